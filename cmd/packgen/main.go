@@ -4,14 +4,22 @@ package main
 import (
 	"bytes"
 	_ "embed"
+	"errors"
 	"flag"
 	"fmt"
+	"go/format"
+	"go/scanner"
+	"go/token"
 	"io"
 	"log"
+	"math/bits"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Name the command in use.
@@ -19,8 +27,22 @@ var name = os.Args[0]
 
 var (
 	packageNameFlag = flag.String("package", "", "Overrides the `name` detected by default.")
-	wordWidthFlag   = flag.Int("width", 64, "Sets the word size in `bits`.")
+	wordWidthFlag   = flag.Int("width", 64, "Sets the word size in `bits`, which is either 16, 32 or 64.")
 	packLimitFlag   = flag.Int("limit", 42, "Sets the upper boundary for bit-packing in `bits`. Full range\ncompression can be achieved with -limit set to one less than the\n-width value. Higher limits generate more code.")
+	prefixSumFlag   = flag.String("prefixsum", "", "Decodes with a prefix sum over all deltas unpacked, instead of one\ndelta at a time, for the comma-separated `bits` sizes or ranges,\ne.g., \"1-8,16\". The encoding is not affected.")
+	configFlag      = flag.String("config", "", "Reads options from `file`, which is either Go source with a go:generate\nline of packgen, or plain text in which # starts a comment. Options\non the command line take precedence. FILE may come from the config\ntoo, relative to its directory.")
+	checkFlag       = flag.Bool("check", false, "Compares the output with the existing files, without writing, and it\nexits with status 1 on any difference. The options in the header of\nFILE apply, unless overridden by the command line.")
+	onlyFlag        = flag.String("only", "", "Limits the output to either the \"encode\" or the \"decode\" `side`.\nEncoded data remains compatible with the full output.")
+	bitsFlag        = flag.String("bits", "", "Limits bit-packing to the comma-separated `bits` sizes or ranges,\ne.g., \"1-16,24,32\". Encoding rounds up to the next size available.\nDecoders panic on other sizes, except for the word width.")
+	compactFlag     = flag.Int("compact", 0, "Generates loops instead of unrolled code for the pack sizes from `bits`\nand up, which reduces the amount of code at the cost of speed. Zero\ndisables the loops. The encoding is not affected.")
+	prefixFlag      = flag.String("prefix", "", "Prepends `text` to the name of each declaration, such that multiple\noutputs can share a package. Names with a lower-case prefix are all\nunexported.")
+	suffixFlag      = flag.String("suffix", "", "Appends `text` to the name of each declaration, such that multiple\noutputs can share a package.")
+	wordFlag        = flag.String("word", "", "Overrides the `name` of the Word type, regardless of -prefix and\n-suffix.")
+	integerFlag     = flag.String("integer", "", "Overrides the `name` of the Integer constraint, regardless of -prefix\nand -suffix.")
+	renameFlag      = flag.String("rename", "", "Overrides declaration names with a comma-separated `list` of old=new\npairs, e.g., \"DecodeInto=decodeIntoGeneric\", regardless of -prefix\nand -suffix.")
+	testFlag        = flag.Bool("test", false, "Writes tests, a fuzz target and benchmarks for the generated code to\nanother file, named FILE with a \"_test\" suffix.")
+	streamFlag      = flag.Bool("stream", false, "Includes a Writer and a Reader for streams of pages. Each page\nstarts with a header Word, which holds the pack sizes.")
+	interleaveFlag  = flag.Bool("interleave", false, "Distributes values over 4 lanes in each word, with value i in lane\ni % 4, such that lanes can unpack in parallel (SIMD). The encoding\nis incompatible with the default (sequential) layout.")
 )
 
 func main() {
@@ -30,6 +52,23 @@ func main() {
 
 	// locate output file
 	args := flag.Args()
+	if *configFlag != "" {
+		configArgs, err := readConfig(*configFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		flag.CommandLine.Parse(configArgs)
+		if len(args) == 0 {
+			args = flag.Args()
+			for i, arg := range args {
+				if arg != "-" && !filepath.IsAbs(arg) {
+					args[i] = filepath.Join(filepath.Dir(*configFlag), arg)
+				}
+			}
+		}
+		// command-line arguments take precedence
+		flag.CommandLine.Parse(os.Args[1:])
+	}
 	switch len(args) {
 	case 0:
 		printManual()
@@ -42,8 +81,20 @@ func main() {
 		os.Exit(2)
 	}
 	path := args[0]
+	if path == "-" && (*checkFlag || *testFlag) {
+		log.Fatal(name, ": standard output (\"-\") can not combine with -check or -test")
+	}
 
-	// unsure parent directory
+	if *checkFlag {
+		headerArgs, err := readOptions(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		// command-line arguments take precedence
+		flag.CommandLine.Parse(append(headerArgs, os.Args[1:]...))
+	}
+
+	// directory name is the default package name
 	dir := filepath.Dir(path)
 	if dir == "." {
 		var err error
@@ -51,39 +102,212 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
-	} else {
-		err := os.MkdirAll(dir, 0o777)
-		if err != nil {
-			log.Fatal(err)
-		}
-	}
-
-	// open output file
-	f, err := os.Create(path)
-	if err != nil {
-		log.Fatal(err)
 	}
-	defer f.Close()
 
 	// execute configuration
 	c := Config{
 		PackageName: *packageNameFlag,
 		WordWidth:   *wordWidthFlag,
 		PackLimit:   *packLimitFlag,
+		Interleave:  *interleaveFlag,
+		Stream:      *streamFlag,
+		Only:        *onlyFlag,
+		CompactFrom: *compactFlag,
+		Prefix:      *prefixFlag,
+		Suffix:      *suffixFlag,
+		WordType:    *wordFlag,
+		IntegerType: *integerFlag,
+	}
+	var err error
+	c.PrefixSum, err = parseBitSizes(*prefixSumFlag)
+	if err != nil {
+		log.Fatal(name, ": -prefixsum: ", err)
+	}
+	c.BitSizes, err = parseBitSizes(*bitsFlag)
+	if err != nil {
+		log.Fatal(name, ": -bits: ", err)
+	}
+	c.Renames, err = parseRenames(*renameFlag)
+	if err != nil {
+		log.Fatal(name, ": -rename: ", err)
+	}
+	if c.CompactFrom < 0 {
+		log.Fatal(name, ": -compact: negative bit size")
 	}
 	if c.PackageName == "" {
 		c.PackageName = filepath.Base(dir)
 	}
-	err = generatePack(f, c)
+	// record the options in use
+	var options []string
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "check" || f.Name == "config" {
+			return
+		}
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+			if f.Value.String() == "true" {
+				options = append(options, "-"+f.Name)
+			}
+			return
+		}
+		options = append(options, "-"+f.Name, f.Value.String())
+	})
+	c.Options = strings.Join(options, " ")
+
+	type output struct {
+		path     string
+		generate func(io.Writer, Config) error
+		buf      bytes.Buffer
+	}
+	outputs := []*output{{path: path, generate: generatePack}}
+	if *testFlag {
+		outputs = append(outputs, &output{path: strings.TrimSuffix(path, ".go") + "_test.go", generate: generateTest})
+	}
+	// no output on any error
+	for _, o := range outputs {
+		err := o.generate(&o.buf, c)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var outdated bool
+	for _, o := range outputs {
+		if o.path == "-" {
+			_, err := os.Stdout.Write(o.buf.Bytes())
+			if err != nil {
+				log.Fatal(err)
+			}
+			continue
+		}
+		if !*checkFlag {
+			err := os.MkdirAll(filepath.Dir(o.path), 0o777)
+			if err != nil {
+				log.Fatal(err)
+			}
+			err = os.WriteFile(o.path, o.buf.Bytes(), 0o666)
+			if err != nil {
+				log.Fatal(err)
+			}
+			continue
+		}
+
+		current, err := os.ReadFile(o.path)
+		if err != nil {
+			log.Print(err)
+			outdated = true
+			continue
+		}
+		if diff := diffSummary(current, o.buf.Bytes()); diff != "" {
+			log.Printf("%s: %s", o.path, diff)
+			outdated = true
+		}
+	}
+	if outdated {
+		os.Exit(1)
+	}
+}
+
+// FormatVersion is incremented on each change in the encoding rules, i.e., any
+// change in the data format of generated code requires a new version.
+const formatVersion = 1
+
+// OptionsPrefix marks the line with options in the header of generated files.
+const optionsPrefix = "// Options: "
+
+// ReadOptions returns the options from the header of a generated file, if any.
+func readOptions(path string) ([]string, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(text), "\n") {
+		if !strings.HasPrefix(line, "//") {
+			break // end of header
+		}
+		if strings.HasPrefix(line, optionsPrefix) {
+			return strings.Fields(strings.TrimPrefix(line, optionsPrefix)), nil
+		}
+	}
+	return nil, nil
+}
+
+// ReadConfig returns the arguments from a config file. Go source has them in a
+// go:generate line of packgen. Other files have them in plain text, in which #
+// starts a comment.
+func readConfig(path string) ([]string, error) {
+	text, err := os.ReadFile(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	lines := strings.Split(string(text), "\n")
+
+	if filepath.Ext(path) == ".go" {
+		for _, line := range lines {
+			if !strings.HasPrefix(line, "//go:generate ") {
+				continue
+			}
+			fields := strings.Fields(line)[1:]
+			for i, field := range fields {
+				// trim any version from go run
+				command, _, _ := strings.Cut(field, "@")
+				if filepath.Base(command) == "packgen" {
+					return fields[i+1:], nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("%s: no go:generate line with packgen", path)
 	}
+
+	var args []string
+	for _, line := range lines {
+		line, _, _ = strings.Cut(line, "#")
+		args = append(args, strings.Fields(line)...)
+	}
+	return args, nil
+}
+
+// DiffSummary describes the first difference between the current content and
+// the generated content. The return is empty when both are equal.
+func diffSummary(current, generated []byte) string {
+	if bytes.Equal(current, generated) {
+		return ""
+	}
+	currentLines := bytes.Split(current, []byte{'\n'})
+	generatedLines := bytes.Split(generated, []byte{'\n'})
+	lineNo := 1
+	for lineNo <= len(currentLines) && lineNo <= len(generatedLines) && bytes.Equal(currentLines[lineNo-1], generatedLines[lineNo-1]) {
+		lineNo++
+	}
+	return fmt.Sprintf("not up to date; first difference on line %d, with %d lines in file and %d lines generated", lineNo, len(currentLines), len(generatedLines))
 }
 
 //go:embed pack.template
 var packText string
 
+//go:embed stream.template
+var streamText string
+
+//go:embed test.template
+var testText string
+
 func generatePack(w io.Writer, c Config) error {
+	if c.IntegerTypes() == "" {
+		return fmt.Errorf("word width %d not supported; need 16, 32 or 64", c.WordWidth)
+	}
+	if !c.Encoder() && !c.Decoder() {
+		return fmt.Errorf("side %q not supported; need \"encode\" or \"decode\"", c.Only)
+	}
+	for _, name := range []string{"Word", "Integer", "appendCompactDeltaEncode"} {
+		if s := c.Identifier(name); !token.IsIdentifier(s) {
+			return fmt.Errorf("declaration name %q is not a Go identifier", s)
+		}
+	}
+	for _, s := range c.Renames {
+		if !token.IsIdentifier(s) {
+			return fmt.Errorf("declaration name %q is not a Go identifier", s)
+		}
+	}
+
 	t := template.New("pack").Funcs(map[string]any{
 		"iterate": func(n int) []int {
 			all := make([]int, n)
@@ -92,24 +316,234 @@ func generatePack(w io.Writer, c Config) error {
 			}
 			return all
 		},
+		"sub":  func(a, b int) int { return a - b },
+		"name": c.Identifier,
 	})
 
 	t, err := t.Parse(packText)
 	if err != nil {
 		return err
 	}
+	_, err = t.New("stream").Parse(streamText)
+	if err != nil {
+		return err
+	}
+
+	return executeFormatted(w, t, c, "pack.template or stream.template")
+}
+
+// GenerateTest writes the tests of generatePack with the same configuration.
+func generateTest(w io.Writer, c Config) error {
+	if c.Only != "" {
+		return errors.New("tests need both the encode and the decode side")
+	}
+	t, err := template.New("test").Funcs(map[string]any{
+		"name":   c.Identifier,
+		"export": upperFirst,
+	}).Parse(testText)
+	if err != nil {
+		return err
+	}
+	return executeFormatted(w, t, c, "test.template")
+}
+
+// ExecuteFormatted writes the output of t with gofmt applied. Syntax errors
+// are bugs in templates, which are named in the error.
+func executeFormatted(w io.Writer, t *template.Template, c Config, templates string) error {
+	var buf bytes.Buffer
+	err := t.Execute(&buf, c)
+	if err != nil {
+		return err
+	}
+	src, err := formatSource(buf.Bytes(), templates)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// FormatSource returns src with gofmt applied. Syntax errors include the line
+// in question, such that the origin in templates can be found.
+func formatSource(src []byte, templates string) ([]byte, error) {
+	formatted, err := format.Source(src)
+	if err == nil {
+		return formatted, nil
+	}
 
-	return t.Execute(w, c)
+	var errs scanner.ErrorList
+	if !errors.As(err, &errs) || len(errs) == 0 {
+		return nil, fmt.Errorf("generated code from %s: %w", templates, err)
+	}
+	pos := errs[0].Pos
+	var line string
+	if lines := bytes.Split(src, []byte{'\n'}); pos.Line > 0 && pos.Line <= len(lines) {
+		line = strings.TrimSpace(string(lines[pos.Line-1]))
+	}
+	return nil, fmt.Errorf("generated code from %s does not parse: line %d, column %d: %s; got %q", templates, pos.Line, pos.Column, errs[0].Msg, line)
 }
 
 type Config struct {
 	PackageName string
 	WordWidth   int
 	PackLimit   int
+	Interleave  bool
+	// decode with prefix sum per bit size
+	PrefixSum map[int]bool
+	Stream    bool
+	// limit output to "encode" or "decode", or empty for both
+	Only string
+	// limit bit-packing to sizes, or empty for all
+	BitSizes map[int]bool
+	// loops instead of unrolled code from bit size, or zero for none
+	CompactFrom int
+	// declaration names get prefix and suffix
+	Prefix, Suffix string
+	// type names override prefix and suffix when not empty
+	WordType, IntegerType string
+	// declaration names override prefix and suffix
+	Renames map[string]string
+	// command-line arguments for the header
+	Options string
+}
+
+// Identifier returns the name in generated code for the declaration of name.
+// The prefix, if any, decides whether the name is exported or not.
+func (c Config) Identifier(name string) string {
+	if s, ok := c.Renames[name]; ok {
+		return s
+	}
+	switch {
+	case name == "Word" && c.WordType != "":
+		return c.WordType
+	case name == "Integer" && c.IntegerType != "":
+		return c.IntegerType
+	case c.Prefix == "":
+		return name + c.Suffix
+	case token.IsExported(name):
+		return c.Prefix + name + c.Suffix
+	default:
+		return lowerFirst(c.Prefix) + upperFirst(name) + c.Suffix
+	}
+}
+
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+// ParseBitSizes reads a comma-separated list of bit sizes, in which each
+// element is either a number or a range of numbers, like "1-8,16".
+func parseBitSizes(s string) (map[int]bool, error) {
+	set := make(map[int]bool)
+	if s == "" {
+		return set, nil
+	}
+	for _, elem := range strings.Split(s, ",") {
+		first, last, isRange := strings.Cut(elem, "-")
+		from, err := strconv.Atoi(first)
+		if err != nil || from < 1 {
+			return nil, fmt.Errorf("malformed bit size %q", elem)
+		}
+		to := from
+		if isRange {
+			to, err = strconv.Atoi(last)
+			if err != nil || to < from {
+				return nil, fmt.Errorf("malformed bit-size range %q", elem)
+			}
+		}
+		for n := from; n <= to; n++ {
+			set[n] = true
+		}
+	}
+	return set, nil
+}
+
+// ParseRenames reads a comma-separated list of old=new name pairs, like
+// "DecodeInto=decodeIntoGeneric".
+func parseRenames(s string) (map[string]string, error) {
+	renames := make(map[string]string)
+	if s == "" {
+		return renames, nil
+	}
+	for _, elem := range strings.Split(s, ",") {
+		old, new, ok := strings.Cut(elem, "=")
+		if !ok || !token.IsIdentifier(old) || !token.IsIdentifier(new) {
+			return nil, fmt.Errorf("malformed rename %q", elem)
+		}
+		if _, dupe := renames[old]; dupe {
+			return nil, fmt.Errorf("name %q renamed twice", old)
+		}
+		renames[old] = new
+	}
+	return renames, nil
 }
 
 func (c Config) WordWidthMinusOne() int { return c.WordWidth - 1 }
 
+// Encoder returns whether the output includes the encode side.
+func (c Config) Encoder() bool { return c.Only == "" || c.Only == "encode" }
+
+// Decoder returns whether the output includes the decode side.
+func (c Config) Decoder() bool { return c.Only == "" || c.Only == "decode" }
+
+// MaxBitN returns the highest bit size with compression.
+func (c Config) MaxBitN() int {
+	packs := c.BitPacks()
+	if len(packs) == 0 {
+		return 0
+	}
+	return packs[len(packs)-1].BitN
+}
+
+// FormatVersion returns the version of the encoding rules.
+func (c Config) FormatVersion() int { return formatVersion }
+
+// WordSize returns the number of bytes in a word.
+func (c Config) WordSize() int { return c.WordWidth / 8 }
+
+// The header of a page in a stream has a slot for each pack size, with the
+// full-page flag in the most significant bit. A slot fits any size from zero up
+// to the word width. The highest value marks unused slots in a partial page.
+// The last slot of a partial page holds the number of uncompressed integers
+// instead.
+
+// SlotBits returns the number of bits per header slot.
+func (c Config) SlotBits() int { return bits.Len(uint(c.WordWidth)) }
+
+// SlotN returns the number of header slots, i.e., the number of packs per page.
+func (c Config) SlotN() int { return (c.WordWidth - 1) / c.SlotBits() }
+
+// SlotMask returns the value bits of a header slot.
+func (c Config) SlotMask() int { return 1<<c.SlotBits() - 1 }
+
+// HeaderEnd returns the bit offset past the last header slot.
+func (c Config) HeaderEnd() int { return c.SlotN() * c.SlotBits() }
+
+// TailShift returns the bit offset of the last header slot.
+func (c Config) TailShift() int { return c.HeaderEnd() - c.SlotBits() }
+
+// IntegerTypes returns the type set of the Integer constraint, which is empty
+// for unsupported word widths. Types can not exceed the word width, and their
+// deltas must sign-extend, i.e., only the unsigned type of the word width fits.
+func (c Config) IntegerTypes() string {
+	switch c.WordWidth {
+	case 16:
+		return "~int16 | ~uint16"
+	case 32:
+		return "~int16 | ~int32 | ~uint32"
+	case 64:
+		return "~int | ~int16 | ~int32 | ~int64 | ~uint64"
+	default:
+		return ""
+	}
+}
+
 // BitPacks returns each supported pack size in ascending order.
 // Zero bits are not packed and neither is the word width itself.
 func (c Config) BitPacks() []BitPack {
@@ -117,21 +551,100 @@ func (c Config) BitPacks() []BitPack {
 	if c.PackLimit >= 0 && packN > c.PackLimit {
 		packN = c.PackLimit
 	}
-	packs := make([]BitPack, packN)
-	for i := range packs {
-		packs[i].BitN = i + 1
-		packs[i].WordWidth = c.WordWidth
+	var packs []BitPack
+	// lowest size not covered yet
+	encodeFrom := 1
+	for bitN := 1; bitN <= packN; bitN++ {
+		if len(c.BitSizes) != 0 && !c.BitSizes[bitN] {
+			continue
+		}
+		packs = append(packs, BitPack{
+			BitN:       bitN,
+			WordWidth:  c.WordWidth,
+			Interleave: c.Interleave,
+			PrefixSum:  c.PrefixSum[bitN],
+			EncodeFrom: encodeFrom,
+			Compact:    c.CompactFrom != 0 && bitN >= c.CompactFrom,
+			WordType:   c.Identifier("Word"),
+		})
+		encodeFrom = bitN + 1
+	}
+	return packs
+}
+
+// UnrolledPacks returns each of BitPacks without the compact ones.
+func (c Config) UnrolledPacks() []BitPack {
+	var packs []BitPack
+	for _, p := range c.BitPacks() {
+		if !p.Compact {
+			packs = append(packs, p)
+		}
+	}
+	return packs
+}
+
+// CompactPacks returns each of BitPacks with loops instead of unrolled code.
+func (c Config) CompactPacks() []BitPack {
+	var packs []BitPack
+	for _, p := range c.BitPacks() {
+		if p.Compact {
+			packs = append(packs, p)
+		}
 	}
 	return packs
 }
 
+// CompactCases returns the bit sizes of CompactPacks as a Go case list.
+func (c Config) CompactCases() string {
+	var cases []string
+	for _, p := range c.CompactPacks() {
+		cases = append(cases, strconv.Itoa(p.BitN))
+	}
+	return strings.Join(cases, ", ")
+}
+
+// LaneN returns the number of lanes in the bit layout.
+func (c Config) LaneN() int {
+	if c.Interleave {
+		return laneN
+	}
+	return 1
+}
+
+// ChunkBitN returns the number of bits per lane in each word.
+func (c Config) ChunkBitN() int { return c.WordWidth / c.LaneN() }
+
 type BitPack struct {
-	BitN      int
-	WordWidth int
+	BitN       int
+	WordWidth  int
+	Interleave bool
+	PrefixSum  bool
+	// smallest bit size encoded with this pack
+	EncodeFrom int
+	// loops instead of unrolled code
+	Compact bool
+	// name of the Word type in generated code
+	WordType string
+}
+
+// EncodeCases returns the bit sizes encoded with this pack as a Go case list.
+func (p BitPack) EncodeCases() string {
+	cases := make([]string, 0, p.BitN-p.EncodeFrom+1)
+	for n := p.EncodeFrom; n <= p.BitN; n++ {
+		cases = append(cases, strconv.Itoa(n))
+	}
+	return strings.Join(cases, ", ")
 }
 
+// LaneN is the number of lanes in an interleaved layout.
+const laneN = 4
+
 // BitPackExpressions returns Go code for each output word.
 func (p BitPack) BitPackExpressions(inputExpressions []string) []string {
+	if p.Interleave {
+		return p.interleavedPackExpressions(inputExpressions)
+	}
+
 	// number of input bits remaining from last word
 	var passBitN int
 
@@ -144,18 +657,18 @@ func (p BitPack) BitPackExpressions(inputExpressions []string) []string {
 		space := p.WordWidth
 
 		if passBitN > 0 {
-			fmt.Fprintf(&buf, "|Word(%s)<<%d", inputExpressions[0], space-passBitN)
+			fmt.Fprintf(&buf, "|%s(%s)<<%d", p.WordType, inputExpressions[0], space-passBitN)
 			space -= passBitN
 			inputExpressions = inputExpressions[1:]
 			passBitN = 0
 		}
 		for ; space >= p.BitN; space -= p.BitN {
-			fmt.Fprintf(&buf, "|Word(%s)<<%d", inputExpressions[0], space-p.BitN)
+			fmt.Fprintf(&buf, "|%s(%s)<<%d", p.WordType, inputExpressions[0], space-p.BitN)
 			inputExpressions = inputExpressions[1:]
 		}
 		if space > 0 {
 			passBitN = p.BitN - space
-			fmt.Fprintf(&buf, "|Word(%s)>>%d", inputExpressions[0], passBitN)
+			fmt.Fprintf(&buf, "|%s(%s)>>%d", p.WordType, inputExpressions[0], passBitN)
 		}
 
 		words[i] = strings.TrimPrefix(buf.String(), "|")
@@ -183,6 +696,10 @@ func (p BitPack) DeltaEncodeExpressions() []string {
 
 // BitUnpackExpressions returns the Go code for each encoded value.
 func (p BitPack) BitUnpackExpressions() []string {
+	if p.Interleave {
+		return p.interleavedUnpackExpressions()
+	}
+
 	words := make([]string, p.WordWidth)
 	for i := range words {
 		// calculate location in word input
@@ -205,6 +722,124 @@ func (p BitPack) BitUnpackExpressions() []string {
 	return words
 }
 
+// PrefixSumStatements returns Go code which sets each dst[i] to offset minus the
+// sum of all deltas up to and including index i. The deltas are summed within
+// blocks first, which are independent of each other, such that the additions
+// of each block can execute in parallel with those of the other blocks. The
+// carry over blocks is applied after.
+func (p BitPack) PrefixSumStatements() []string {
+	blockN := 1
+	for blockN*blockN < p.WordWidth {
+		blockN *= 2
+	}
+
+	exprs := p.BitUnpackExpressions()
+	var lines []string
+	for i, expr := range exprs {
+		zigZag := fmt.Sprintf("int%d(%s)", p.WordWidth, expr)
+		if i%blockN == 0 {
+			lines = append(lines, fmt.Sprintf("sum = %s>>1 ^ -(%s & 1)", zigZag, zigZag))
+		} else {
+			lines = append(lines, fmt.Sprintf("sum += %s>>1 ^ -(%s & 1)", zigZag, zigZag))
+		}
+		lines = append(lines, fmt.Sprintf("dst[%d] = T(sum)", i))
+	}
+	for i := range exprs {
+		lines = append(lines, fmt.Sprintf("dst[%d] = offset - dst[%d]", i, i))
+		if i%blockN == blockN-1 {
+			lines = append(lines, fmt.Sprintf("offset = dst[%d]", i))
+		}
+	}
+	return lines
+}
+
+// The interleaved layout splits each word in laneN chunks. Value i goes to lane
+// i % laneN. The values of each lane are packed in sequence, and the resulting
+// bits are spread over the chunks of the lane, one chunk per word. Thus, value
+// i and value i + 1 are located in different lanes, with an equal offset.
+
+// InterleavedPackExpressions returns Go code for each output word.
+func (p BitPack) interleavedPackExpressions(inputExpressions []string) []string {
+	chunkBitN := p.WordWidth / laneN
+
+	words := make([]string, p.BitN)
+	for i := range words {
+		// expression text
+		var buf bytes.Buffer
+
+		// bit range of chunk in lane
+		chunkStart, chunkEnd := i*chunkBitN, (i+1)*chunkBitN
+		for lane := 0; lane < laneN; lane++ {
+			// values in lane with bits in chunk
+			for j := chunkStart / p.BitN; j*p.BitN < chunkEnd; j++ {
+				// bit range of value in lane
+				valueStart, valueEnd := j*p.BitN, (j+1)*p.BitN
+				// intersection of value and chunk
+				start, end := overlap(valueStart, valueEnd, chunkStart, chunkEnd)
+
+				// position in value
+				expr := fmt.Sprintf("%s(%s)", p.WordType, inputExpressions[j*laneN+lane])
+				if end != valueEnd || start != valueStart {
+					expr = fmt.Sprintf("(%s>>%d&%#x)", expr, valueEnd-end, uint64(1)<<(end-start)-1)
+				}
+				// position in word
+				fmt.Fprintf(&buf, "|%s<<%d", expr, p.WordWidth-lane*chunkBitN-(end-chunkStart))
+			}
+		}
+
+		words[i] = strings.TrimPrefix(buf.String(), "|")
+	}
+
+	return words
+}
+
+// InterleavedUnpackExpressions returns the Go code for each encoded value.
+func (p BitPack) interleavedUnpackExpressions() []string {
+	chunkBitN := p.WordWidth / laneN
+
+	values := make([]string, p.WordWidth)
+	for i := range values {
+		lane := i % laneN
+		// bit range of value in lane
+		valueStart, valueEnd := i/laneN*p.BitN, (i/laneN+1)*p.BitN
+
+		// expression text
+		var buf bytes.Buffer
+
+		// chunks (word indices) with bits from value
+		for c := valueStart / chunkBitN; c*chunkBitN < valueEnd; c++ {
+			// bit range of chunk in lane
+			chunkStart, chunkEnd := c*chunkBitN, (c+1)*chunkBitN
+			// intersection of value and chunk
+			start, end := overlap(valueStart, valueEnd, chunkStart, chunkEnd)
+
+			// position in word
+			expr := fmt.Sprintf("src[%d]>>%d&%#x", c, p.WordWidth-lane*chunkBitN-(end-chunkStart), uint64(1)<<(end-start)-1)
+			// position in value
+			if end != valueEnd {
+				expr = fmt.Sprintf("(%s)<<%d", expr, valueEnd-end)
+			}
+			buf.WriteString("|" + expr)
+		}
+
+		values[i] = strings.TrimPrefix(buf.String(), "|")
+	}
+
+	return values
+}
+
+// Overlap returns the intersection of two ranges.
+func overlap(start1, end1, start2, end2 int) (start, end int) {
+	start, end = start1, end1
+	if start2 > start {
+		start = start2
+	}
+	if end2 < end {
+		end = end2
+	}
+	return start, end
+}
+
 // ANSI escape codes for markup
 const (
 	bold  = "\x1b[1m"
@@ -219,6 +854,11 @@ func printManual() {
 		"\n" +
 		bold + "SYNOPSIS\n" +
 		"\t" + name + clear + " [" + bold + "OPTIONS" + clear + "] FILE\n" +
+		"\t" + bold + name + clear + " -config file [" + bold + "OPTIONS" + clear + "] [FILE]\n" +
+		"\n" +
+		bold + "DESCRIPTION" + clear + "\n" +
+		"\tThe code goes to FILE, or to the standard output when FILE is \"-\".\n" +
+		"\tOutput is formatted as by gofmt(1), and it is the same on each run.\n" +
 		"\n" +
 		bold + "OPTIONS" + clear + "\n")
 
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var genBenchFlag = flag.String("genbench", "", "Runs the benchmarks from testdata which match the regular expression\non generated code, such as -genbench=DecodeInto -run=PrefixSum.")
+
+// TestWidth includes the stream layer.
+func TestWidth(t *testing.T) {
+	for _, width := range []int{16, 32, 64} {
+		width := width
+		t.Run(fmt.Sprintf("%dBit", width), func(t *testing.T) {
+			t.Parallel()
+			testGenerated(t, Config{
+				PackageName: "pack",
+				WordWidth:   width,
+				PackLimit:   width - 1,
+				Stream:      true,
+			})
+		})
+	}
+
+	err := generatePack(io.Discard, Config{PackageName: "pack", WordWidth: 8})
+	if err == nil {
+		t.Error("8-bit word width got no error")
+	}
+}
+
+func TestSubset(t *testing.T) {
+	bitSizes, err := parseBitSizes("3,5-8,16,31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, only := range map[string]string{"Both": "", "Encode": "encode", "Decode": "decode"} {
+		only := only
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			testGenerated(t, Config{
+				PackageName: "pack",
+				WordWidth:   32,
+				PackLimit:   31,
+				Stream:      true,
+				Only:        only,
+				BitSizes:    bitSizes,
+			})
+		})
+	}
+
+	err = generatePack(io.Discard, Config{PackageName: "pack", WordWidth: 64, Only: "both"})
+	if err == nil {
+		t.Error("unknown side got no error")
+	}
+	err = generateTest(io.Discard, Config{PackageName: "pack", WordWidth: 64, Only: "decode"})
+	if err == nil {
+		t.Error("test generation for decode only got no error")
+	}
+}
+
+// TestCompact compares the encoding with unrolled code.
+func TestCompact(t *testing.T) {
+	bitSizes, err := parseBitSizes("2,4,8,12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, c := range map[string]Config{
+		"Width16":    {WordWidth: 16, PackLimit: 15, CompactFrom: 5, BitSizes: bitSizes},
+		"Width32":    {WordWidth: 32, PackLimit: 31, CompactFrom: 9, Stream: true},
+		"Interleave": {WordWidth: 64, PackLimit: 63, CompactFrom: 17, Interleave: true},
+	} {
+		c := c
+		c.PackageName = "pack"
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			testGenerated(t, c)
+		})
+	}
+}
+
+// TestNames generates multiple outputs into one package.
+func TestNames(t *testing.T) {
+	testGenerated(t,
+		Config{PackageName: "pack", WordWidth: 64, PackLimit: 20, Stream: true, Suffix: "64", Renames: map[string]string{"DecodeInto": "decodeIntoGeneric"}},
+		Config{PackageName: "pack", WordWidth: 32, PackLimit: 31, Stream: true, CompactFrom: 9, Prefix: "p32", WordType: "Word32"},
+		Config{PackageName: "pack", WordWidth: 16, PackLimit: 15, Interleave: true, Prefix: "Short", IntegerType: "ShortInt"},
+	)
+
+	for _, c := range []Config{
+		{PackageName: "pack", WordWidth: 64, Prefix: "9"},
+		{PackageName: "pack", WordWidth: 64, Suffix: "-"},
+		{PackageName: "pack", WordWidth: 64, WordType: "type"},
+		{PackageName: "pack", WordWidth: 64, Renames: map[string]string{"DecodeInto": "func"}},
+	} {
+		err := generatePack(io.Discard, c)
+		if err == nil {
+			t.Errorf("prefix %q, suffix %q, word %q and renames %v got no error", c.Prefix, c.Suffix, c.WordType, c.Renames)
+		}
+	}
+}
+
+// TestInterleave includes a test of the bit layout from testdata.
+func TestInterleave(t *testing.T) {
+	testGenerated(t, Config{
+		PackageName: "pack",
+		WordWidth:   64,
+		PackLimit:   63,
+		Interleave:  true,
+	})
+}
+
+// TestPrefixSum includes the default (serial) decoding for comparison with
+// -genbench only.
+func TestPrefixSum(t *testing.T) {
+	if *genBenchFlag != "" {
+		t.Run("Serial", func(t *testing.T) {
+			testGenerated(t, Config{
+				PackageName: "pack",
+				WordWidth:   64,
+				PackLimit:   63,
+			})
+		})
+	}
+
+	t.Run("PrefixSum", func(t *testing.T) {
+		all, err := parseBitSizes("1-63")
+		if err != nil {
+			t.Fatal(err)
+		}
+		testGenerated(t, Config{
+			PackageName: "pack",
+			WordWidth:   64,
+			PackLimit:   63,
+			PrefixSum:   all,
+		})
+	})
+}
+
+func TestParseBitSizes(t *testing.T) {
+	got, err := parseBitSizes("1-3,7,9-9")
+	if err != nil {
+		t.Fatal("parse error:", err)
+	}
+	want := map[int]bool{1: true, 2: true, 3: true, 7: true, 9: true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for _, s := range []string{",", "0", "x", "3-1", "1-", "-4"} {
+		_, err := parseBitSizes(s)
+		if err == nil {
+			t.Errorf("%q got no error", s)
+		}
+	}
+}
+
+func TestParseRenames(t *testing.T) {
+	got, err := parseRenames("DecodeInto=decodeInto2,Word=W")
+	if err != nil {
+		t.Fatal("parse error:", err)
+	}
+	want := map[string]string{"DecodeInto": "decodeInto2", "Word": "W"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for _, s := range []string{",", "x", "x=", "=y", "x=y=z", "x=1", "x=y,x=z"} {
+		_, err := parseRenames(s)
+		if err == nil {
+			t.Errorf("%q got no error", s)
+		}
+	}
+}
+
+func TestReadOptions(t *testing.T) {
+	var buf bytes.Buffer
+	err := generateTest(&buf, Config{PackageName: "pack", WordWidth: 32, PackLimit: 7, Options: "-width 32 -limit 7"})
+	if err != nil {
+		t.Fatal("generate error:", err)
+	}
+	path := filepath.Join(t.TempDir(), "gen_test.go")
+	err = os.WriteFile(path, buf.Bytes(), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readOptions(path)
+	if err != nil {
+		t.Fatal("read error:", err)
+	}
+	want := []string{"-width", "32", "-limit", "7"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got options %q, want %q", got, want)
+	}
+}
+
+// TestStable verifies identical output across runs, including the ones from
+// map arguments.
+func TestStable(t *testing.T) {
+	bitSizes, err := parseBitSizes("1-9,12,16")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefixSum, err := parseBitSizes("2-4,16")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Config{PackageName: "pack", WordWidth: 32, PackLimit: 20, Stream: true, BitSizes: bitSizes, PrefixSum: prefixSum, CompactFrom: 12}
+
+	for _, generate := range []func(io.Writer, Config) error{generatePack, generateTest} {
+		var first, second bytes.Buffer
+		if err := generate(&first, c); err != nil {
+			t.Fatal("generate error:", err)
+		}
+		if err := generate(&second, c); err != nil {
+			t.Fatal("generate error:", err)
+		}
+		if diff := diffSummary(first.Bytes(), second.Bytes()); diff != "" {
+			t.Error("second run", diff)
+		}
+	}
+}
+
+func TestFormatSource(t *testing.T) {
+	got, err := formatSource([]byte("package pack\nvar  x=1\n"), "pack.template")
+	if err != nil {
+		t.Fatal("format error:", err)
+	}
+	if want := "package pack\n\nvar x = 1\n"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	_, err = formatSource([]byte("package pack\n\nfunc f() {\n\treturn append(dst, )x\n}\n"), "pack.template")
+	const want = `generated code from pack.template does not parse: line 4, column 22: expected ';', found x; got "return append(dst, )x"`
+	if err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %s", err, want)
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	dir := t.TempDir()
+	configs := map[string]string{
+		"pack.go":   "// Package pack is generated.\npackage pack\n\n//go:generate go run example.com/cmd/packgen@v1.0.0 -width 32 -stream gen.go\n",
+		"pack.conf": "# 32-bit codec\n-width 32\n-stream # with Reader & Writer\ngen.go\n",
+	}
+	for name, config := range configs {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, []byte(config), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := readConfig(path)
+		if err != nil {
+			t.Errorf("%s got error: %s", name, err)
+			continue
+		}
+		want := []string{"-width", "32", "-stream", "gen.go"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s got arguments %q, want %q", name, got, want)
+		}
+	}
+
+	path := filepath.Join(dir, "other.go")
+	err := os.WriteFile(path, []byte("package pack\n\n//go:generate stringer -type Kind\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readConfig(path); err == nil {
+		t.Error("Go source without packgen got no error")
+	}
+}
+
+func TestDiffSummary(t *testing.T) {
+	if s := diffSummary([]byte("a\nb\n"), []byte("a\nb\n")); s != "" {
+		t.Errorf("equal content got %q", s)
+	}
+	const want = "not up to date; first difference on line 2, with 3 lines in file and 4 lines generated"
+	if s := diffSummary([]byte("a\nb\n"), []byte("a\nc\nd\n")); s != want {
+		t.Errorf("got %q, want %q", s, want)
+	}
+}
+
+// TestGenerated runs the generated tests, plus the ones from testdata, on the
+// output of c. Any more configurations generate into the same package.
+func testGenerated(t *testing.T, c Config, more ...Config) {
+	if testing.Short() {
+		t.Skip("compilation of generated code takes long")
+	}
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not available:", err)
+	}
+
+	dir := t.TempDir()
+	goMod := "module example.com/pack\n\ngo 1.20\n"
+	// testdata uses the default names
+	defaultNames := c.Prefix == "" && c.Suffix == "" && c.WordType == "" && c.IntegerType == ""
+	if c.Stream && c.WordWidth == 64 && c.PackLimit == 63 && !c.Interleave && len(c.BitSizes) == 0 && defaultNames {
+		// compare with the pack64 package from this module
+		root, err := filepath.Abs(filepath.Join("..", ".."))
+		if err != nil {
+			t.Fatal(err)
+		}
+		goMod += "\nrequire github.com/pascaldekloe/wordpack v0.0.0\n\nreplace github.com/pascaldekloe/wordpack => " + root + "\n"
+
+		test, err := os.ReadFile(filepath.Join("testdata", "pack64_test.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, "pack64_test.go"), test, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Interleave && c.Only == "" && defaultNames {
+		test, err := os.ReadFile(filepath.Join("testdata", "interleave_test.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, "interleave_test.go"), test, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if c.CompactFrom != 0 && c.Only == "" && defaultNames {
+		// compare with unrolled code
+		unrolled := c
+		unrolled.PackageName = "unrolled"
+		unrolled.CompactFrom = 0
+		var buf bytes.Buffer
+		err := generatePack(&buf, unrolled)
+		if err != nil {
+			t.Fatal("generate unrolled error:", err)
+		}
+		err = os.Mkdir(filepath.Join(dir, "unrolled"), 0o755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, "unrolled", "gen.go"), buf.Bytes(), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		test, err := os.ReadFile(filepath.Join("testdata", "compact_test.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, "compact_test.go"), test, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, c := range append([]Config{c}, more...) {
+		base := "gen"
+		if i != 0 {
+			base = fmt.Sprintf("gen%d", i)
+		}
+		outputs := map[string]func(io.Writer, Config) error{
+			base + ".go": generatePack,
+		}
+		if c.Only == "" {
+			outputs[base+"_test.go"] = generateTest
+		}
+		for name, generate := range outputs {
+			f, err := os.Create(filepath.Join(dir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = generate(f, c)
+			f.Close()
+			if err != nil {
+				t.Fatalf("generate %s error: %s", name, err)
+			}
+		}
+	}
+
+	args := []string{"test"}
+	if c.Only != "" {
+		args = []string{"vet"} // no tests
+	}
+	if *genBenchFlag != "" {
+		args = append(args, "-bench", *genBenchFlag)
+	}
+	cmd := exec.Command(goCmd, append(args, ".")...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("generated code with %+v failed: %s\n%s", c, err, out)
+	}
+	if *genBenchFlag != "" {
+		t.Logf("%s", out)
+	}
+}
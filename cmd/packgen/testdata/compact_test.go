@@ -0,0 +1,65 @@
+package pack
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"example.com/pack/unrolled"
+)
+
+// TestCompact verifies the encoding and the decoding against the unrolled code.
+func TestCompact(t *testing.T) {
+	random := rand.New(rand.NewSource(99))
+	for bitN := 1; bitN <= PackLimit; bitN++ {
+		data, offset := randomDeltas(random, bitN)
+
+		in := data // copy just in case encode mutates input
+		got := AppendDeltaEncode(nil, &in, offset)
+		want := unrolled.AppendDeltaEncode(nil, &data, offset)
+		if len(got) != len(want) {
+			t.Fatalf("%d-bit deltas packed in %d words, want %d", bitN, len(got), len(want))
+		}
+		for i := range got {
+			if uint64(got[i]) != uint64(want[i]) {
+				t.Fatalf("%d-bit deltas packed %#x, want %#x", bitN, got, want)
+			}
+		}
+
+		// same pack for both
+		pack := make([]unrolled.Word, len(got))
+		for i, w := range got {
+			pack[i] = unrolled.Word(w)
+		}
+
+		if last, want := DecodeLast(got, offset), unrolled.DecodeLast(pack, offset); last != want {
+			t.Errorf("%d-bit deltas decoded last %#x, want %#x", bitN, last, want)
+		}
+		sum, last := SumDelta(got, offset)
+		wantSum, wantLast := unrolled.SumDelta(pack, offset)
+		if sum != wantSum || last != wantLast {
+			t.Errorf("%d-bit deltas got sum %#x and last %#x, want %#x and %#x", bitN, sum, last, wantSum, wantLast)
+		}
+
+		lo, hi := unrolled.MinMax(pack, offset)
+		from, to := lo/2+hi/4, hi/2+lo/4
+		mask, last := RangeMask(got, offset, from, to)
+		wantMask, wantLast := unrolled.RangeMask(pack, offset, from, to)
+		if mask != wantMask || last != wantLast {
+			t.Errorf("%d-bit deltas in range [%#x, %#x] got mask %#x and last %#x, want %#x and %#x", bitN, from, to, mask, last, wantMask, wantLast)
+		}
+
+		for _, mask := range selectMasks(wantMask) {
+			selected := AppendDeltaDecodeSelect(nil, got, offset, mask)
+			want := unrolled.AppendDeltaDecodeSelect(nil, pack, offset, mask)
+			if !reflect.DeepEqual(selected, want) {
+				t.Errorf("%d-bit deltas decoded %#x with mask %#x, want %#x", bitN, selected, mask, want)
+			}
+		}
+	}
+}
+
+// SelectMasks returns masks for AppendDeltaDecodeSelect, including m.
+func selectMasks[M uint16 | uint32 | uint64](m M) []M {
+	return []M{0, 1, 0xf0f0, m, ^M(0)}
+}
@@ -0,0 +1,42 @@
+package pack
+
+import (
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+// TestInterleaveLayout verifies the bits of the Integer at index i to be in
+// lane i % 4, directly after the bits of the Integer at index i - 4.
+func TestInterleaveLayout(t *testing.T) {
+	const laneN = 4
+	wordWidth := bits.OnesCount64(uint64(^Word(0)))
+	chunkBitN := wordWidth / laneN
+
+	for bitN := 1; bitN <= PackLimit; bitN++ {
+		for i := 0; i < wordWidth; i++ {
+			// zero deltas only
+			data, offset := randomDeltas(rand.New(rand.NewSource(1)), 0)
+			// delta at index i zig-zag encodes as bitN ones
+			for j := i; j < len(data); j++ {
+				data[j] += 1 << (bitN - 1)
+			}
+
+			pack := AppendDeltaEncode(nil, &data, offset)
+			if len(pack) != bitN {
+				t.Fatalf("%d-bit delta packed in %d words", bitN, len(pack))
+			}
+
+			want := make([]Word, bitN)
+			lane := i % laneN
+			for pos := i / laneN * bitN; pos < (i/laneN+1)*bitN; pos++ {
+				want[pos/chunkBitN] |= 1 << (wordWidth - lane*chunkBitN - 1 - pos%chunkBitN)
+			}
+			for w := range want {
+				if pack[w] != want[w] {
+					t.Fatalf("%d-bit delta at index %d packed as %#x, want %#x in lane %d", bitN, i, pack, want, lane)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package pack
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pascaldekloe/wordpack/pack64"
+)
+
+// TestPack64 verifies the stream format against the pack64 package.
+func TestPack64(t *testing.T) {
+	data := make([]int64, 2*PageSize+100)
+	for i := range data {
+		data[i] = int64(i*i) - int64(i%3)<<40
+	}
+	if PageSize != pack64.PageSize {
+		t.Fatalf("page size %d, want %d", PageSize, pack64.PageSize)
+	}
+
+	var got, want bytes.Buffer
+	if err := NewWriter(&got, int64(-7)).Flush(data); err != nil {
+		t.Fatal("flush error:", err)
+	}
+	if err := pack64.NewWriter(&want, int64(-7)).Flush(data); err != nil {
+		t.Fatal("pack64 flush error:", err)
+	}
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Error("stream differs from pack64")
+	}
+}
@@ -0,0 +1,15223 @@
+// Code generated by packgen(1); DO NOT EDIT.
+// Options: -limit 31 -stream -width 32
+// Format: 1
+
+package pack32
+
+import (
+	"io"
+	"math/bits"
+	"unsafe"
+)
+
+// Code generation parameters, as recorded in the file header.
+const (
+	// FormatVersion identifies the encoding rules of packgen(1).
+	// Options such as -interleave alter the encoding too.
+	FormatVersion = 1
+	// PackgenOptions has the command-line options of packgen(1).
+	PackgenOptions = "-limit 31 -stream -width 32"
+
+	// PackLimit is the highest bit size with compression.
+	PackLimit = 31
+	// Interleaved is set when packs have an interleaved bit layout.
+	Interleaved = false
+)
+
+// Integer defines the supported data types.
+type Integer interface {
+	~int16 | ~int32 | ~uint32
+}
+
+// Word is the processing size for bit-packing.
+type Word uint32
+
+// AppendDeltaEncode adds the difference of each consecutive value in src,
+// encoded to dst, and it returns the extended buffer. The first value in src
+// gets compared against offset. Src[0] makes a good offset when first in line.
+// The number of Words added to dst ranges from 0 to 32.
+func AppendDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	// collect bits in use by all deltas (zig-zag encoded) combined
+	d0 := int32(offset - src[0])
+	mask := (d0 << 1) ^ (d0 >> 31)
+	d1 := int32(src[0] - src[1])
+	mask |= d1<<1 ^ d1>>31
+	d2 := int32(src[1] - src[2])
+	mask |= d2<<1 ^ d2>>31
+	d3 := int32(src[2] - src[3])
+	mask |= d3<<1 ^ d3>>31
+	d4 := int32(src[3] - src[4])
+	mask |= d4<<1 ^ d4>>31
+	d5 := int32(src[4] - src[5])
+	mask |= d5<<1 ^ d5>>31
+	d6 := int32(src[5] - src[6])
+	mask |= d6<<1 ^ d6>>31
+	d7 := int32(src[6] - src[7])
+	mask |= d7<<1 ^ d7>>31
+	d8 := int32(src[7] - src[8])
+	mask |= d8<<1 ^ d8>>31
+	d9 := int32(src[8] - src[9])
+	mask |= d9<<1 ^ d9>>31
+	d10 := int32(src[9] - src[10])
+	mask |= d10<<1 ^ d10>>31
+	d11 := int32(src[10] - src[11])
+	mask |= d11<<1 ^ d11>>31
+	d12 := int32(src[11] - src[12])
+	mask |= d12<<1 ^ d12>>31
+	d13 := int32(src[12] - src[13])
+	mask |= d13<<1 ^ d13>>31
+	d14 := int32(src[13] - src[14])
+	mask |= d14<<1 ^ d14>>31
+	d15 := int32(src[14] - src[15])
+	mask |= d15<<1 ^ d15>>31
+	d16 := int32(src[15] - src[16])
+	mask |= d16<<1 ^ d16>>31
+	d17 := int32(src[16] - src[17])
+	mask |= d17<<1 ^ d17>>31
+	d18 := int32(src[17] - src[18])
+	mask |= d18<<1 ^ d18>>31
+	d19 := int32(src[18] - src[19])
+	mask |= d19<<1 ^ d19>>31
+	d20 := int32(src[19] - src[20])
+	mask |= d20<<1 ^ d20>>31
+	d21 := int32(src[20] - src[21])
+	mask |= d21<<1 ^ d21>>31
+	d22 := int32(src[21] - src[22])
+	mask |= d22<<1 ^ d22>>31
+	d23 := int32(src[22] - src[23])
+	mask |= d23<<1 ^ d23>>31
+	d24 := int32(src[23] - src[24])
+	mask |= d24<<1 ^ d24>>31
+	d25 := int32(src[24] - src[25])
+	mask |= d25<<1 ^ d25>>31
+	d26 := int32(src[25] - src[26])
+	mask |= d26<<1 ^ d26>>31
+	d27 := int32(src[26] - src[27])
+	mask |= d27<<1 ^ d27>>31
+	d28 := int32(src[27] - src[28])
+	mask |= d28<<1 ^ d28>>31
+	d29 := int32(src[28] - src[29])
+	mask |= d29<<1 ^ d29>>31
+	d30 := int32(src[29] - src[30])
+	mask |= d30<<1 ^ d30>>31
+	d31 := int32(src[30] - src[31])
+	mask |= d31<<1 ^ d31>>31
+
+	switch bits.Len32(uint32(mask)) {
+	case 0:
+		return dst // nop
+	case 1:
+		return append1BitDeltaEncode(dst, src, offset)
+	case 2:
+		return append2BitDeltaEncode(dst, src, offset)
+	case 3:
+		return append3BitDeltaEncode(dst, src, offset)
+	case 4:
+		return append4BitDeltaEncode(dst, src, offset)
+	case 5:
+		return append5BitDeltaEncode(dst, src, offset)
+	case 6:
+		return append6BitDeltaEncode(dst, src, offset)
+	case 7:
+		return append7BitDeltaEncode(dst, src, offset)
+	case 8:
+		return append8BitDeltaEncode(dst, src, offset)
+	case 9:
+		return append9BitDeltaEncode(dst, src, offset)
+	case 10:
+		return append10BitDeltaEncode(dst, src, offset)
+	case 11:
+		return append11BitDeltaEncode(dst, src, offset)
+	case 12:
+		return append12BitDeltaEncode(dst, src, offset)
+	case 13:
+		return append13BitDeltaEncode(dst, src, offset)
+	case 14:
+		return append14BitDeltaEncode(dst, src, offset)
+	case 15:
+		return append15BitDeltaEncode(dst, src, offset)
+	case 16:
+		return append16BitDeltaEncode(dst, src, offset)
+	case 17:
+		return append17BitDeltaEncode(dst, src, offset)
+	case 18:
+		return append18BitDeltaEncode(dst, src, offset)
+	case 19:
+		return append19BitDeltaEncode(dst, src, offset)
+	case 20:
+		return append20BitDeltaEncode(dst, src, offset)
+	case 21:
+		return append21BitDeltaEncode(dst, src, offset)
+	case 22:
+		return append22BitDeltaEncode(dst, src, offset)
+	case 23:
+		return append23BitDeltaEncode(dst, src, offset)
+	case 24:
+		return append24BitDeltaEncode(dst, src, offset)
+	case 25:
+		return append25BitDeltaEncode(dst, src, offset)
+	case 26:
+		return append26BitDeltaEncode(dst, src, offset)
+	case 27:
+		return append27BitDeltaEncode(dst, src, offset)
+	case 28:
+		return append28BitDeltaEncode(dst, src, offset)
+	case 29:
+		return append29BitDeltaEncode(dst, src, offset)
+	case 30:
+		return append30BitDeltaEncode(dst, src, offset)
+	case 31:
+		return append31BitDeltaEncode(dst, src, offset)
+	default:
+		return append(dst,
+			Word(src[0]),
+			Word(src[1]),
+			Word(src[2]),
+			Word(src[3]),
+			Word(src[4]),
+			Word(src[5]),
+			Word(src[6]),
+			Word(src[7]),
+			Word(src[8]),
+			Word(src[9]),
+			Word(src[10]),
+			Word(src[11]),
+			Word(src[12]),
+			Word(src[13]),
+			Word(src[14]),
+			Word(src[15]),
+			Word(src[16]),
+			Word(src[17]),
+			Word(src[18]),
+			Word(src[19]),
+			Word(src[20]),
+			Word(src[21]),
+			Word(src[22]),
+			Word(src[23]),
+			Word(src[24]),
+			Word(src[25]),
+			Word(src[26]),
+			Word(src[27]),
+			Word(src[28]),
+			Word(src[29]),
+			Word(src[30]),
+			Word(src[31]),
+		)
+	}
+}
+
+// AppendDeltaEncode adds 32 Integers to dst and it returns the extended buffer.
+// The appended Integers are equal to an AppendDeltaEncode's input if src equals
+// the appended Words from the encode, and if both offset values are equal too.
+func AppendDeltaDecode[T Integer](dst []T, src []Word, offset T) []T {
+	switch len(src) {
+	case 0:
+		return append(dst,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+			offset,
+		)
+	case 1:
+		return append1BitDeltaDecode(dst, (*[1]Word)(src), offset)
+	case 2:
+		return append2BitDeltaDecode(dst, (*[2]Word)(src), offset)
+	case 3:
+		return append3BitDeltaDecode(dst, (*[3]Word)(src), offset)
+	case 4:
+		return append4BitDeltaDecode(dst, (*[4]Word)(src), offset)
+	case 5:
+		return append5BitDeltaDecode(dst, (*[5]Word)(src), offset)
+	case 6:
+		return append6BitDeltaDecode(dst, (*[6]Word)(src), offset)
+	case 7:
+		return append7BitDeltaDecode(dst, (*[7]Word)(src), offset)
+	case 8:
+		return append8BitDeltaDecode(dst, (*[8]Word)(src), offset)
+	case 9:
+		return append9BitDeltaDecode(dst, (*[9]Word)(src), offset)
+	case 10:
+		return append10BitDeltaDecode(dst, (*[10]Word)(src), offset)
+	case 11:
+		return append11BitDeltaDecode(dst, (*[11]Word)(src), offset)
+	case 12:
+		return append12BitDeltaDecode(dst, (*[12]Word)(src), offset)
+	case 13:
+		return append13BitDeltaDecode(dst, (*[13]Word)(src), offset)
+	case 14:
+		return append14BitDeltaDecode(dst, (*[14]Word)(src), offset)
+	case 15:
+		return append15BitDeltaDecode(dst, (*[15]Word)(src), offset)
+	case 16:
+		return append16BitDeltaDecode(dst, (*[16]Word)(src), offset)
+	case 17:
+		return append17BitDeltaDecode(dst, (*[17]Word)(src), offset)
+	case 18:
+		return append18BitDeltaDecode(dst, (*[18]Word)(src), offset)
+	case 19:
+		return append19BitDeltaDecode(dst, (*[19]Word)(src), offset)
+	case 20:
+		return append20BitDeltaDecode(dst, (*[20]Word)(src), offset)
+	case 21:
+		return append21BitDeltaDecode(dst, (*[21]Word)(src), offset)
+	case 22:
+		return append22BitDeltaDecode(dst, (*[22]Word)(src), offset)
+	case 23:
+		return append23BitDeltaDecode(dst, (*[23]Word)(src), offset)
+	case 24:
+		return append24BitDeltaDecode(dst, (*[24]Word)(src), offset)
+	case 25:
+		return append25BitDeltaDecode(dst, (*[25]Word)(src), offset)
+	case 26:
+		return append26BitDeltaDecode(dst, (*[26]Word)(src), offset)
+	case 27:
+		return append27BitDeltaDecode(dst, (*[27]Word)(src), offset)
+	case 28:
+		return append28BitDeltaDecode(dst, (*[28]Word)(src), offset)
+	case 29:
+		return append29BitDeltaDecode(dst, (*[29]Word)(src), offset)
+	case 30:
+		return append30BitDeltaDecode(dst, (*[30]Word)(src), offset)
+	case 31:
+		return append31BitDeltaDecode(dst, (*[31]Word)(src), offset)
+	default:
+		return append(dst,
+			T(src[0]),
+			T(src[1]),
+			T(src[2]),
+			T(src[3]),
+			T(src[4]),
+			T(src[5]),
+			T(src[6]),
+			T(src[7]),
+			T(src[8]),
+			T(src[9]),
+			T(src[10]),
+			T(src[11]),
+			T(src[12]),
+			T(src[13]),
+			T(src[14]),
+			T(src[15]),
+			T(src[16]),
+			T(src[17]),
+			T(src[18]),
+			T(src[19]),
+			T(src[20]),
+			T(src[21]),
+			T(src[22]),
+			T(src[23]),
+			T(src[24]),
+			T(src[25]),
+			T(src[26]),
+			T(src[27]),
+			T(src[28]),
+			T(src[29]),
+			T(src[30]),
+			T(src[31]),
+		)
+	}
+}
+
+// AppendDeltaDecodeSelect adds Integers from an AppendDeltaDecode with the same
+// arguments to dst, yet only those selected by mask, and it returns the extended
+// buffer. The Integer at index i in the decoding maps to the mask bit 1 << i.
+func AppendDeltaDecodeSelect[T Integer](dst []T, src []Word, offset T, mask uint32) []T {
+	// unrolled kernels per bit size benchmarked no faster than a decode plus a loop
+	var buf [32]T
+	DecodeInto(&buf, src, offset)
+	for ; mask != 0; mask &= mask - 1 {
+		dst = append(dst, buf[bits.TrailingZeros32(mask)])
+	}
+	return dst
+}
+
+// DecodeInto sets each Integer in dst to the respective AppendDeltaEncode input,
+// given that src equals the appended Words from the encode, and given that both
+// offset values are equal too. DecodeInto does not allocate.
+func DecodeInto[T Integer](dst *[32]T, src []Word, offset T) {
+	switch len(src) {
+	case 0:
+		for i := range dst {
+			dst[i] = offset
+		}
+	case 1:
+		decode1BitDeltaInto(dst, (*[1]Word)(src), offset)
+	case 2:
+		decode2BitDeltaInto(dst, (*[2]Word)(src), offset)
+	case 3:
+		decode3BitDeltaInto(dst, (*[3]Word)(src), offset)
+	case 4:
+		decode4BitDeltaInto(dst, (*[4]Word)(src), offset)
+	case 5:
+		decode5BitDeltaInto(dst, (*[5]Word)(src), offset)
+	case 6:
+		decode6BitDeltaInto(dst, (*[6]Word)(src), offset)
+	case 7:
+		decode7BitDeltaInto(dst, (*[7]Word)(src), offset)
+	case 8:
+		decode8BitDeltaInto(dst, (*[8]Word)(src), offset)
+	case 9:
+		decode9BitDeltaInto(dst, (*[9]Word)(src), offset)
+	case 10:
+		decode10BitDeltaInto(dst, (*[10]Word)(src), offset)
+	case 11:
+		decode11BitDeltaInto(dst, (*[11]Word)(src), offset)
+	case 12:
+		decode12BitDeltaInto(dst, (*[12]Word)(src), offset)
+	case 13:
+		decode13BitDeltaInto(dst, (*[13]Word)(src), offset)
+	case 14:
+		decode14BitDeltaInto(dst, (*[14]Word)(src), offset)
+	case 15:
+		decode15BitDeltaInto(dst, (*[15]Word)(src), offset)
+	case 16:
+		decode16BitDeltaInto(dst, (*[16]Word)(src), offset)
+	case 17:
+		decode17BitDeltaInto(dst, (*[17]Word)(src), offset)
+	case 18:
+		decode18BitDeltaInto(dst, (*[18]Word)(src), offset)
+	case 19:
+		decode19BitDeltaInto(dst, (*[19]Word)(src), offset)
+	case 20:
+		decode20BitDeltaInto(dst, (*[20]Word)(src), offset)
+	case 21:
+		decode21BitDeltaInto(dst, (*[21]Word)(src), offset)
+	case 22:
+		decode22BitDeltaInto(dst, (*[22]Word)(src), offset)
+	case 23:
+		decode23BitDeltaInto(dst, (*[23]Word)(src), offset)
+	case 24:
+		decode24BitDeltaInto(dst, (*[24]Word)(src), offset)
+	case 25:
+		decode25BitDeltaInto(dst, (*[25]Word)(src), offset)
+	case 26:
+		decode26BitDeltaInto(dst, (*[26]Word)(src), offset)
+	case 27:
+		decode27BitDeltaInto(dst, (*[27]Word)(src), offset)
+	case 28:
+		decode28BitDeltaInto(dst, (*[28]Word)(src), offset)
+	case 29:
+		decode29BitDeltaInto(dst, (*[29]Word)(src), offset)
+	case 30:
+		decode30BitDeltaInto(dst, (*[30]Word)(src), offset)
+	case 31:
+		decode31BitDeltaInto(dst, (*[31]Word)(src), offset)
+	default:
+		for i, w := range (*[32]Word)(src) {
+			dst[i] = T(w)
+		}
+	}
+}
+
+// DecodeLast returns the last Integer from an AppendDeltaDecode with the same
+// arguments, without the need to produce any of the other Integers.
+func DecodeLast[T Integer](src []Word, offset T) T {
+	switch len(src) {
+	case 0:
+		return offset
+	case 1:
+		return decode1BitDeltaLast((*[1]Word)(src), offset)
+	case 2:
+		return decode2BitDeltaLast((*[2]Word)(src), offset)
+	case 3:
+		return decode3BitDeltaLast((*[3]Word)(src), offset)
+	case 4:
+		return decode4BitDeltaLast((*[4]Word)(src), offset)
+	case 5:
+		return decode5BitDeltaLast((*[5]Word)(src), offset)
+	case 6:
+		return decode6BitDeltaLast((*[6]Word)(src), offset)
+	case 7:
+		return decode7BitDeltaLast((*[7]Word)(src), offset)
+	case 8:
+		return decode8BitDeltaLast((*[8]Word)(src), offset)
+	case 9:
+		return decode9BitDeltaLast((*[9]Word)(src), offset)
+	case 10:
+		return decode10BitDeltaLast((*[10]Word)(src), offset)
+	case 11:
+		return decode11BitDeltaLast((*[11]Word)(src), offset)
+	case 12:
+		return decode12BitDeltaLast((*[12]Word)(src), offset)
+	case 13:
+		return decode13BitDeltaLast((*[13]Word)(src), offset)
+	case 14:
+		return decode14BitDeltaLast((*[14]Word)(src), offset)
+	case 15:
+		return decode15BitDeltaLast((*[15]Word)(src), offset)
+	case 16:
+		return decode16BitDeltaLast((*[16]Word)(src), offset)
+	case 17:
+		return decode17BitDeltaLast((*[17]Word)(src), offset)
+	case 18:
+		return decode18BitDeltaLast((*[18]Word)(src), offset)
+	case 19:
+		return decode19BitDeltaLast((*[19]Word)(src), offset)
+	case 20:
+		return decode20BitDeltaLast((*[20]Word)(src), offset)
+	case 21:
+		return decode21BitDeltaLast((*[21]Word)(src), offset)
+	case 22:
+		return decode22BitDeltaLast((*[22]Word)(src), offset)
+	case 23:
+		return decode23BitDeltaLast((*[23]Word)(src), offset)
+	case 24:
+		return decode24BitDeltaLast((*[24]Word)(src), offset)
+	case 25:
+		return decode25BitDeltaLast((*[25]Word)(src), offset)
+	case 26:
+		return decode26BitDeltaLast((*[26]Word)(src), offset)
+	case 27:
+		return decode27BitDeltaLast((*[27]Word)(src), offset)
+	case 28:
+		return decode28BitDeltaLast((*[28]Word)(src), offset)
+	case 29:
+		return decode29BitDeltaLast((*[29]Word)(src), offset)
+	case 30:
+		return decode30BitDeltaLast((*[30]Word)(src), offset)
+	case 31:
+		return decode31BitDeltaLast((*[31]Word)(src), offset)
+	default:
+		return T((*[32]Word)(src)[31])
+	}
+}
+
+// SumDelta returns the sum of all Integers from an AppendDeltaDecode with the
+// same arguments, without the need to produce any of them. The sum overflows
+// the same as regular addition on T does. Last equals DecodeLast.
+func SumDelta[T Integer](src []Word, offset T) (sum, last T) {
+	switch len(src) {
+	case 0:
+		return 32 * offset, offset
+	case 1:
+		return sum1BitDelta((*[1]Word)(src), offset)
+	case 2:
+		return sum2BitDelta((*[2]Word)(src), offset)
+	case 3:
+		return sum3BitDelta((*[3]Word)(src), offset)
+	case 4:
+		return sum4BitDelta((*[4]Word)(src), offset)
+	case 5:
+		return sum5BitDelta((*[5]Word)(src), offset)
+	case 6:
+		return sum6BitDelta((*[6]Word)(src), offset)
+	case 7:
+		return sum7BitDelta((*[7]Word)(src), offset)
+	case 8:
+		return sum8BitDelta((*[8]Word)(src), offset)
+	case 9:
+		return sum9BitDelta((*[9]Word)(src), offset)
+	case 10:
+		return sum10BitDelta((*[10]Word)(src), offset)
+	case 11:
+		return sum11BitDelta((*[11]Word)(src), offset)
+	case 12:
+		return sum12BitDelta((*[12]Word)(src), offset)
+	case 13:
+		return sum13BitDelta((*[13]Word)(src), offset)
+	case 14:
+		return sum14BitDelta((*[14]Word)(src), offset)
+	case 15:
+		return sum15BitDelta((*[15]Word)(src), offset)
+	case 16:
+		return sum16BitDelta((*[16]Word)(src), offset)
+	case 17:
+		return sum17BitDelta((*[17]Word)(src), offset)
+	case 18:
+		return sum18BitDelta((*[18]Word)(src), offset)
+	case 19:
+		return sum19BitDelta((*[19]Word)(src), offset)
+	case 20:
+		return sum20BitDelta((*[20]Word)(src), offset)
+	case 21:
+		return sum21BitDelta((*[21]Word)(src), offset)
+	case 22:
+		return sum22BitDelta((*[22]Word)(src), offset)
+	case 23:
+		return sum23BitDelta((*[23]Word)(src), offset)
+	case 24:
+		return sum24BitDelta((*[24]Word)(src), offset)
+	case 25:
+		return sum25BitDelta((*[25]Word)(src), offset)
+	case 26:
+		return sum26BitDelta((*[26]Word)(src), offset)
+	case 27:
+		return sum27BitDelta((*[27]Word)(src), offset)
+	case 28:
+		return sum28BitDelta((*[28]Word)(src), offset)
+	case 29:
+		return sum29BitDelta((*[29]Word)(src), offset)
+	case 30:
+		return sum30BitDelta((*[30]Word)(src), offset)
+	case 31:
+		return sum31BitDelta((*[31]Word)(src), offset)
+	default:
+		for _, w := range (*[32]Word)(src) {
+			sum += T(w)
+		}
+		return sum, T(src[31])
+	}
+}
+
+// RangeMask returns a bit for each Integer from an AppendDeltaDecode with the
+// same arguments, without the need to produce any of them. The Integer at index
+// i in the decoding maps to the mask bit 1 << i. Bits are set for each Integer
+// greater than or equal to min, and less than or equal to max. Last equals
+// DecodeLast.
+func RangeMask[T Integer](src []Word, offset, min, max T) (mask uint32, last T) {
+	switch len(src) {
+	case 0:
+		if offset >= min && offset <= max {
+			return 1<<32 - 1, offset
+		}
+		return 0, offset
+	case 1:
+		return range1BitDelta((*[1]Word)(src), offset, min, max)
+	case 2:
+		return range2BitDelta((*[2]Word)(src), offset, min, max)
+	case 3:
+		return range3BitDelta((*[3]Word)(src), offset, min, max)
+	case 4:
+		return range4BitDelta((*[4]Word)(src), offset, min, max)
+	case 5:
+		return range5BitDelta((*[5]Word)(src), offset, min, max)
+	case 6:
+		return range6BitDelta((*[6]Word)(src), offset, min, max)
+	case 7:
+		return range7BitDelta((*[7]Word)(src), offset, min, max)
+	case 8:
+		return range8BitDelta((*[8]Word)(src), offset, min, max)
+	case 9:
+		return range9BitDelta((*[9]Word)(src), offset, min, max)
+	case 10:
+		return range10BitDelta((*[10]Word)(src), offset, min, max)
+	case 11:
+		return range11BitDelta((*[11]Word)(src), offset, min, max)
+	case 12:
+		return range12BitDelta((*[12]Word)(src), offset, min, max)
+	case 13:
+		return range13BitDelta((*[13]Word)(src), offset, min, max)
+	case 14:
+		return range14BitDelta((*[14]Word)(src), offset, min, max)
+	case 15:
+		return range15BitDelta((*[15]Word)(src), offset, min, max)
+	case 16:
+		return range16BitDelta((*[16]Word)(src), offset, min, max)
+	case 17:
+		return range17BitDelta((*[17]Word)(src), offset, min, max)
+	case 18:
+		return range18BitDelta((*[18]Word)(src), offset, min, max)
+	case 19:
+		return range19BitDelta((*[19]Word)(src), offset, min, max)
+	case 20:
+		return range20BitDelta((*[20]Word)(src), offset, min, max)
+	case 21:
+		return range21BitDelta((*[21]Word)(src), offset, min, max)
+	case 22:
+		return range22BitDelta((*[22]Word)(src), offset, min, max)
+	case 23:
+		return range23BitDelta((*[23]Word)(src), offset, min, max)
+	case 24:
+		return range24BitDelta((*[24]Word)(src), offset, min, max)
+	case 25:
+		return range25BitDelta((*[25]Word)(src), offset, min, max)
+	case 26:
+		return range26BitDelta((*[26]Word)(src), offset, min, max)
+	case 27:
+		return range27BitDelta((*[27]Word)(src), offset, min, max)
+	case 28:
+		return range28BitDelta((*[28]Word)(src), offset, min, max)
+	case 29:
+		return range29BitDelta((*[29]Word)(src), offset, min, max)
+	case 30:
+		return range30BitDelta((*[30]Word)(src), offset, min, max)
+	case 31:
+		return range31BitDelta((*[31]Word)(src), offset, min, max)
+	default:
+		for i, w := range (*[32]Word)(src) {
+			if T(w) >= min && T(w) <= max {
+				mask |= 1 << i
+			}
+		}
+		return mask, T(src[31])
+	}
+}
+
+// CountRange returns the number of Integers from an AppendDeltaDecode with the
+// same arguments, which are greater than or equal to min, and which are less
+// than or equal to max, without the need to produce any of them. Last equals
+// DecodeLast.
+func CountRange[T Integer](src []Word, offset, min, max T) (n int, last T) {
+	mask, last := RangeMask(src, offset, min, max)
+	return bits.OnesCount32(mask), last
+}
+
+// MinMax returns the lowest and the highest Integer from an AppendDeltaDecode
+// with the same arguments.
+func MinMax[T Integer](src []Word, offset T) (min, max T) {
+	// unrolled kernels per bit size benchmarked slower than a decode plus a loop
+	var buf [32]T
+	DecodeInto(&buf, src, offset)
+	min, max = buf[0], buf[0]
+	for _, v := range buf[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max
+}
+
+func append1BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<31|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<30|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<29|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<28|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<27|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<26|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<25|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<23|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<22|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<21|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<20|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<19|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<18|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<17|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<15|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<14|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<13|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<12|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<11|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<10|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<9|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<7|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<6|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<5|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<4|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<3|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<2|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<1|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append2BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<30|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<28|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<26|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<24|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<22|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<20|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<18|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<14|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<12|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<10|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<8|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<6|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<4|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<2|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<30|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<28|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<26|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<24|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<22|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<20|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<18|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<14|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<12|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<10|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<8|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<6|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<4|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<2|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append3BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<29|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<26|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<23|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<20|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<17|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<14|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<11|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<5|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<2|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>1,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<31|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<28|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<25|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<22|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<19|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<13|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<10|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<7|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<4|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<1|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>2,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<30|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<27|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<21|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<18|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<15|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<12|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<9|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<6|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<3|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append4BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<28|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<24|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<20|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<16|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<12|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<8|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<4|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<0,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<28|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<24|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<20|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<16|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<12|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<8|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<4|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<28|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<24|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<20|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<16|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<12|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<8|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<4|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<0,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<28|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<24|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<20|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<16|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<12|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<8|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<4|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append5BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<27|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<22|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<17|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<12|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<7|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<2|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>3,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<29|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<19|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<14|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<9|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<4|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>1,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<31|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<26|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<21|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<11|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<6|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<1|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>4,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<28|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<23|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<18|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<13|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<3|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>2,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<30|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<25|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<20|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<15|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<10|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<5|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append6BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<26|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<20|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<14|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<8|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<2|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>4,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<28|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<22|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<10|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<4|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>2,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<30|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<24|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<18|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<12|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<6|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<26|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<20|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<14|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<8|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<2|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>4,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<28|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<22|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<10|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<4|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>2,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<30|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<24|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<18|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<12|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<6|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append7BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<25|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<18|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<11|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<4|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>3,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<29|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<22|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<15|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<1|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>6,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<26|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<19|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<12|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<5|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>2,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<30|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<23|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<9|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<2|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>5,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<27|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<20|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<13|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<6|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>1,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<31|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<17|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<10|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<3|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>4,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<28|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<21|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<14|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<7|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append8BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<24|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<16|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<8|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<0,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<24|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<16|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<8|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<0,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<24|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<16|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<8|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<0,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<24|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<16|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<8|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<24|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<16|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<8|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<0,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<24|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<16|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<8|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<0,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<24|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<16|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<8|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<0,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<24|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<16|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<8|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append9BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<23|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<14|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<5|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>4,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<28|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<19|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<10|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<1|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>8,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<15|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<6|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>3,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<29|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<20|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<11|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<2|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>7,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<25|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<7|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>2,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<30|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<21|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<12|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<3|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>6,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<26|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<17|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>1,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<31|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<22|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<13|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<4|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>5,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<27|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<18|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<9|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append10BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<22|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<12|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<2|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>8,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<24|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<14|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<4|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>6,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<26|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<6|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>4,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<28|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<18|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<8|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>2,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<30|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<20|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<10|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<22|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<12|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<2|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>8,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<24|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<14|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<4|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>6,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<26|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<6|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>4,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<28|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<18|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<8|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>2,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<30|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<20|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<10|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append11BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<21|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<10|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>1,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<31|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<20|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<9|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>2,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<30|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<19|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>3,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<29|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<18|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<7|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>4,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<28|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<17|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<6|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>5,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<27|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<5|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>6,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<26|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<15|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<4|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>7,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<25|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<14|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<3|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>8,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<13|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<2|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>9,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<23|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<12|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<1|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>10,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<22|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<11|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append12BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<20|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<8|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>4,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<28|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<16|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<4|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>8,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<24|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<12|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<0,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<20|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<8|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>4,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<28|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<16|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<4|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>8,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<24|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<12|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<20|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<8|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>4,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<28|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<16|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<4|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>8,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<24|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<12|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<0,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<20|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<8|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>4,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<28|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<16|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<4|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>8,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<24|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<12|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append13BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<19|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<6|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>7,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<25|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<12|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>1,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<31|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<18|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<5|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>8,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<11|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>2,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<30|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<17|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<4|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>9,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<23|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<10|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>3,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<29|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<3|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>10,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<22|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<9|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>4,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<28|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<15|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<2|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>11,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<21|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>5,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<27|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<14|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<1|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>12,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<20|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<7|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>6,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<26|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<13|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append14BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<18|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<4|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>10,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<22|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<8|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>6,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<26|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<12|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>2,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<30|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<2|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>12,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<20|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<6|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>8,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<24|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<10|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>4,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<28|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<14|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<18|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<4|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>10,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<22|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<8|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>6,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<26|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<12|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>2,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<30|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<2|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>12,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<20|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<6|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>8,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<24|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<10|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>4,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<28|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<14|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append15BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<17|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<2|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>13,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<19|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<4|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>11,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<21|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<6|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>9,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<23|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>7,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<25|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<10|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>5,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<27|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<12|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>3,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<29|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<14|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>1,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<31|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<1|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>14,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<18|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<3|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>12,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<20|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<5|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>10,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<22|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<7|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>8,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<9|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>6,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<26|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<11|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>4,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<28|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<13|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>2,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<30|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<15|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append16BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<16|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<0,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<16|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<0,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<16|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<0,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<16|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<0,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<16|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<0,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<16|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<0,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<16|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<0,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<16|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<16|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<0,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<16|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<0,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<16|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<0,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<16|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<0,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<16|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<0,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<16|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<0,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<16|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<0,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<16|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append17BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<15|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>2,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<30|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<13|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>4,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<28|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<11|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>6,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<26|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<9|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>8,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<7|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>10,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<22|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<5|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>12,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<20|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<3|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>14,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<18|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<1|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>1,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<31|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<14|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>3,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<29|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<12|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>5,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<27|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<10|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>7,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<25|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>9,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<23|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<6|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>11,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<21|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<4|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>13,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<19|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<2|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>15,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<17|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append18BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<14|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>4,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<28|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<10|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>8,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<24|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<6|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>12,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<20|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<2|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>16,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>2,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<30|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<12|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>6,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<26|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<8|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>10,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<22|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<4|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>14,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<18|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<14|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>4,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<28|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<10|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>8,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<24|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<6|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>12,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<20|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<2|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>16,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>2,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<30|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<12|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>6,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<26|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<8|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>10,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<22|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<4|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>14,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<18|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append19BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<13|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>6,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<26|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<7|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>12,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<20|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<1|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>18,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<14|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>5,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<27|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>11,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<21|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<2|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>17,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<15|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>4,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<28|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<9|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>10,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<22|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<3|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>3,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<29|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<10|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>9,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<23|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<4|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>15,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<17|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>2,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<30|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<11|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>8,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<5|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>14,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<18|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>1,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<31|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<12|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>7,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<25|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<6|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>13,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<19|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append20BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<12|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>8,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<24|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<4|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>16,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<16|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>4,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<28|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<8|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>12,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<20|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<0,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<12|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>8,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<24|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<4|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>16,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<16|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>4,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<28|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<8|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>12,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<20|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<12|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>8,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<24|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<4|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>16,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<16|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>4,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<28|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<8|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>12,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<20|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<0,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<12|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>8,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<24|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<4|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>16,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<16|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>4,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<28|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<8|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>12,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<20|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append21BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<11|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>10,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<22|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<1|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>20,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<12|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>9,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<23|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<2|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>19,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<13|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>8,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<3|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>18,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<14|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>7,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<25|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<4|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>17,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<15|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>6,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<26|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<5|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>5,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<27|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<6|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>15,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<17|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>4,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<28|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<7|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>14,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<18|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>3,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<29|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>13,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<19|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>2,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<30|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<9|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>12,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<20|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>1,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<31|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<10|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>11,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<21|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append22BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<10|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>12,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<20|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>2,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<30|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<8|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>14,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<18|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>4,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<28|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<6|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>16,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>6,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<26|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<4|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>18,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<14|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>8,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<24|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<2|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>20,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<12|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>10,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<22|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<10|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>12,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<20|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>2,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<30|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<8|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>14,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<18|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>4,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<28|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<6|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>16,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>6,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<26|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<4|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>18,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<14|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>8,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<24|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<2|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>20,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<12|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>10,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<22|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append23BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<9|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>14,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<18|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>5,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<27|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<4|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>19,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<13|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>10,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<22|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>1,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<31|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>15,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<17|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>6,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<26|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<3|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>20,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<12|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>11,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<21|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>2,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<30|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<7|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>7,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<25|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<2|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>21,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<11|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>12,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<20|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>3,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<29|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<6|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>17,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<15|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>8,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<1|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>22,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<10|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>13,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<19|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>4,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<28|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<5|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>18,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<14|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>9,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<23|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append24BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<8|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>16,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<16|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>8,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<24|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<0,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<8|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>16,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<16|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>8,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<24|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<0,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<8|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>16,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<16|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>8,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<24|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<0,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<8|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>16,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<16|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>8,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<24|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<8|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>16,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<16|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>8,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<24|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<0,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<8|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>16,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<16|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>8,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<24|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<0,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<8|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>16,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<16|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>8,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<24|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<0,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<8|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>16,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<16|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>8,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<24|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append25BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<7|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>18,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<14|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>11,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<21|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>4,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<28|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<3|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>22,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<10|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>15,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<17|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>8,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>1,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<31|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<6|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>19,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<13|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>12,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<20|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>5,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<27|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<2|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>23,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<9|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>9,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<23|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>2,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<30|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<5|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>20,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<12|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>13,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<19|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>6,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<26|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<1|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>24,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>17,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<15|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>10,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<22|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>3,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<29|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<4|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>21,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<11|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>14,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<18|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>7,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<25|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append26BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<6|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>20,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<12|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>14,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<18|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>8,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<24|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>2,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<30|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<4|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>22,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<10|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>16,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>10,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<22|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>4,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<28|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<2|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>24,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<8|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>18,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<14|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>12,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<20|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>6,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<26|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<6|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>20,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<12|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>14,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<18|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>8,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<24|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>2,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<30|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<4|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>22,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<10|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>16,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>10,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<22|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>4,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<28|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<2|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>24,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<8|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>18,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<14|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>12,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<20|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>6,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<26|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append27BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<5|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>22,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<10|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>17,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<15|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>12,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<20|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>7,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<25|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>2,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<30|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<3|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>24,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>19,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<13|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>14,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<18|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>9,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<23|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>4,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<28|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<1|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>26,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<6|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>21,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<11|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>11,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<21|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>6,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<26|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>1,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<31|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<4|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>23,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<9|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>18,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<14|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>13,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<19|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>8,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>3,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<29|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<2|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>25,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<7|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>20,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<12|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>15,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<17|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>10,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<22|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>5,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<27|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append28BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<4|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>24,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<8|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>20,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<12|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>16,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<16|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>12,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<20|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>8,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<24|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>4,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<28|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<0,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<4|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>24,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<8|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>20,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<12|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>16,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<16|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>12,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<20|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>8,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<24|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>4,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<28|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<4|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>24,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<8|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>20,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<12|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>16,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<16|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>12,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<20|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>8,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<24|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>4,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<28|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<0,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<4|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>24,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<8|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>20,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<12|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>16,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<16|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>12,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<20|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>8,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<24|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>4,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<28|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append29BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<3|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>26,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<6|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>23,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<9|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>20,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<12|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>17,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<15|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>14,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<18|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>11,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<21|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>8,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<24|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>5,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<27|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>2,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<30|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<1|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>28,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<4|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>25,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<7|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>22,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<10|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>19,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<13|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>13,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<19|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>10,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<22|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>7,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<25|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>4,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<28|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>1,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<31|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<2|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>27,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<5|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>24,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<8|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>21,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<11|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>18,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<14|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>15,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<17|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>12,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<20|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>9,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<23|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>6,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<26|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>3,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<29|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append30BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<2|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>28,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<4|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>26,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<6|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>24,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<8|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>22,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<10|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>20,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<12|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>18,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<14|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>16,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<16|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>14,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<18|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>12,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<20|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>10,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<22|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>8,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<24|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>6,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<26|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>4,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<28|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>2,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<30|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<0,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<2|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>28,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<4|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>26,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<6|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>24,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<8|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>22,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<10|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>20,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<12|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>18,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<14|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>16,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<16|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>14,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<18|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>12,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<20|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>10,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<22|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>8,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<24|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>6,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<26|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>4,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<28|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>2,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<30|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append31BitDeltaEncode[T Integer](dst []Word, src *[32]T, offset T) []Word {
+	return append(dst,
+		Word(int32(offset-src[0])>>31^int32(offset-src[0])<<1)<<1|Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)>>30,
+		Word(int32(src[0]-src[1])>>31^int32(src[0]-src[1])<<1)<<2|Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)>>29,
+		Word(int32(src[1]-src[2])>>31^int32(src[1]-src[2])<<1)<<3|Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)>>28,
+		Word(int32(src[2]-src[3])>>31^int32(src[2]-src[3])<<1)<<4|Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)>>27,
+		Word(int32(src[3]-src[4])>>31^int32(src[3]-src[4])<<1)<<5|Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)>>26,
+		Word(int32(src[4]-src[5])>>31^int32(src[4]-src[5])<<1)<<6|Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)>>25,
+		Word(int32(src[5]-src[6])>>31^int32(src[5]-src[6])<<1)<<7|Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)>>24,
+		Word(int32(src[6]-src[7])>>31^int32(src[6]-src[7])<<1)<<8|Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)>>23,
+		Word(int32(src[7]-src[8])>>31^int32(src[7]-src[8])<<1)<<9|Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)>>22,
+		Word(int32(src[8]-src[9])>>31^int32(src[8]-src[9])<<1)<<10|Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)>>21,
+		Word(int32(src[9]-src[10])>>31^int32(src[9]-src[10])<<1)<<11|Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)>>20,
+		Word(int32(src[10]-src[11])>>31^int32(src[10]-src[11])<<1)<<12|Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)>>19,
+		Word(int32(src[11]-src[12])>>31^int32(src[11]-src[12])<<1)<<13|Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)>>18,
+		Word(int32(src[12]-src[13])>>31^int32(src[12]-src[13])<<1)<<14|Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)>>17,
+		Word(int32(src[13]-src[14])>>31^int32(src[13]-src[14])<<1)<<15|Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)>>16,
+		Word(int32(src[14]-src[15])>>31^int32(src[14]-src[15])<<1)<<16|Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)>>15,
+		Word(int32(src[15]-src[16])>>31^int32(src[15]-src[16])<<1)<<17|Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)>>14,
+		Word(int32(src[16]-src[17])>>31^int32(src[16]-src[17])<<1)<<18|Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)>>13,
+		Word(int32(src[17]-src[18])>>31^int32(src[17]-src[18])<<1)<<19|Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)>>12,
+		Word(int32(src[18]-src[19])>>31^int32(src[18]-src[19])<<1)<<20|Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)>>11,
+		Word(int32(src[19]-src[20])>>31^int32(src[19]-src[20])<<1)<<21|Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)>>10,
+		Word(int32(src[20]-src[21])>>31^int32(src[20]-src[21])<<1)<<22|Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)>>9,
+		Word(int32(src[21]-src[22])>>31^int32(src[21]-src[22])<<1)<<23|Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)>>8,
+		Word(int32(src[22]-src[23])>>31^int32(src[22]-src[23])<<1)<<24|Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)>>7,
+		Word(int32(src[23]-src[24])>>31^int32(src[23]-src[24])<<1)<<25|Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)>>6,
+		Word(int32(src[24]-src[25])>>31^int32(src[24]-src[25])<<1)<<26|Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)>>5,
+		Word(int32(src[25]-src[26])>>31^int32(src[25]-src[26])<<1)<<27|Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)>>4,
+		Word(int32(src[26]-src[27])>>31^int32(src[26]-src[27])<<1)<<28|Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)>>3,
+		Word(int32(src[27]-src[28])>>31^int32(src[27]-src[28])<<1)<<29|Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)>>2,
+		Word(int32(src[28]-src[29])>>31^int32(src[28]-src[29])<<1)<<30|Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)>>1,
+		Word(int32(src[29]-src[30])>>31^int32(src[29]-src[30])<<1)<<31|Word(int32(src[30]-src[31])>>31^int32(src[30]-src[31])<<1)<<0,
+	)
+}
+
+func append1BitDeltaDecode[T Integer](dst []T, src *[1]Word, offset T) []T {
+	offset -= T(int32(src[0]>>31&0x1)>>1 ^ -(int32(src[0]>>31&0x1) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>30&0x1)>>1 ^ -(int32(src[0]>>30&0x1) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>29&0x1)>>1 ^ -(int32(src[0]>>29&0x1) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>28&0x1)>>1 ^ -(int32(src[0]>>28&0x1) & 1))
+	out3 := offset
+	offset -= T(int32(src[0]>>27&0x1)>>1 ^ -(int32(src[0]>>27&0x1) & 1))
+	out4 := offset
+	offset -= T(int32(src[0]>>26&0x1)>>1 ^ -(int32(src[0]>>26&0x1) & 1))
+	out5 := offset
+	offset -= T(int32(src[0]>>25&0x1)>>1 ^ -(int32(src[0]>>25&0x1) & 1))
+	out6 := offset
+	offset -= T(int32(src[0]>>24&0x1)>>1 ^ -(int32(src[0]>>24&0x1) & 1))
+	out7 := offset
+	offset -= T(int32(src[0]>>23&0x1)>>1 ^ -(int32(src[0]>>23&0x1) & 1))
+	out8 := offset
+	offset -= T(int32(src[0]>>22&0x1)>>1 ^ -(int32(src[0]>>22&0x1) & 1))
+	out9 := offset
+	offset -= T(int32(src[0]>>21&0x1)>>1 ^ -(int32(src[0]>>21&0x1) & 1))
+	out10 := offset
+	offset -= T(int32(src[0]>>20&0x1)>>1 ^ -(int32(src[0]>>20&0x1) & 1))
+	out11 := offset
+	offset -= T(int32(src[0]>>19&0x1)>>1 ^ -(int32(src[0]>>19&0x1) & 1))
+	out12 := offset
+	offset -= T(int32(src[0]>>18&0x1)>>1 ^ -(int32(src[0]>>18&0x1) & 1))
+	out13 := offset
+	offset -= T(int32(src[0]>>17&0x1)>>1 ^ -(int32(src[0]>>17&0x1) & 1))
+	out14 := offset
+	offset -= T(int32(src[0]>>16&0x1)>>1 ^ -(int32(src[0]>>16&0x1) & 1))
+	out15 := offset
+	offset -= T(int32(src[0]>>15&0x1)>>1 ^ -(int32(src[0]>>15&0x1) & 1))
+	out16 := offset
+	offset -= T(int32(src[0]>>14&0x1)>>1 ^ -(int32(src[0]>>14&0x1) & 1))
+	out17 := offset
+	offset -= T(int32(src[0]>>13&0x1)>>1 ^ -(int32(src[0]>>13&0x1) & 1))
+	out18 := offset
+	offset -= T(int32(src[0]>>12&0x1)>>1 ^ -(int32(src[0]>>12&0x1) & 1))
+	out19 := offset
+	offset -= T(int32(src[0]>>11&0x1)>>1 ^ -(int32(src[0]>>11&0x1) & 1))
+	out20 := offset
+	offset -= T(int32(src[0]>>10&0x1)>>1 ^ -(int32(src[0]>>10&0x1) & 1))
+	out21 := offset
+	offset -= T(int32(src[0]>>9&0x1)>>1 ^ -(int32(src[0]>>9&0x1) & 1))
+	out22 := offset
+	offset -= T(int32(src[0]>>8&0x1)>>1 ^ -(int32(src[0]>>8&0x1) & 1))
+	out23 := offset
+	offset -= T(int32(src[0]>>7&0x1)>>1 ^ -(int32(src[0]>>7&0x1) & 1))
+	out24 := offset
+	offset -= T(int32(src[0]>>6&0x1)>>1 ^ -(int32(src[0]>>6&0x1) & 1))
+	out25 := offset
+	offset -= T(int32(src[0]>>5&0x1)>>1 ^ -(int32(src[0]>>5&0x1) & 1))
+	out26 := offset
+	offset -= T(int32(src[0]>>4&0x1)>>1 ^ -(int32(src[0]>>4&0x1) & 1))
+	out27 := offset
+	offset -= T(int32(src[0]>>3&0x1)>>1 ^ -(int32(src[0]>>3&0x1) & 1))
+	out28 := offset
+	offset -= T(int32(src[0]>>2&0x1)>>1 ^ -(int32(src[0]>>2&0x1) & 1))
+	out29 := offset
+	offset -= T(int32(src[0]>>1&0x1)>>1 ^ -(int32(src[0]>>1&0x1) & 1))
+	out30 := offset
+	offset -= T(int32(src[0]>>0&0x1)>>1 ^ -(int32(src[0]>>0&0x1) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append2BitDeltaDecode[T Integer](dst []T, src *[2]Word, offset T) []T {
+	offset -= T(int32(src[0]>>30&0x3)>>1 ^ -(int32(src[0]>>30&0x3) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>28&0x3)>>1 ^ -(int32(src[0]>>28&0x3) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>26&0x3)>>1 ^ -(int32(src[0]>>26&0x3) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>24&0x3)>>1 ^ -(int32(src[0]>>24&0x3) & 1))
+	out3 := offset
+	offset -= T(int32(src[0]>>22&0x3)>>1 ^ -(int32(src[0]>>22&0x3) & 1))
+	out4 := offset
+	offset -= T(int32(src[0]>>20&0x3)>>1 ^ -(int32(src[0]>>20&0x3) & 1))
+	out5 := offset
+	offset -= T(int32(src[0]>>18&0x3)>>1 ^ -(int32(src[0]>>18&0x3) & 1))
+	out6 := offset
+	offset -= T(int32(src[0]>>16&0x3)>>1 ^ -(int32(src[0]>>16&0x3) & 1))
+	out7 := offset
+	offset -= T(int32(src[0]>>14&0x3)>>1 ^ -(int32(src[0]>>14&0x3) & 1))
+	out8 := offset
+	offset -= T(int32(src[0]>>12&0x3)>>1 ^ -(int32(src[0]>>12&0x3) & 1))
+	out9 := offset
+	offset -= T(int32(src[0]>>10&0x3)>>1 ^ -(int32(src[0]>>10&0x3) & 1))
+	out10 := offset
+	offset -= T(int32(src[0]>>8&0x3)>>1 ^ -(int32(src[0]>>8&0x3) & 1))
+	out11 := offset
+	offset -= T(int32(src[0]>>6&0x3)>>1 ^ -(int32(src[0]>>6&0x3) & 1))
+	out12 := offset
+	offset -= T(int32(src[0]>>4&0x3)>>1 ^ -(int32(src[0]>>4&0x3) & 1))
+	out13 := offset
+	offset -= T(int32(src[0]>>2&0x3)>>1 ^ -(int32(src[0]>>2&0x3) & 1))
+	out14 := offset
+	offset -= T(int32(src[0]>>0&0x3)>>1 ^ -(int32(src[0]>>0&0x3) & 1))
+	out15 := offset
+	offset -= T(int32(src[1]>>30&0x3)>>1 ^ -(int32(src[1]>>30&0x3) & 1))
+	out16 := offset
+	offset -= T(int32(src[1]>>28&0x3)>>1 ^ -(int32(src[1]>>28&0x3) & 1))
+	out17 := offset
+	offset -= T(int32(src[1]>>26&0x3)>>1 ^ -(int32(src[1]>>26&0x3) & 1))
+	out18 := offset
+	offset -= T(int32(src[1]>>24&0x3)>>1 ^ -(int32(src[1]>>24&0x3) & 1))
+	out19 := offset
+	offset -= T(int32(src[1]>>22&0x3)>>1 ^ -(int32(src[1]>>22&0x3) & 1))
+	out20 := offset
+	offset -= T(int32(src[1]>>20&0x3)>>1 ^ -(int32(src[1]>>20&0x3) & 1))
+	out21 := offset
+	offset -= T(int32(src[1]>>18&0x3)>>1 ^ -(int32(src[1]>>18&0x3) & 1))
+	out22 := offset
+	offset -= T(int32(src[1]>>16&0x3)>>1 ^ -(int32(src[1]>>16&0x3) & 1))
+	out23 := offset
+	offset -= T(int32(src[1]>>14&0x3)>>1 ^ -(int32(src[1]>>14&0x3) & 1))
+	out24 := offset
+	offset -= T(int32(src[1]>>12&0x3)>>1 ^ -(int32(src[1]>>12&0x3) & 1))
+	out25 := offset
+	offset -= T(int32(src[1]>>10&0x3)>>1 ^ -(int32(src[1]>>10&0x3) & 1))
+	out26 := offset
+	offset -= T(int32(src[1]>>8&0x3)>>1 ^ -(int32(src[1]>>8&0x3) & 1))
+	out27 := offset
+	offset -= T(int32(src[1]>>6&0x3)>>1 ^ -(int32(src[1]>>6&0x3) & 1))
+	out28 := offset
+	offset -= T(int32(src[1]>>4&0x3)>>1 ^ -(int32(src[1]>>4&0x3) & 1))
+	out29 := offset
+	offset -= T(int32(src[1]>>2&0x3)>>1 ^ -(int32(src[1]>>2&0x3) & 1))
+	out30 := offset
+	offset -= T(int32(src[1]>>0&0x3)>>1 ^ -(int32(src[1]>>0&0x3) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append3BitDeltaDecode[T Integer](dst []T, src *[3]Word, offset T) []T {
+	offset -= T(int32(src[0]>>29&0x7)>>1 ^ -(int32(src[0]>>29&0x7) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>26&0x7)>>1 ^ -(int32(src[0]>>26&0x7) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>23&0x7)>>1 ^ -(int32(src[0]>>23&0x7) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>20&0x7)>>1 ^ -(int32(src[0]>>20&0x7) & 1))
+	out3 := offset
+	offset -= T(int32(src[0]>>17&0x7)>>1 ^ -(int32(src[0]>>17&0x7) & 1))
+	out4 := offset
+	offset -= T(int32(src[0]>>14&0x7)>>1 ^ -(int32(src[0]>>14&0x7) & 1))
+	out5 := offset
+	offset -= T(int32(src[0]>>11&0x7)>>1 ^ -(int32(src[0]>>11&0x7) & 1))
+	out6 := offset
+	offset -= T(int32(src[0]>>8&0x7)>>1 ^ -(int32(src[0]>>8&0x7) & 1))
+	out7 := offset
+	offset -= T(int32(src[0]>>5&0x7)>>1 ^ -(int32(src[0]>>5&0x7) & 1))
+	out8 := offset
+	offset -= T(int32(src[0]>>2&0x7)>>1 ^ -(int32(src[0]>>2&0x7) & 1))
+	out9 := offset
+	offset -= T(int32((src[0]<<1&0x6)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x6)|src[1]>>31) & 1))
+	out10 := offset
+	offset -= T(int32(src[1]>>28&0x7)>>1 ^ -(int32(src[1]>>28&0x7) & 1))
+	out11 := offset
+	offset -= T(int32(src[1]>>25&0x7)>>1 ^ -(int32(src[1]>>25&0x7) & 1))
+	out12 := offset
+	offset -= T(int32(src[1]>>22&0x7)>>1 ^ -(int32(src[1]>>22&0x7) & 1))
+	out13 := offset
+	offset -= T(int32(src[1]>>19&0x7)>>1 ^ -(int32(src[1]>>19&0x7) & 1))
+	out14 := offset
+	offset -= T(int32(src[1]>>16&0x7)>>1 ^ -(int32(src[1]>>16&0x7) & 1))
+	out15 := offset
+	offset -= T(int32(src[1]>>13&0x7)>>1 ^ -(int32(src[1]>>13&0x7) & 1))
+	out16 := offset
+	offset -= T(int32(src[1]>>10&0x7)>>1 ^ -(int32(src[1]>>10&0x7) & 1))
+	out17 := offset
+	offset -= T(int32(src[1]>>7&0x7)>>1 ^ -(int32(src[1]>>7&0x7) & 1))
+	out18 := offset
+	offset -= T(int32(src[1]>>4&0x7)>>1 ^ -(int32(src[1]>>4&0x7) & 1))
+	out19 := offset
+	offset -= T(int32(src[1]>>1&0x7)>>1 ^ -(int32(src[1]>>1&0x7) & 1))
+	out20 := offset
+	offset -= T(int32((src[1]<<2&0x4)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x4)|src[2]>>30) & 1))
+	out21 := offset
+	offset -= T(int32(src[2]>>27&0x7)>>1 ^ -(int32(src[2]>>27&0x7) & 1))
+	out22 := offset
+	offset -= T(int32(src[2]>>24&0x7)>>1 ^ -(int32(src[2]>>24&0x7) & 1))
+	out23 := offset
+	offset -= T(int32(src[2]>>21&0x7)>>1 ^ -(int32(src[2]>>21&0x7) & 1))
+	out24 := offset
+	offset -= T(int32(src[2]>>18&0x7)>>1 ^ -(int32(src[2]>>18&0x7) & 1))
+	out25 := offset
+	offset -= T(int32(src[2]>>15&0x7)>>1 ^ -(int32(src[2]>>15&0x7) & 1))
+	out26 := offset
+	offset -= T(int32(src[2]>>12&0x7)>>1 ^ -(int32(src[2]>>12&0x7) & 1))
+	out27 := offset
+	offset -= T(int32(src[2]>>9&0x7)>>1 ^ -(int32(src[2]>>9&0x7) & 1))
+	out28 := offset
+	offset -= T(int32(src[2]>>6&0x7)>>1 ^ -(int32(src[2]>>6&0x7) & 1))
+	out29 := offset
+	offset -= T(int32(src[2]>>3&0x7)>>1 ^ -(int32(src[2]>>3&0x7) & 1))
+	out30 := offset
+	offset -= T(int32(src[2]>>0&0x7)>>1 ^ -(int32(src[2]>>0&0x7) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append4BitDeltaDecode[T Integer](dst []T, src *[4]Word, offset T) []T {
+	offset -= T(int32(src[0]>>28&0xf)>>1 ^ -(int32(src[0]>>28&0xf) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>24&0xf)>>1 ^ -(int32(src[0]>>24&0xf) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>20&0xf)>>1 ^ -(int32(src[0]>>20&0xf) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>16&0xf)>>1 ^ -(int32(src[0]>>16&0xf) & 1))
+	out3 := offset
+	offset -= T(int32(src[0]>>12&0xf)>>1 ^ -(int32(src[0]>>12&0xf) & 1))
+	out4 := offset
+	offset -= T(int32(src[0]>>8&0xf)>>1 ^ -(int32(src[0]>>8&0xf) & 1))
+	out5 := offset
+	offset -= T(int32(src[0]>>4&0xf)>>1 ^ -(int32(src[0]>>4&0xf) & 1))
+	out6 := offset
+	offset -= T(int32(src[0]>>0&0xf)>>1 ^ -(int32(src[0]>>0&0xf) & 1))
+	out7 := offset
+	offset -= T(int32(src[1]>>28&0xf)>>1 ^ -(int32(src[1]>>28&0xf) & 1))
+	out8 := offset
+	offset -= T(int32(src[1]>>24&0xf)>>1 ^ -(int32(src[1]>>24&0xf) & 1))
+	out9 := offset
+	offset -= T(int32(src[1]>>20&0xf)>>1 ^ -(int32(src[1]>>20&0xf) & 1))
+	out10 := offset
+	offset -= T(int32(src[1]>>16&0xf)>>1 ^ -(int32(src[1]>>16&0xf) & 1))
+	out11 := offset
+	offset -= T(int32(src[1]>>12&0xf)>>1 ^ -(int32(src[1]>>12&0xf) & 1))
+	out12 := offset
+	offset -= T(int32(src[1]>>8&0xf)>>1 ^ -(int32(src[1]>>8&0xf) & 1))
+	out13 := offset
+	offset -= T(int32(src[1]>>4&0xf)>>1 ^ -(int32(src[1]>>4&0xf) & 1))
+	out14 := offset
+	offset -= T(int32(src[1]>>0&0xf)>>1 ^ -(int32(src[1]>>0&0xf) & 1))
+	out15 := offset
+	offset -= T(int32(src[2]>>28&0xf)>>1 ^ -(int32(src[2]>>28&0xf) & 1))
+	out16 := offset
+	offset -= T(int32(src[2]>>24&0xf)>>1 ^ -(int32(src[2]>>24&0xf) & 1))
+	out17 := offset
+	offset -= T(int32(src[2]>>20&0xf)>>1 ^ -(int32(src[2]>>20&0xf) & 1))
+	out18 := offset
+	offset -= T(int32(src[2]>>16&0xf)>>1 ^ -(int32(src[2]>>16&0xf) & 1))
+	out19 := offset
+	offset -= T(int32(src[2]>>12&0xf)>>1 ^ -(int32(src[2]>>12&0xf) & 1))
+	out20 := offset
+	offset -= T(int32(src[2]>>8&0xf)>>1 ^ -(int32(src[2]>>8&0xf) & 1))
+	out21 := offset
+	offset -= T(int32(src[2]>>4&0xf)>>1 ^ -(int32(src[2]>>4&0xf) & 1))
+	out22 := offset
+	offset -= T(int32(src[2]>>0&0xf)>>1 ^ -(int32(src[2]>>0&0xf) & 1))
+	out23 := offset
+	offset -= T(int32(src[3]>>28&0xf)>>1 ^ -(int32(src[3]>>28&0xf) & 1))
+	out24 := offset
+	offset -= T(int32(src[3]>>24&0xf)>>1 ^ -(int32(src[3]>>24&0xf) & 1))
+	out25 := offset
+	offset -= T(int32(src[3]>>20&0xf)>>1 ^ -(int32(src[3]>>20&0xf) & 1))
+	out26 := offset
+	offset -= T(int32(src[3]>>16&0xf)>>1 ^ -(int32(src[3]>>16&0xf) & 1))
+	out27 := offset
+	offset -= T(int32(src[3]>>12&0xf)>>1 ^ -(int32(src[3]>>12&0xf) & 1))
+	out28 := offset
+	offset -= T(int32(src[3]>>8&0xf)>>1 ^ -(int32(src[3]>>8&0xf) & 1))
+	out29 := offset
+	offset -= T(int32(src[3]>>4&0xf)>>1 ^ -(int32(src[3]>>4&0xf) & 1))
+	out30 := offset
+	offset -= T(int32(src[3]>>0&0xf)>>1 ^ -(int32(src[3]>>0&0xf) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append5BitDeltaDecode[T Integer](dst []T, src *[5]Word, offset T) []T {
+	offset -= T(int32(src[0]>>27&0x1f)>>1 ^ -(int32(src[0]>>27&0x1f) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>22&0x1f)>>1 ^ -(int32(src[0]>>22&0x1f) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>17&0x1f)>>1 ^ -(int32(src[0]>>17&0x1f) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>12&0x1f)>>1 ^ -(int32(src[0]>>12&0x1f) & 1))
+	out3 := offset
+	offset -= T(int32(src[0]>>7&0x1f)>>1 ^ -(int32(src[0]>>7&0x1f) & 1))
+	out4 := offset
+	offset -= T(int32(src[0]>>2&0x1f)>>1 ^ -(int32(src[0]>>2&0x1f) & 1))
+	out5 := offset
+	offset -= T(int32((src[0]<<3&0x18)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x18)|src[1]>>29) & 1))
+	out6 := offset
+	offset -= T(int32(src[1]>>24&0x1f)>>1 ^ -(int32(src[1]>>24&0x1f) & 1))
+	out7 := offset
+	offset -= T(int32(src[1]>>19&0x1f)>>1 ^ -(int32(src[1]>>19&0x1f) & 1))
+	out8 := offset
+	offset -= T(int32(src[1]>>14&0x1f)>>1 ^ -(int32(src[1]>>14&0x1f) & 1))
+	out9 := offset
+	offset -= T(int32(src[1]>>9&0x1f)>>1 ^ -(int32(src[1]>>9&0x1f) & 1))
+	out10 := offset
+	offset -= T(int32(src[1]>>4&0x1f)>>1 ^ -(int32(src[1]>>4&0x1f) & 1))
+	out11 := offset
+	offset -= T(int32((src[1]<<1&0x1e)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1e)|src[2]>>31) & 1))
+	out12 := offset
+	offset -= T(int32(src[2]>>26&0x1f)>>1 ^ -(int32(src[2]>>26&0x1f) & 1))
+	out13 := offset
+	offset -= T(int32(src[2]>>21&0x1f)>>1 ^ -(int32(src[2]>>21&0x1f) & 1))
+	out14 := offset
+	offset -= T(int32(src[2]>>16&0x1f)>>1 ^ -(int32(src[2]>>16&0x1f) & 1))
+	out15 := offset
+	offset -= T(int32(src[2]>>11&0x1f)>>1 ^ -(int32(src[2]>>11&0x1f) & 1))
+	out16 := offset
+	offset -= T(int32(src[2]>>6&0x1f)>>1 ^ -(int32(src[2]>>6&0x1f) & 1))
+	out17 := offset
+	offset -= T(int32(src[2]>>1&0x1f)>>1 ^ -(int32(src[2]>>1&0x1f) & 1))
+	out18 := offset
+	offset -= T(int32((src[2]<<4&0x10)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x10)|src[3]>>28) & 1))
+	out19 := offset
+	offset -= T(int32(src[3]>>23&0x1f)>>1 ^ -(int32(src[3]>>23&0x1f) & 1))
+	out20 := offset
+	offset -= T(int32(src[3]>>18&0x1f)>>1 ^ -(int32(src[3]>>18&0x1f) & 1))
+	out21 := offset
+	offset -= T(int32(src[3]>>13&0x1f)>>1 ^ -(int32(src[3]>>13&0x1f) & 1))
+	out22 := offset
+	offset -= T(int32(src[3]>>8&0x1f)>>1 ^ -(int32(src[3]>>8&0x1f) & 1))
+	out23 := offset
+	offset -= T(int32(src[3]>>3&0x1f)>>1 ^ -(int32(src[3]>>3&0x1f) & 1))
+	out24 := offset
+	offset -= T(int32((src[3]<<2&0x1c)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1c)|src[4]>>30) & 1))
+	out25 := offset
+	offset -= T(int32(src[4]>>25&0x1f)>>1 ^ -(int32(src[4]>>25&0x1f) & 1))
+	out26 := offset
+	offset -= T(int32(src[4]>>20&0x1f)>>1 ^ -(int32(src[4]>>20&0x1f) & 1))
+	out27 := offset
+	offset -= T(int32(src[4]>>15&0x1f)>>1 ^ -(int32(src[4]>>15&0x1f) & 1))
+	out28 := offset
+	offset -= T(int32(src[4]>>10&0x1f)>>1 ^ -(int32(src[4]>>10&0x1f) & 1))
+	out29 := offset
+	offset -= T(int32(src[4]>>5&0x1f)>>1 ^ -(int32(src[4]>>5&0x1f) & 1))
+	out30 := offset
+	offset -= T(int32(src[4]>>0&0x1f)>>1 ^ -(int32(src[4]>>0&0x1f) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append6BitDeltaDecode[T Integer](dst []T, src *[6]Word, offset T) []T {
+	offset -= T(int32(src[0]>>26&0x3f)>>1 ^ -(int32(src[0]>>26&0x3f) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>20&0x3f)>>1 ^ -(int32(src[0]>>20&0x3f) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>14&0x3f)>>1 ^ -(int32(src[0]>>14&0x3f) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>8&0x3f)>>1 ^ -(int32(src[0]>>8&0x3f) & 1))
+	out3 := offset
+	offset -= T(int32(src[0]>>2&0x3f)>>1 ^ -(int32(src[0]>>2&0x3f) & 1))
+	out4 := offset
+	offset -= T(int32((src[0]<<4&0x30)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x30)|src[1]>>28) & 1))
+	out5 := offset
+	offset -= T(int32(src[1]>>22&0x3f)>>1 ^ -(int32(src[1]>>22&0x3f) & 1))
+	out6 := offset
+	offset -= T(int32(src[1]>>16&0x3f)>>1 ^ -(int32(src[1]>>16&0x3f) & 1))
+	out7 := offset
+	offset -= T(int32(src[1]>>10&0x3f)>>1 ^ -(int32(src[1]>>10&0x3f) & 1))
+	out8 := offset
+	offset -= T(int32(src[1]>>4&0x3f)>>1 ^ -(int32(src[1]>>4&0x3f) & 1))
+	out9 := offset
+	offset -= T(int32((src[1]<<2&0x3c)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3c)|src[2]>>30) & 1))
+	out10 := offset
+	offset -= T(int32(src[2]>>24&0x3f)>>1 ^ -(int32(src[2]>>24&0x3f) & 1))
+	out11 := offset
+	offset -= T(int32(src[2]>>18&0x3f)>>1 ^ -(int32(src[2]>>18&0x3f) & 1))
+	out12 := offset
+	offset -= T(int32(src[2]>>12&0x3f)>>1 ^ -(int32(src[2]>>12&0x3f) & 1))
+	out13 := offset
+	offset -= T(int32(src[2]>>6&0x3f)>>1 ^ -(int32(src[2]>>6&0x3f) & 1))
+	out14 := offset
+	offset -= T(int32(src[2]>>0&0x3f)>>1 ^ -(int32(src[2]>>0&0x3f) & 1))
+	out15 := offset
+	offset -= T(int32(src[3]>>26&0x3f)>>1 ^ -(int32(src[3]>>26&0x3f) & 1))
+	out16 := offset
+	offset -= T(int32(src[3]>>20&0x3f)>>1 ^ -(int32(src[3]>>20&0x3f) & 1))
+	out17 := offset
+	offset -= T(int32(src[3]>>14&0x3f)>>1 ^ -(int32(src[3]>>14&0x3f) & 1))
+	out18 := offset
+	offset -= T(int32(src[3]>>8&0x3f)>>1 ^ -(int32(src[3]>>8&0x3f) & 1))
+	out19 := offset
+	offset -= T(int32(src[3]>>2&0x3f)>>1 ^ -(int32(src[3]>>2&0x3f) & 1))
+	out20 := offset
+	offset -= T(int32((src[3]<<4&0x30)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x30)|src[4]>>28) & 1))
+	out21 := offset
+	offset -= T(int32(src[4]>>22&0x3f)>>1 ^ -(int32(src[4]>>22&0x3f) & 1))
+	out22 := offset
+	offset -= T(int32(src[4]>>16&0x3f)>>1 ^ -(int32(src[4]>>16&0x3f) & 1))
+	out23 := offset
+	offset -= T(int32(src[4]>>10&0x3f)>>1 ^ -(int32(src[4]>>10&0x3f) & 1))
+	out24 := offset
+	offset -= T(int32(src[4]>>4&0x3f)>>1 ^ -(int32(src[4]>>4&0x3f) & 1))
+	out25 := offset
+	offset -= T(int32((src[4]<<2&0x3c)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3c)|src[5]>>30) & 1))
+	out26 := offset
+	offset -= T(int32(src[5]>>24&0x3f)>>1 ^ -(int32(src[5]>>24&0x3f) & 1))
+	out27 := offset
+	offset -= T(int32(src[5]>>18&0x3f)>>1 ^ -(int32(src[5]>>18&0x3f) & 1))
+	out28 := offset
+	offset -= T(int32(src[5]>>12&0x3f)>>1 ^ -(int32(src[5]>>12&0x3f) & 1))
+	out29 := offset
+	offset -= T(int32(src[5]>>6&0x3f)>>1 ^ -(int32(src[5]>>6&0x3f) & 1))
+	out30 := offset
+	offset -= T(int32(src[5]>>0&0x3f)>>1 ^ -(int32(src[5]>>0&0x3f) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append7BitDeltaDecode[T Integer](dst []T, src *[7]Word, offset T) []T {
+	offset -= T(int32(src[0]>>25&0x7f)>>1 ^ -(int32(src[0]>>25&0x7f) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>18&0x7f)>>1 ^ -(int32(src[0]>>18&0x7f) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>11&0x7f)>>1 ^ -(int32(src[0]>>11&0x7f) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>4&0x7f)>>1 ^ -(int32(src[0]>>4&0x7f) & 1))
+	out3 := offset
+	offset -= T(int32((src[0]<<3&0x78)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x78)|src[1]>>29) & 1))
+	out4 := offset
+	offset -= T(int32(src[1]>>22&0x7f)>>1 ^ -(int32(src[1]>>22&0x7f) & 1))
+	out5 := offset
+	offset -= T(int32(src[1]>>15&0x7f)>>1 ^ -(int32(src[1]>>15&0x7f) & 1))
+	out6 := offset
+	offset -= T(int32(src[1]>>8&0x7f)>>1 ^ -(int32(src[1]>>8&0x7f) & 1))
+	out7 := offset
+	offset -= T(int32(src[1]>>1&0x7f)>>1 ^ -(int32(src[1]>>1&0x7f) & 1))
+	out8 := offset
+	offset -= T(int32((src[1]<<6&0x40)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x40)|src[2]>>26) & 1))
+	out9 := offset
+	offset -= T(int32(src[2]>>19&0x7f)>>1 ^ -(int32(src[2]>>19&0x7f) & 1))
+	out10 := offset
+	offset -= T(int32(src[2]>>12&0x7f)>>1 ^ -(int32(src[2]>>12&0x7f) & 1))
+	out11 := offset
+	offset -= T(int32(src[2]>>5&0x7f)>>1 ^ -(int32(src[2]>>5&0x7f) & 1))
+	out12 := offset
+	offset -= T(int32((src[2]<<2&0x7c)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x7c)|src[3]>>30) & 1))
+	out13 := offset
+	offset -= T(int32(src[3]>>23&0x7f)>>1 ^ -(int32(src[3]>>23&0x7f) & 1))
+	out14 := offset
+	offset -= T(int32(src[3]>>16&0x7f)>>1 ^ -(int32(src[3]>>16&0x7f) & 1))
+	out15 := offset
+	offset -= T(int32(src[3]>>9&0x7f)>>1 ^ -(int32(src[3]>>9&0x7f) & 1))
+	out16 := offset
+	offset -= T(int32(src[3]>>2&0x7f)>>1 ^ -(int32(src[3]>>2&0x7f) & 1))
+	out17 := offset
+	offset -= T(int32((src[3]<<5&0x60)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x60)|src[4]>>27) & 1))
+	out18 := offset
+	offset -= T(int32(src[4]>>20&0x7f)>>1 ^ -(int32(src[4]>>20&0x7f) & 1))
+	out19 := offset
+	offset -= T(int32(src[4]>>13&0x7f)>>1 ^ -(int32(src[4]>>13&0x7f) & 1))
+	out20 := offset
+	offset -= T(int32(src[4]>>6&0x7f)>>1 ^ -(int32(src[4]>>6&0x7f) & 1))
+	out21 := offset
+	offset -= T(int32((src[4]<<1&0x7e)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7e)|src[5]>>31) & 1))
+	out22 := offset
+	offset -= T(int32(src[5]>>24&0x7f)>>1 ^ -(int32(src[5]>>24&0x7f) & 1))
+	out23 := offset
+	offset -= T(int32(src[5]>>17&0x7f)>>1 ^ -(int32(src[5]>>17&0x7f) & 1))
+	out24 := offset
+	offset -= T(int32(src[5]>>10&0x7f)>>1 ^ -(int32(src[5]>>10&0x7f) & 1))
+	out25 := offset
+	offset -= T(int32(src[5]>>3&0x7f)>>1 ^ -(int32(src[5]>>3&0x7f) & 1))
+	out26 := offset
+	offset -= T(int32((src[5]<<4&0x70)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x70)|src[6]>>28) & 1))
+	out27 := offset
+	offset -= T(int32(src[6]>>21&0x7f)>>1 ^ -(int32(src[6]>>21&0x7f) & 1))
+	out28 := offset
+	offset -= T(int32(src[6]>>14&0x7f)>>1 ^ -(int32(src[6]>>14&0x7f) & 1))
+	out29 := offset
+	offset -= T(int32(src[6]>>7&0x7f)>>1 ^ -(int32(src[6]>>7&0x7f) & 1))
+	out30 := offset
+	offset -= T(int32(src[6]>>0&0x7f)>>1 ^ -(int32(src[6]>>0&0x7f) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append8BitDeltaDecode[T Integer](dst []T, src *[8]Word, offset T) []T {
+	offset -= T(int32(src[0]>>24&0xff)>>1 ^ -(int32(src[0]>>24&0xff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>16&0xff)>>1 ^ -(int32(src[0]>>16&0xff) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>8&0xff)>>1 ^ -(int32(src[0]>>8&0xff) & 1))
+	out2 := offset
+	offset -= T(int32(src[0]>>0&0xff)>>1 ^ -(int32(src[0]>>0&0xff) & 1))
+	out3 := offset
+	offset -= T(int32(src[1]>>24&0xff)>>1 ^ -(int32(src[1]>>24&0xff) & 1))
+	out4 := offset
+	offset -= T(int32(src[1]>>16&0xff)>>1 ^ -(int32(src[1]>>16&0xff) & 1))
+	out5 := offset
+	offset -= T(int32(src[1]>>8&0xff)>>1 ^ -(int32(src[1]>>8&0xff) & 1))
+	out6 := offset
+	offset -= T(int32(src[1]>>0&0xff)>>1 ^ -(int32(src[1]>>0&0xff) & 1))
+	out7 := offset
+	offset -= T(int32(src[2]>>24&0xff)>>1 ^ -(int32(src[2]>>24&0xff) & 1))
+	out8 := offset
+	offset -= T(int32(src[2]>>16&0xff)>>1 ^ -(int32(src[2]>>16&0xff) & 1))
+	out9 := offset
+	offset -= T(int32(src[2]>>8&0xff)>>1 ^ -(int32(src[2]>>8&0xff) & 1))
+	out10 := offset
+	offset -= T(int32(src[2]>>0&0xff)>>1 ^ -(int32(src[2]>>0&0xff) & 1))
+	out11 := offset
+	offset -= T(int32(src[3]>>24&0xff)>>1 ^ -(int32(src[3]>>24&0xff) & 1))
+	out12 := offset
+	offset -= T(int32(src[3]>>16&0xff)>>1 ^ -(int32(src[3]>>16&0xff) & 1))
+	out13 := offset
+	offset -= T(int32(src[3]>>8&0xff)>>1 ^ -(int32(src[3]>>8&0xff) & 1))
+	out14 := offset
+	offset -= T(int32(src[3]>>0&0xff)>>1 ^ -(int32(src[3]>>0&0xff) & 1))
+	out15 := offset
+	offset -= T(int32(src[4]>>24&0xff)>>1 ^ -(int32(src[4]>>24&0xff) & 1))
+	out16 := offset
+	offset -= T(int32(src[4]>>16&0xff)>>1 ^ -(int32(src[4]>>16&0xff) & 1))
+	out17 := offset
+	offset -= T(int32(src[4]>>8&0xff)>>1 ^ -(int32(src[4]>>8&0xff) & 1))
+	out18 := offset
+	offset -= T(int32(src[4]>>0&0xff)>>1 ^ -(int32(src[4]>>0&0xff) & 1))
+	out19 := offset
+	offset -= T(int32(src[5]>>24&0xff)>>1 ^ -(int32(src[5]>>24&0xff) & 1))
+	out20 := offset
+	offset -= T(int32(src[5]>>16&0xff)>>1 ^ -(int32(src[5]>>16&0xff) & 1))
+	out21 := offset
+	offset -= T(int32(src[5]>>8&0xff)>>1 ^ -(int32(src[5]>>8&0xff) & 1))
+	out22 := offset
+	offset -= T(int32(src[5]>>0&0xff)>>1 ^ -(int32(src[5]>>0&0xff) & 1))
+	out23 := offset
+	offset -= T(int32(src[6]>>24&0xff)>>1 ^ -(int32(src[6]>>24&0xff) & 1))
+	out24 := offset
+	offset -= T(int32(src[6]>>16&0xff)>>1 ^ -(int32(src[6]>>16&0xff) & 1))
+	out25 := offset
+	offset -= T(int32(src[6]>>8&0xff)>>1 ^ -(int32(src[6]>>8&0xff) & 1))
+	out26 := offset
+	offset -= T(int32(src[6]>>0&0xff)>>1 ^ -(int32(src[6]>>0&0xff) & 1))
+	out27 := offset
+	offset -= T(int32(src[7]>>24&0xff)>>1 ^ -(int32(src[7]>>24&0xff) & 1))
+	out28 := offset
+	offset -= T(int32(src[7]>>16&0xff)>>1 ^ -(int32(src[7]>>16&0xff) & 1))
+	out29 := offset
+	offset -= T(int32(src[7]>>8&0xff)>>1 ^ -(int32(src[7]>>8&0xff) & 1))
+	out30 := offset
+	offset -= T(int32(src[7]>>0&0xff)>>1 ^ -(int32(src[7]>>0&0xff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append9BitDeltaDecode[T Integer](dst []T, src *[9]Word, offset T) []T {
+	offset -= T(int32(src[0]>>23&0x1ff)>>1 ^ -(int32(src[0]>>23&0x1ff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>14&0x1ff)>>1 ^ -(int32(src[0]>>14&0x1ff) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>5&0x1ff)>>1 ^ -(int32(src[0]>>5&0x1ff) & 1))
+	out2 := offset
+	offset -= T(int32((src[0]<<4&0x1f0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x1f0)|src[1]>>28) & 1))
+	out3 := offset
+	offset -= T(int32(src[1]>>19&0x1ff)>>1 ^ -(int32(src[1]>>19&0x1ff) & 1))
+	out4 := offset
+	offset -= T(int32(src[1]>>10&0x1ff)>>1 ^ -(int32(src[1]>>10&0x1ff) & 1))
+	out5 := offset
+	offset -= T(int32(src[1]>>1&0x1ff)>>1 ^ -(int32(src[1]>>1&0x1ff) & 1))
+	out6 := offset
+	offset -= T(int32((src[1]<<8&0x100)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x100)|src[2]>>24) & 1))
+	out7 := offset
+	offset -= T(int32(src[2]>>15&0x1ff)>>1 ^ -(int32(src[2]>>15&0x1ff) & 1))
+	out8 := offset
+	offset -= T(int32(src[2]>>6&0x1ff)>>1 ^ -(int32(src[2]>>6&0x1ff) & 1))
+	out9 := offset
+	offset -= T(int32((src[2]<<3&0x1f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x1f8)|src[3]>>29) & 1))
+	out10 := offset
+	offset -= T(int32(src[3]>>20&0x1ff)>>1 ^ -(int32(src[3]>>20&0x1ff) & 1))
+	out11 := offset
+	offset -= T(int32(src[3]>>11&0x1ff)>>1 ^ -(int32(src[3]>>11&0x1ff) & 1))
+	out12 := offset
+	offset -= T(int32(src[3]>>2&0x1ff)>>1 ^ -(int32(src[3]>>2&0x1ff) & 1))
+	out13 := offset
+	offset -= T(int32((src[3]<<7&0x180)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x180)|src[4]>>25) & 1))
+	out14 := offset
+	offset -= T(int32(src[4]>>16&0x1ff)>>1 ^ -(int32(src[4]>>16&0x1ff) & 1))
+	out15 := offset
+	offset -= T(int32(src[4]>>7&0x1ff)>>1 ^ -(int32(src[4]>>7&0x1ff) & 1))
+	out16 := offset
+	offset -= T(int32((src[4]<<2&0x1fc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x1fc)|src[5]>>30) & 1))
+	out17 := offset
+	offset -= T(int32(src[5]>>21&0x1ff)>>1 ^ -(int32(src[5]>>21&0x1ff) & 1))
+	out18 := offset
+	offset -= T(int32(src[5]>>12&0x1ff)>>1 ^ -(int32(src[5]>>12&0x1ff) & 1))
+	out19 := offset
+	offset -= T(int32(src[5]>>3&0x1ff)>>1 ^ -(int32(src[5]>>3&0x1ff) & 1))
+	out20 := offset
+	offset -= T(int32((src[5]<<6&0x1c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x1c0)|src[6]>>26) & 1))
+	out21 := offset
+	offset -= T(int32(src[6]>>17&0x1ff)>>1 ^ -(int32(src[6]>>17&0x1ff) & 1))
+	out22 := offset
+	offset -= T(int32(src[6]>>8&0x1ff)>>1 ^ -(int32(src[6]>>8&0x1ff) & 1))
+	out23 := offset
+	offset -= T(int32((src[6]<<1&0x1fe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fe)|src[7]>>31) & 1))
+	out24 := offset
+	offset -= T(int32(src[7]>>22&0x1ff)>>1 ^ -(int32(src[7]>>22&0x1ff) & 1))
+	out25 := offset
+	offset -= T(int32(src[7]>>13&0x1ff)>>1 ^ -(int32(src[7]>>13&0x1ff) & 1))
+	out26 := offset
+	offset -= T(int32(src[7]>>4&0x1ff)>>1 ^ -(int32(src[7]>>4&0x1ff) & 1))
+	out27 := offset
+	offset -= T(int32((src[7]<<5&0x1e0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1e0)|src[8]>>27) & 1))
+	out28 := offset
+	offset -= T(int32(src[8]>>18&0x1ff)>>1 ^ -(int32(src[8]>>18&0x1ff) & 1))
+	out29 := offset
+	offset -= T(int32(src[8]>>9&0x1ff)>>1 ^ -(int32(src[8]>>9&0x1ff) & 1))
+	out30 := offset
+	offset -= T(int32(src[8]>>0&0x1ff)>>1 ^ -(int32(src[8]>>0&0x1ff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append10BitDeltaDecode[T Integer](dst []T, src *[10]Word, offset T) []T {
+	offset -= T(int32(src[0]>>22&0x3ff)>>1 ^ -(int32(src[0]>>22&0x3ff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>12&0x3ff)>>1 ^ -(int32(src[0]>>12&0x3ff) & 1))
+	out1 := offset
+	offset -= T(int32(src[0]>>2&0x3ff)>>1 ^ -(int32(src[0]>>2&0x3ff) & 1))
+	out2 := offset
+	offset -= T(int32((src[0]<<8&0x300)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0x300)|src[1]>>24) & 1))
+	out3 := offset
+	offset -= T(int32(src[1]>>14&0x3ff)>>1 ^ -(int32(src[1]>>14&0x3ff) & 1))
+	out4 := offset
+	offset -= T(int32(src[1]>>4&0x3ff)>>1 ^ -(int32(src[1]>>4&0x3ff) & 1))
+	out5 := offset
+	offset -= T(int32((src[1]<<6&0x3c0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3c0)|src[2]>>26) & 1))
+	out6 := offset
+	offset -= T(int32(src[2]>>16&0x3ff)>>1 ^ -(int32(src[2]>>16&0x3ff) & 1))
+	out7 := offset
+	offset -= T(int32(src[2]>>6&0x3ff)>>1 ^ -(int32(src[2]>>6&0x3ff) & 1))
+	out8 := offset
+	offset -= T(int32((src[2]<<4&0x3f0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x3f0)|src[3]>>28) & 1))
+	out9 := offset
+	offset -= T(int32(src[3]>>18&0x3ff)>>1 ^ -(int32(src[3]>>18&0x3ff) & 1))
+	out10 := offset
+	offset -= T(int32(src[3]>>8&0x3ff)>>1 ^ -(int32(src[3]>>8&0x3ff) & 1))
+	out11 := offset
+	offset -= T(int32((src[3]<<2&0x3fc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fc)|src[4]>>30) & 1))
+	out12 := offset
+	offset -= T(int32(src[4]>>20&0x3ff)>>1 ^ -(int32(src[4]>>20&0x3ff) & 1))
+	out13 := offset
+	offset -= T(int32(src[4]>>10&0x3ff)>>1 ^ -(int32(src[4]>>10&0x3ff) & 1))
+	out14 := offset
+	offset -= T(int32(src[4]>>0&0x3ff)>>1 ^ -(int32(src[4]>>0&0x3ff) & 1))
+	out15 := offset
+	offset -= T(int32(src[5]>>22&0x3ff)>>1 ^ -(int32(src[5]>>22&0x3ff) & 1))
+	out16 := offset
+	offset -= T(int32(src[5]>>12&0x3ff)>>1 ^ -(int32(src[5]>>12&0x3ff) & 1))
+	out17 := offset
+	offset -= T(int32(src[5]>>2&0x3ff)>>1 ^ -(int32(src[5]>>2&0x3ff) & 1))
+	out18 := offset
+	offset -= T(int32((src[5]<<8&0x300)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x300)|src[6]>>24) & 1))
+	out19 := offset
+	offset -= T(int32(src[6]>>14&0x3ff)>>1 ^ -(int32(src[6]>>14&0x3ff) & 1))
+	out20 := offset
+	offset -= T(int32(src[6]>>4&0x3ff)>>1 ^ -(int32(src[6]>>4&0x3ff) & 1))
+	out21 := offset
+	offset -= T(int32((src[6]<<6&0x3c0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x3c0)|src[7]>>26) & 1))
+	out22 := offset
+	offset -= T(int32(src[7]>>16&0x3ff)>>1 ^ -(int32(src[7]>>16&0x3ff) & 1))
+	out23 := offset
+	offset -= T(int32(src[7]>>6&0x3ff)>>1 ^ -(int32(src[7]>>6&0x3ff) & 1))
+	out24 := offset
+	offset -= T(int32((src[7]<<4&0x3f0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3f0)|src[8]>>28) & 1))
+	out25 := offset
+	offset -= T(int32(src[8]>>18&0x3ff)>>1 ^ -(int32(src[8]>>18&0x3ff) & 1))
+	out26 := offset
+	offset -= T(int32(src[8]>>8&0x3ff)>>1 ^ -(int32(src[8]>>8&0x3ff) & 1))
+	out27 := offset
+	offset -= T(int32((src[8]<<2&0x3fc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x3fc)|src[9]>>30) & 1))
+	out28 := offset
+	offset -= T(int32(src[9]>>20&0x3ff)>>1 ^ -(int32(src[9]>>20&0x3ff) & 1))
+	out29 := offset
+	offset -= T(int32(src[9]>>10&0x3ff)>>1 ^ -(int32(src[9]>>10&0x3ff) & 1))
+	out30 := offset
+	offset -= T(int32(src[9]>>0&0x3ff)>>1 ^ -(int32(src[9]>>0&0x3ff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append11BitDeltaDecode[T Integer](dst []T, src *[11]Word, offset T) []T {
+	offset -= T(int32(src[0]>>21&0x7ff)>>1 ^ -(int32(src[0]>>21&0x7ff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>10&0x7ff)>>1 ^ -(int32(src[0]>>10&0x7ff) & 1))
+	out1 := offset
+	offset -= T(int32((src[0]<<1&0x7fe)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x7fe)|src[1]>>31) & 1))
+	out2 := offset
+	offset -= T(int32(src[1]>>20&0x7ff)>>1 ^ -(int32(src[1]>>20&0x7ff) & 1))
+	out3 := offset
+	offset -= T(int32(src[1]>>9&0x7ff)>>1 ^ -(int32(src[1]>>9&0x7ff) & 1))
+	out4 := offset
+	offset -= T(int32((src[1]<<2&0x7fc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x7fc)|src[2]>>30) & 1))
+	out5 := offset
+	offset -= T(int32(src[2]>>19&0x7ff)>>1 ^ -(int32(src[2]>>19&0x7ff) & 1))
+	out6 := offset
+	offset -= T(int32(src[2]>>8&0x7ff)>>1 ^ -(int32(src[2]>>8&0x7ff) & 1))
+	out7 := offset
+	offset -= T(int32((src[2]<<3&0x7f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x7f8)|src[3]>>29) & 1))
+	out8 := offset
+	offset -= T(int32(src[3]>>18&0x7ff)>>1 ^ -(int32(src[3]>>18&0x7ff) & 1))
+	out9 := offset
+	offset -= T(int32(src[3]>>7&0x7ff)>>1 ^ -(int32(src[3]>>7&0x7ff) & 1))
+	out10 := offset
+	offset -= T(int32((src[3]<<4&0x7f0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x7f0)|src[4]>>28) & 1))
+	out11 := offset
+	offset -= T(int32(src[4]>>17&0x7ff)>>1 ^ -(int32(src[4]>>17&0x7ff) & 1))
+	out12 := offset
+	offset -= T(int32(src[4]>>6&0x7ff)>>1 ^ -(int32(src[4]>>6&0x7ff) & 1))
+	out13 := offset
+	offset -= T(int32((src[4]<<5&0x7e0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7e0)|src[5]>>27) & 1))
+	out14 := offset
+	offset -= T(int32(src[5]>>16&0x7ff)>>1 ^ -(int32(src[5]>>16&0x7ff) & 1))
+	out15 := offset
+	offset -= T(int32(src[5]>>5&0x7ff)>>1 ^ -(int32(src[5]>>5&0x7ff) & 1))
+	out16 := offset
+	offset -= T(int32((src[5]<<6&0x7c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x7c0)|src[6]>>26) & 1))
+	out17 := offset
+	offset -= T(int32(src[6]>>15&0x7ff)>>1 ^ -(int32(src[6]>>15&0x7ff) & 1))
+	out18 := offset
+	offset -= T(int32(src[6]>>4&0x7ff)>>1 ^ -(int32(src[6]>>4&0x7ff) & 1))
+	out19 := offset
+	offset -= T(int32((src[6]<<7&0x780)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x780)|src[7]>>25) & 1))
+	out20 := offset
+	offset -= T(int32(src[7]>>14&0x7ff)>>1 ^ -(int32(src[7]>>14&0x7ff) & 1))
+	out21 := offset
+	offset -= T(int32(src[7]>>3&0x7ff)>>1 ^ -(int32(src[7]>>3&0x7ff) & 1))
+	out22 := offset
+	offset -= T(int32((src[7]<<8&0x700)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x700)|src[8]>>24) & 1))
+	out23 := offset
+	offset -= T(int32(src[8]>>13&0x7ff)>>1 ^ -(int32(src[8]>>13&0x7ff) & 1))
+	out24 := offset
+	offset -= T(int32(src[8]>>2&0x7ff)>>1 ^ -(int32(src[8]>>2&0x7ff) & 1))
+	out25 := offset
+	offset -= T(int32((src[8]<<9&0x600)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x600)|src[9]>>23) & 1))
+	out26 := offset
+	offset -= T(int32(src[9]>>12&0x7ff)>>1 ^ -(int32(src[9]>>12&0x7ff) & 1))
+	out27 := offset
+	offset -= T(int32(src[9]>>1&0x7ff)>>1 ^ -(int32(src[9]>>1&0x7ff) & 1))
+	out28 := offset
+	offset -= T(int32((src[9]<<10&0x400)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x400)|src[10]>>22) & 1))
+	out29 := offset
+	offset -= T(int32(src[10]>>11&0x7ff)>>1 ^ -(int32(src[10]>>11&0x7ff) & 1))
+	out30 := offset
+	offset -= T(int32(src[10]>>0&0x7ff)>>1 ^ -(int32(src[10]>>0&0x7ff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append12BitDeltaDecode[T Integer](dst []T, src *[12]Word, offset T) []T {
+	offset -= T(int32(src[0]>>20&0xfff)>>1 ^ -(int32(src[0]>>20&0xfff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>8&0xfff)>>1 ^ -(int32(src[0]>>8&0xfff) & 1))
+	out1 := offset
+	offset -= T(int32((src[0]<<4&0xff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0xff0)|src[1]>>28) & 1))
+	out2 := offset
+	offset -= T(int32(src[1]>>16&0xfff)>>1 ^ -(int32(src[1]>>16&0xfff) & 1))
+	out3 := offset
+	offset -= T(int32(src[1]>>4&0xfff)>>1 ^ -(int32(src[1]>>4&0xfff) & 1))
+	out4 := offset
+	offset -= T(int32((src[1]<<8&0xf00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xf00)|src[2]>>24) & 1))
+	out5 := offset
+	offset -= T(int32(src[2]>>12&0xfff)>>1 ^ -(int32(src[2]>>12&0xfff) & 1))
+	out6 := offset
+	offset -= T(int32(src[2]>>0&0xfff)>>1 ^ -(int32(src[2]>>0&0xfff) & 1))
+	out7 := offset
+	offset -= T(int32(src[3]>>20&0xfff)>>1 ^ -(int32(src[3]>>20&0xfff) & 1))
+	out8 := offset
+	offset -= T(int32(src[3]>>8&0xfff)>>1 ^ -(int32(src[3]>>8&0xfff) & 1))
+	out9 := offset
+	offset -= T(int32((src[3]<<4&0xff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0xff0)|src[4]>>28) & 1))
+	out10 := offset
+	offset -= T(int32(src[4]>>16&0xfff)>>1 ^ -(int32(src[4]>>16&0xfff) & 1))
+	out11 := offset
+	offset -= T(int32(src[4]>>4&0xfff)>>1 ^ -(int32(src[4]>>4&0xfff) & 1))
+	out12 := offset
+	offset -= T(int32((src[4]<<8&0xf00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xf00)|src[5]>>24) & 1))
+	out13 := offset
+	offset -= T(int32(src[5]>>12&0xfff)>>1 ^ -(int32(src[5]>>12&0xfff) & 1))
+	out14 := offset
+	offset -= T(int32(src[5]>>0&0xfff)>>1 ^ -(int32(src[5]>>0&0xfff) & 1))
+	out15 := offset
+	offset -= T(int32(src[6]>>20&0xfff)>>1 ^ -(int32(src[6]>>20&0xfff) & 1))
+	out16 := offset
+	offset -= T(int32(src[6]>>8&0xfff)>>1 ^ -(int32(src[6]>>8&0xfff) & 1))
+	out17 := offset
+	offset -= T(int32((src[6]<<4&0xff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0xff0)|src[7]>>28) & 1))
+	out18 := offset
+	offset -= T(int32(src[7]>>16&0xfff)>>1 ^ -(int32(src[7]>>16&0xfff) & 1))
+	out19 := offset
+	offset -= T(int32(src[7]>>4&0xfff)>>1 ^ -(int32(src[7]>>4&0xfff) & 1))
+	out20 := offset
+	offset -= T(int32((src[7]<<8&0xf00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xf00)|src[8]>>24) & 1))
+	out21 := offset
+	offset -= T(int32(src[8]>>12&0xfff)>>1 ^ -(int32(src[8]>>12&0xfff) & 1))
+	out22 := offset
+	offset -= T(int32(src[8]>>0&0xfff)>>1 ^ -(int32(src[8]>>0&0xfff) & 1))
+	out23 := offset
+	offset -= T(int32(src[9]>>20&0xfff)>>1 ^ -(int32(src[9]>>20&0xfff) & 1))
+	out24 := offset
+	offset -= T(int32(src[9]>>8&0xfff)>>1 ^ -(int32(src[9]>>8&0xfff) & 1))
+	out25 := offset
+	offset -= T(int32((src[9]<<4&0xff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0xff0)|src[10]>>28) & 1))
+	out26 := offset
+	offset -= T(int32(src[10]>>16&0xfff)>>1 ^ -(int32(src[10]>>16&0xfff) & 1))
+	out27 := offset
+	offset -= T(int32(src[10]>>4&0xfff)>>1 ^ -(int32(src[10]>>4&0xfff) & 1))
+	out28 := offset
+	offset -= T(int32((src[10]<<8&0xf00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xf00)|src[11]>>24) & 1))
+	out29 := offset
+	offset -= T(int32(src[11]>>12&0xfff)>>1 ^ -(int32(src[11]>>12&0xfff) & 1))
+	out30 := offset
+	offset -= T(int32(src[11]>>0&0xfff)>>1 ^ -(int32(src[11]>>0&0xfff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append13BitDeltaDecode[T Integer](dst []T, src *[13]Word, offset T) []T {
+	offset -= T(int32(src[0]>>19&0x1fff)>>1 ^ -(int32(src[0]>>19&0x1fff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>6&0x1fff)>>1 ^ -(int32(src[0]>>6&0x1fff) & 1))
+	out1 := offset
+	offset -= T(int32((src[0]<<7&0x1f80)|src[1]>>25)>>1 ^ -(int32((src[0]<<7&0x1f80)|src[1]>>25) & 1))
+	out2 := offset
+	offset -= T(int32(src[1]>>12&0x1fff)>>1 ^ -(int32(src[1]>>12&0x1fff) & 1))
+	out3 := offset
+	offset -= T(int32((src[1]<<1&0x1ffe)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1ffe)|src[2]>>31) & 1))
+	out4 := offset
+	offset -= T(int32(src[2]>>18&0x1fff)>>1 ^ -(int32(src[2]>>18&0x1fff) & 1))
+	out5 := offset
+	offset -= T(int32(src[2]>>5&0x1fff)>>1 ^ -(int32(src[2]>>5&0x1fff) & 1))
+	out6 := offset
+	offset -= T(int32((src[2]<<8&0x1f00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x1f00)|src[3]>>24) & 1))
+	out7 := offset
+	offset -= T(int32(src[3]>>11&0x1fff)>>1 ^ -(int32(src[3]>>11&0x1fff) & 1))
+	out8 := offset
+	offset -= T(int32((src[3]<<2&0x1ffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1ffc)|src[4]>>30) & 1))
+	out9 := offset
+	offset -= T(int32(src[4]>>17&0x1fff)>>1 ^ -(int32(src[4]>>17&0x1fff) & 1))
+	out10 := offset
+	offset -= T(int32(src[4]>>4&0x1fff)>>1 ^ -(int32(src[4]>>4&0x1fff) & 1))
+	out11 := offset
+	offset -= T(int32((src[4]<<9&0x1e00)|src[5]>>23)>>1 ^ -(int32((src[4]<<9&0x1e00)|src[5]>>23) & 1))
+	out12 := offset
+	offset -= T(int32(src[5]>>10&0x1fff)>>1 ^ -(int32(src[5]>>10&0x1fff) & 1))
+	out13 := offset
+	offset -= T(int32((src[5]<<3&0x1ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x1ff8)|src[6]>>29) & 1))
+	out14 := offset
+	offset -= T(int32(src[6]>>16&0x1fff)>>1 ^ -(int32(src[6]>>16&0x1fff) & 1))
+	out15 := offset
+	offset -= T(int32(src[6]>>3&0x1fff)>>1 ^ -(int32(src[6]>>3&0x1fff) & 1))
+	out16 := offset
+	offset -= T(int32((src[6]<<10&0x1c00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x1c00)|src[7]>>22) & 1))
+	out17 := offset
+	offset -= T(int32(src[7]>>9&0x1fff)>>1 ^ -(int32(src[7]>>9&0x1fff) & 1))
+	out18 := offset
+	offset -= T(int32((src[7]<<4&0x1ff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x1ff0)|src[8]>>28) & 1))
+	out19 := offset
+	offset -= T(int32(src[8]>>15&0x1fff)>>1 ^ -(int32(src[8]>>15&0x1fff) & 1))
+	out20 := offset
+	offset -= T(int32(src[8]>>2&0x1fff)>>1 ^ -(int32(src[8]>>2&0x1fff) & 1))
+	out21 := offset
+	offset -= T(int32((src[8]<<11&0x1800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x1800)|src[9]>>21) & 1))
+	out22 := offset
+	offset -= T(int32(src[9]>>8&0x1fff)>>1 ^ -(int32(src[9]>>8&0x1fff) & 1))
+	out23 := offset
+	offset -= T(int32((src[9]<<5&0x1fe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1fe0)|src[10]>>27) & 1))
+	out24 := offset
+	offset -= T(int32(src[10]>>14&0x1fff)>>1 ^ -(int32(src[10]>>14&0x1fff) & 1))
+	out25 := offset
+	offset -= T(int32(src[10]>>1&0x1fff)>>1 ^ -(int32(src[10]>>1&0x1fff) & 1))
+	out26 := offset
+	offset -= T(int32((src[10]<<12&0x1000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x1000)|src[11]>>20) & 1))
+	out27 := offset
+	offset -= T(int32(src[11]>>7&0x1fff)>>1 ^ -(int32(src[11]>>7&0x1fff) & 1))
+	out28 := offset
+	offset -= T(int32((src[11]<<6&0x1fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x1fc0)|src[12]>>26) & 1))
+	out29 := offset
+	offset -= T(int32(src[12]>>13&0x1fff)>>1 ^ -(int32(src[12]>>13&0x1fff) & 1))
+	out30 := offset
+	offset -= T(int32(src[12]>>0&0x1fff)>>1 ^ -(int32(src[12]>>0&0x1fff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append14BitDeltaDecode[T Integer](dst []T, src *[14]Word, offset T) []T {
+	offset -= T(int32(src[0]>>18&0x3fff)>>1 ^ -(int32(src[0]>>18&0x3fff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>4&0x3fff)>>1 ^ -(int32(src[0]>>4&0x3fff) & 1))
+	out1 := offset
+	offset -= T(int32((src[0]<<10&0x3c00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x3c00)|src[1]>>22) & 1))
+	out2 := offset
+	offset -= T(int32(src[1]>>8&0x3fff)>>1 ^ -(int32(src[1]>>8&0x3fff) & 1))
+	out3 := offset
+	offset -= T(int32((src[1]<<6&0x3fc0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3fc0)|src[2]>>26) & 1))
+	out4 := offset
+	offset -= T(int32(src[2]>>12&0x3fff)>>1 ^ -(int32(src[2]>>12&0x3fff) & 1))
+	out5 := offset
+	offset -= T(int32((src[2]<<2&0x3ffc)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x3ffc)|src[3]>>30) & 1))
+	out6 := offset
+	offset -= T(int32(src[3]>>16&0x3fff)>>1 ^ -(int32(src[3]>>16&0x3fff) & 1))
+	out7 := offset
+	offset -= T(int32(src[3]>>2&0x3fff)>>1 ^ -(int32(src[3]>>2&0x3fff) & 1))
+	out8 := offset
+	offset -= T(int32((src[3]<<12&0x3000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0x3000)|src[4]>>20) & 1))
+	out9 := offset
+	offset -= T(int32(src[4]>>6&0x3fff)>>1 ^ -(int32(src[4]>>6&0x3fff) & 1))
+	out10 := offset
+	offset -= T(int32((src[4]<<8&0x3f00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x3f00)|src[5]>>24) & 1))
+	out11 := offset
+	offset -= T(int32(src[5]>>10&0x3fff)>>1 ^ -(int32(src[5]>>10&0x3fff) & 1))
+	out12 := offset
+	offset -= T(int32((src[5]<<4&0x3ff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x3ff0)|src[6]>>28) & 1))
+	out13 := offset
+	offset -= T(int32(src[6]>>14&0x3fff)>>1 ^ -(int32(src[6]>>14&0x3fff) & 1))
+	out14 := offset
+	offset -= T(int32(src[6]>>0&0x3fff)>>1 ^ -(int32(src[6]>>0&0x3fff) & 1))
+	out15 := offset
+	offset -= T(int32(src[7]>>18&0x3fff)>>1 ^ -(int32(src[7]>>18&0x3fff) & 1))
+	out16 := offset
+	offset -= T(int32(src[7]>>4&0x3fff)>>1 ^ -(int32(src[7]>>4&0x3fff) & 1))
+	out17 := offset
+	offset -= T(int32((src[7]<<10&0x3c00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x3c00)|src[8]>>22) & 1))
+	out18 := offset
+	offset -= T(int32(src[8]>>8&0x3fff)>>1 ^ -(int32(src[8]>>8&0x3fff) & 1))
+	out19 := offset
+	offset -= T(int32((src[8]<<6&0x3fc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x3fc0)|src[9]>>26) & 1))
+	out20 := offset
+	offset -= T(int32(src[9]>>12&0x3fff)>>1 ^ -(int32(src[9]>>12&0x3fff) & 1))
+	out21 := offset
+	offset -= T(int32((src[9]<<2&0x3ffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x3ffc)|src[10]>>30) & 1))
+	out22 := offset
+	offset -= T(int32(src[10]>>16&0x3fff)>>1 ^ -(int32(src[10]>>16&0x3fff) & 1))
+	out23 := offset
+	offset -= T(int32(src[10]>>2&0x3fff)>>1 ^ -(int32(src[10]>>2&0x3fff) & 1))
+	out24 := offset
+	offset -= T(int32((src[10]<<12&0x3000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3000)|src[11]>>20) & 1))
+	out25 := offset
+	offset -= T(int32(src[11]>>6&0x3fff)>>1 ^ -(int32(src[11]>>6&0x3fff) & 1))
+	out26 := offset
+	offset -= T(int32((src[11]<<8&0x3f00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0x3f00)|src[12]>>24) & 1))
+	out27 := offset
+	offset -= T(int32(src[12]>>10&0x3fff)>>1 ^ -(int32(src[12]>>10&0x3fff) & 1))
+	out28 := offset
+	offset -= T(int32((src[12]<<4&0x3ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ff0)|src[13]>>28) & 1))
+	out29 := offset
+	offset -= T(int32(src[13]>>14&0x3fff)>>1 ^ -(int32(src[13]>>14&0x3fff) & 1))
+	out30 := offset
+	offset -= T(int32(src[13]>>0&0x3fff)>>1 ^ -(int32(src[13]>>0&0x3fff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append15BitDeltaDecode[T Integer](dst []T, src *[15]Word, offset T) []T {
+	offset -= T(int32(src[0]>>17&0x7fff)>>1 ^ -(int32(src[0]>>17&0x7fff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>2&0x7fff)>>1 ^ -(int32(src[0]>>2&0x7fff) & 1))
+	out1 := offset
+	offset -= T(int32((src[0]<<13&0x6000)|src[1]>>19)>>1 ^ -(int32((src[0]<<13&0x6000)|src[1]>>19) & 1))
+	out2 := offset
+	offset -= T(int32(src[1]>>4&0x7fff)>>1 ^ -(int32(src[1]>>4&0x7fff) & 1))
+	out3 := offset
+	offset -= T(int32((src[1]<<11&0x7800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x7800)|src[2]>>21) & 1))
+	out4 := offset
+	offset -= T(int32(src[2]>>6&0x7fff)>>1 ^ -(int32(src[2]>>6&0x7fff) & 1))
+	out5 := offset
+	offset -= T(int32((src[2]<<9&0x7e00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x7e00)|src[3]>>23) & 1))
+	out6 := offset
+	offset -= T(int32(src[3]>>8&0x7fff)>>1 ^ -(int32(src[3]>>8&0x7fff) & 1))
+	out7 := offset
+	offset -= T(int32((src[3]<<7&0x7f80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7f80)|src[4]>>25) & 1))
+	out8 := offset
+	offset -= T(int32(src[4]>>10&0x7fff)>>1 ^ -(int32(src[4]>>10&0x7fff) & 1))
+	out9 := offset
+	offset -= T(int32((src[4]<<5&0x7fe0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7fe0)|src[5]>>27) & 1))
+	out10 := offset
+	offset -= T(int32(src[5]>>12&0x7fff)>>1 ^ -(int32(src[5]>>12&0x7fff) & 1))
+	out11 := offset
+	offset -= T(int32((src[5]<<3&0x7ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x7ff8)|src[6]>>29) & 1))
+	out12 := offset
+	offset -= T(int32(src[6]>>14&0x7fff)>>1 ^ -(int32(src[6]>>14&0x7fff) & 1))
+	out13 := offset
+	offset -= T(int32((src[6]<<1&0x7ffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x7ffe)|src[7]>>31) & 1))
+	out14 := offset
+	offset -= T(int32(src[7]>>16&0x7fff)>>1 ^ -(int32(src[7]>>16&0x7fff) & 1))
+	out15 := offset
+	offset -= T(int32(src[7]>>1&0x7fff)>>1 ^ -(int32(src[7]>>1&0x7fff) & 1))
+	out16 := offset
+	offset -= T(int32((src[7]<<14&0x4000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x4000)|src[8]>>18) & 1))
+	out17 := offset
+	offset -= T(int32(src[8]>>3&0x7fff)>>1 ^ -(int32(src[8]>>3&0x7fff) & 1))
+	out18 := offset
+	offset -= T(int32((src[8]<<12&0x7000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x7000)|src[9]>>20) & 1))
+	out19 := offset
+	offset -= T(int32(src[9]>>5&0x7fff)>>1 ^ -(int32(src[9]>>5&0x7fff) & 1))
+	out20 := offset
+	offset -= T(int32((src[9]<<10&0x7c00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x7c00)|src[10]>>22) & 1))
+	out21 := offset
+	offset -= T(int32(src[10]>>7&0x7fff)>>1 ^ -(int32(src[10]>>7&0x7fff) & 1))
+	out22 := offset
+	offset -= T(int32((src[10]<<8&0x7f00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x7f00)|src[11]>>24) & 1))
+	out23 := offset
+	offset -= T(int32(src[11]>>9&0x7fff)>>1 ^ -(int32(src[11]>>9&0x7fff) & 1))
+	out24 := offset
+	offset -= T(int32((src[11]<<6&0x7fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x7fc0)|src[12]>>26) & 1))
+	out25 := offset
+	offset -= T(int32(src[12]>>11&0x7fff)>>1 ^ -(int32(src[12]>>11&0x7fff) & 1))
+	out26 := offset
+	offset -= T(int32((src[12]<<4&0x7ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x7ff0)|src[13]>>28) & 1))
+	out27 := offset
+	offset -= T(int32(src[13]>>13&0x7fff)>>1 ^ -(int32(src[13]>>13&0x7fff) & 1))
+	out28 := offset
+	offset -= T(int32((src[13]<<2&0x7ffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x7ffc)|src[14]>>30) & 1))
+	out29 := offset
+	offset -= T(int32(src[14]>>15&0x7fff)>>1 ^ -(int32(src[14]>>15&0x7fff) & 1))
+	out30 := offset
+	offset -= T(int32(src[14]>>0&0x7fff)>>1 ^ -(int32(src[14]>>0&0x7fff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append16BitDeltaDecode[T Integer](dst []T, src *[16]Word, offset T) []T {
+	offset -= T(int32(src[0]>>16&0xffff)>>1 ^ -(int32(src[0]>>16&0xffff) & 1))
+	out0 := offset
+	offset -= T(int32(src[0]>>0&0xffff)>>1 ^ -(int32(src[0]>>0&0xffff) & 1))
+	out1 := offset
+	offset -= T(int32(src[1]>>16&0xffff)>>1 ^ -(int32(src[1]>>16&0xffff) & 1))
+	out2 := offset
+	offset -= T(int32(src[1]>>0&0xffff)>>1 ^ -(int32(src[1]>>0&0xffff) & 1))
+	out3 := offset
+	offset -= T(int32(src[2]>>16&0xffff)>>1 ^ -(int32(src[2]>>16&0xffff) & 1))
+	out4 := offset
+	offset -= T(int32(src[2]>>0&0xffff)>>1 ^ -(int32(src[2]>>0&0xffff) & 1))
+	out5 := offset
+	offset -= T(int32(src[3]>>16&0xffff)>>1 ^ -(int32(src[3]>>16&0xffff) & 1))
+	out6 := offset
+	offset -= T(int32(src[3]>>0&0xffff)>>1 ^ -(int32(src[3]>>0&0xffff) & 1))
+	out7 := offset
+	offset -= T(int32(src[4]>>16&0xffff)>>1 ^ -(int32(src[4]>>16&0xffff) & 1))
+	out8 := offset
+	offset -= T(int32(src[4]>>0&0xffff)>>1 ^ -(int32(src[4]>>0&0xffff) & 1))
+	out9 := offset
+	offset -= T(int32(src[5]>>16&0xffff)>>1 ^ -(int32(src[5]>>16&0xffff) & 1))
+	out10 := offset
+	offset -= T(int32(src[5]>>0&0xffff)>>1 ^ -(int32(src[5]>>0&0xffff) & 1))
+	out11 := offset
+	offset -= T(int32(src[6]>>16&0xffff)>>1 ^ -(int32(src[6]>>16&0xffff) & 1))
+	out12 := offset
+	offset -= T(int32(src[6]>>0&0xffff)>>1 ^ -(int32(src[6]>>0&0xffff) & 1))
+	out13 := offset
+	offset -= T(int32(src[7]>>16&0xffff)>>1 ^ -(int32(src[7]>>16&0xffff) & 1))
+	out14 := offset
+	offset -= T(int32(src[7]>>0&0xffff)>>1 ^ -(int32(src[7]>>0&0xffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[8]>>16&0xffff)>>1 ^ -(int32(src[8]>>16&0xffff) & 1))
+	out16 := offset
+	offset -= T(int32(src[8]>>0&0xffff)>>1 ^ -(int32(src[8]>>0&0xffff) & 1))
+	out17 := offset
+	offset -= T(int32(src[9]>>16&0xffff)>>1 ^ -(int32(src[9]>>16&0xffff) & 1))
+	out18 := offset
+	offset -= T(int32(src[9]>>0&0xffff)>>1 ^ -(int32(src[9]>>0&0xffff) & 1))
+	out19 := offset
+	offset -= T(int32(src[10]>>16&0xffff)>>1 ^ -(int32(src[10]>>16&0xffff) & 1))
+	out20 := offset
+	offset -= T(int32(src[10]>>0&0xffff)>>1 ^ -(int32(src[10]>>0&0xffff) & 1))
+	out21 := offset
+	offset -= T(int32(src[11]>>16&0xffff)>>1 ^ -(int32(src[11]>>16&0xffff) & 1))
+	out22 := offset
+	offset -= T(int32(src[11]>>0&0xffff)>>1 ^ -(int32(src[11]>>0&0xffff) & 1))
+	out23 := offset
+	offset -= T(int32(src[12]>>16&0xffff)>>1 ^ -(int32(src[12]>>16&0xffff) & 1))
+	out24 := offset
+	offset -= T(int32(src[12]>>0&0xffff)>>1 ^ -(int32(src[12]>>0&0xffff) & 1))
+	out25 := offset
+	offset -= T(int32(src[13]>>16&0xffff)>>1 ^ -(int32(src[13]>>16&0xffff) & 1))
+	out26 := offset
+	offset -= T(int32(src[13]>>0&0xffff)>>1 ^ -(int32(src[13]>>0&0xffff) & 1))
+	out27 := offset
+	offset -= T(int32(src[14]>>16&0xffff)>>1 ^ -(int32(src[14]>>16&0xffff) & 1))
+	out28 := offset
+	offset -= T(int32(src[14]>>0&0xffff)>>1 ^ -(int32(src[14]>>0&0xffff) & 1))
+	out29 := offset
+	offset -= T(int32(src[15]>>16&0xffff)>>1 ^ -(int32(src[15]>>16&0xffff) & 1))
+	out30 := offset
+	offset -= T(int32(src[15]>>0&0xffff)>>1 ^ -(int32(src[15]>>0&0xffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append17BitDeltaDecode[T Integer](dst []T, src *[17]Word, offset T) []T {
+	offset -= T(int32(src[0]>>15&0x1ffff)>>1 ^ -(int32(src[0]>>15&0x1ffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<2&0x1fffc)|src[1]>>30)>>1 ^ -(int32((src[0]<<2&0x1fffc)|src[1]>>30) & 1))
+	out1 := offset
+	offset -= T(int32(src[1]>>13&0x1ffff)>>1 ^ -(int32(src[1]>>13&0x1ffff) & 1))
+	out2 := offset
+	offset -= T(int32((src[1]<<4&0x1fff0)|src[2]>>28)>>1 ^ -(int32((src[1]<<4&0x1fff0)|src[2]>>28) & 1))
+	out3 := offset
+	offset -= T(int32(src[2]>>11&0x1ffff)>>1 ^ -(int32(src[2]>>11&0x1ffff) & 1))
+	out4 := offset
+	offset -= T(int32((src[2]<<6&0x1ffc0)|src[3]>>26)>>1 ^ -(int32((src[2]<<6&0x1ffc0)|src[3]>>26) & 1))
+	out5 := offset
+	offset -= T(int32(src[3]>>9&0x1ffff)>>1 ^ -(int32(src[3]>>9&0x1ffff) & 1))
+	out6 := offset
+	offset -= T(int32((src[3]<<8&0x1ff00)|src[4]>>24)>>1 ^ -(int32((src[3]<<8&0x1ff00)|src[4]>>24) & 1))
+	out7 := offset
+	offset -= T(int32(src[4]>>7&0x1ffff)>>1 ^ -(int32(src[4]>>7&0x1ffff) & 1))
+	out8 := offset
+	offset -= T(int32((src[4]<<10&0x1fc00)|src[5]>>22)>>1 ^ -(int32((src[4]<<10&0x1fc00)|src[5]>>22) & 1))
+	out9 := offset
+	offset -= T(int32(src[5]>>5&0x1ffff)>>1 ^ -(int32(src[5]>>5&0x1ffff) & 1))
+	out10 := offset
+	offset -= T(int32((src[5]<<12&0x1f000)|src[6]>>20)>>1 ^ -(int32((src[5]<<12&0x1f000)|src[6]>>20) & 1))
+	out11 := offset
+	offset -= T(int32(src[6]>>3&0x1ffff)>>1 ^ -(int32(src[6]>>3&0x1ffff) & 1))
+	out12 := offset
+	offset -= T(int32((src[6]<<14&0x1c000)|src[7]>>18)>>1 ^ -(int32((src[6]<<14&0x1c000)|src[7]>>18) & 1))
+	out13 := offset
+	offset -= T(int32(src[7]>>1&0x1ffff)>>1 ^ -(int32(src[7]>>1&0x1ffff) & 1))
+	out14 := offset
+	offset -= T(int32((src[7]<<16&0x10000)|src[8]>>16)>>1 ^ -(int32((src[7]<<16&0x10000)|src[8]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[8]<<1&0x1fffe)|src[9]>>31)>>1 ^ -(int32((src[8]<<1&0x1fffe)|src[9]>>31) & 1))
+	out16 := offset
+	offset -= T(int32(src[9]>>14&0x1ffff)>>1 ^ -(int32(src[9]>>14&0x1ffff) & 1))
+	out17 := offset
+	offset -= T(int32((src[9]<<3&0x1fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x1fff8)|src[10]>>29) & 1))
+	out18 := offset
+	offset -= T(int32(src[10]>>12&0x1ffff)>>1 ^ -(int32(src[10]>>12&0x1ffff) & 1))
+	out19 := offset
+	offset -= T(int32((src[10]<<5&0x1ffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1ffe0)|src[11]>>27) & 1))
+	out20 := offset
+	offset -= T(int32(src[11]>>10&0x1ffff)>>1 ^ -(int32(src[11]>>10&0x1ffff) & 1))
+	out21 := offset
+	offset -= T(int32((src[11]<<7&0x1ff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x1ff80)|src[12]>>25) & 1))
+	out22 := offset
+	offset -= T(int32(src[12]>>8&0x1ffff)>>1 ^ -(int32(src[12]>>8&0x1ffff) & 1))
+	out23 := offset
+	offset -= T(int32((src[12]<<9&0x1fe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fe00)|src[13]>>23) & 1))
+	out24 := offset
+	offset -= T(int32(src[13]>>6&0x1ffff)>>1 ^ -(int32(src[13]>>6&0x1ffff) & 1))
+	out25 := offset
+	offset -= T(int32((src[13]<<11&0x1f800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x1f800)|src[14]>>21) & 1))
+	out26 := offset
+	offset -= T(int32(src[14]>>4&0x1ffff)>>1 ^ -(int32(src[14]>>4&0x1ffff) & 1))
+	out27 := offset
+	offset -= T(int32((src[14]<<13&0x1e000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1e000)|src[15]>>19) & 1))
+	out28 := offset
+	offset -= T(int32(src[15]>>2&0x1ffff)>>1 ^ -(int32(src[15]>>2&0x1ffff) & 1))
+	out29 := offset
+	offset -= T(int32((src[15]<<15&0x18000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x18000)|src[16]>>17) & 1))
+	out30 := offset
+	offset -= T(int32(src[16]>>0&0x1ffff)>>1 ^ -(int32(src[16]>>0&0x1ffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append18BitDeltaDecode[T Integer](dst []T, src *[18]Word, offset T) []T {
+	offset -= T(int32(src[0]>>14&0x3ffff)>>1 ^ -(int32(src[0]>>14&0x3ffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<4&0x3fff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x3fff0)|src[1]>>28) & 1))
+	out1 := offset
+	offset -= T(int32(src[1]>>10&0x3ffff)>>1 ^ -(int32(src[1]>>10&0x3ffff) & 1))
+	out2 := offset
+	offset -= T(int32((src[1]<<8&0x3ff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x3ff00)|src[2]>>24) & 1))
+	out3 := offset
+	offset -= T(int32(src[2]>>6&0x3ffff)>>1 ^ -(int32(src[2]>>6&0x3ffff) & 1))
+	out4 := offset
+	offset -= T(int32((src[2]<<12&0x3f000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x3f000)|src[3]>>20) & 1))
+	out5 := offset
+	offset -= T(int32(src[3]>>2&0x3ffff)>>1 ^ -(int32(src[3]>>2&0x3ffff) & 1))
+	out6 := offset
+	offset -= T(int32((src[3]<<16&0x30000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0x30000)|src[4]>>16) & 1))
+	out7 := offset
+	offset -= T(int32((src[4]<<2&0x3fffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3fffc)|src[5]>>30) & 1))
+	out8 := offset
+	offset -= T(int32(src[5]>>12&0x3ffff)>>1 ^ -(int32(src[5]>>12&0x3ffff) & 1))
+	out9 := offset
+	offset -= T(int32((src[5]<<6&0x3ffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3ffc0)|src[6]>>26) & 1))
+	out10 := offset
+	offset -= T(int32(src[6]>>8&0x3ffff)>>1 ^ -(int32(src[6]>>8&0x3ffff) & 1))
+	out11 := offset
+	offset -= T(int32((src[6]<<10&0x3fc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fc00)|src[7]>>22) & 1))
+	out12 := offset
+	offset -= T(int32(src[7]>>4&0x3ffff)>>1 ^ -(int32(src[7]>>4&0x3ffff) & 1))
+	out13 := offset
+	offset -= T(int32((src[7]<<14&0x3c000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3c000)|src[8]>>18) & 1))
+	out14 := offset
+	offset -= T(int32(src[8]>>0&0x3ffff)>>1 ^ -(int32(src[8]>>0&0x3ffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[9]>>14&0x3ffff)>>1 ^ -(int32(src[9]>>14&0x3ffff) & 1))
+	out16 := offset
+	offset -= T(int32((src[9]<<4&0x3fff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x3fff0)|src[10]>>28) & 1))
+	out17 := offset
+	offset -= T(int32(src[10]>>10&0x3ffff)>>1 ^ -(int32(src[10]>>10&0x3ffff) & 1))
+	out18 := offset
+	offset -= T(int32((src[10]<<8&0x3ff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3ff00)|src[11]>>24) & 1))
+	out19 := offset
+	offset -= T(int32(src[11]>>6&0x3ffff)>>1 ^ -(int32(src[11]>>6&0x3ffff) & 1))
+	out20 := offset
+	offset -= T(int32((src[11]<<12&0x3f000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3f000)|src[12]>>20) & 1))
+	out21 := offset
+	offset -= T(int32(src[12]>>2&0x3ffff)>>1 ^ -(int32(src[12]>>2&0x3ffff) & 1))
+	out22 := offset
+	offset -= T(int32((src[12]<<16&0x30000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x30000)|src[13]>>16) & 1))
+	out23 := offset
+	offset -= T(int32((src[13]<<2&0x3fffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3fffc)|src[14]>>30) & 1))
+	out24 := offset
+	offset -= T(int32(src[14]>>12&0x3ffff)>>1 ^ -(int32(src[14]>>12&0x3ffff) & 1))
+	out25 := offset
+	offset -= T(int32((src[14]<<6&0x3ffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x3ffc0)|src[15]>>26) & 1))
+	out26 := offset
+	offset -= T(int32(src[15]>>8&0x3ffff)>>1 ^ -(int32(src[15]>>8&0x3ffff) & 1))
+	out27 := offset
+	offset -= T(int32((src[15]<<10&0x3fc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x3fc00)|src[16]>>22) & 1))
+	out28 := offset
+	offset -= T(int32(src[16]>>4&0x3ffff)>>1 ^ -(int32(src[16]>>4&0x3ffff) & 1))
+	out29 := offset
+	offset -= T(int32((src[16]<<14&0x3c000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x3c000)|src[17]>>18) & 1))
+	out30 := offset
+	offset -= T(int32(src[17]>>0&0x3ffff)>>1 ^ -(int32(src[17]>>0&0x3ffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append19BitDeltaDecode[T Integer](dst []T, src *[19]Word, offset T) []T {
+	offset -= T(int32(src[0]>>13&0x7ffff)>>1 ^ -(int32(src[0]>>13&0x7ffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<6&0x7ffc0)|src[1]>>26)>>1 ^ -(int32((src[0]<<6&0x7ffc0)|src[1]>>26) & 1))
+	out1 := offset
+	offset -= T(int32(src[1]>>7&0x7ffff)>>1 ^ -(int32(src[1]>>7&0x7ffff) & 1))
+	out2 := offset
+	offset -= T(int32((src[1]<<12&0x7f000)|src[2]>>20)>>1 ^ -(int32((src[1]<<12&0x7f000)|src[2]>>20) & 1))
+	out3 := offset
+	offset -= T(int32(src[2]>>1&0x7ffff)>>1 ^ -(int32(src[2]>>1&0x7ffff) & 1))
+	out4 := offset
+	offset -= T(int32((src[2]<<18&0x40000)|src[3]>>14)>>1 ^ -(int32((src[2]<<18&0x40000)|src[3]>>14) & 1))
+	out5 := offset
+	offset -= T(int32((src[3]<<5&0x7ffe0)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x7ffe0)|src[4]>>27) & 1))
+	out6 := offset
+	offset -= T(int32(src[4]>>8&0x7ffff)>>1 ^ -(int32(src[4]>>8&0x7ffff) & 1))
+	out7 := offset
+	offset -= T(int32((src[4]<<11&0x7f800)|src[5]>>21)>>1 ^ -(int32((src[4]<<11&0x7f800)|src[5]>>21) & 1))
+	out8 := offset
+	offset -= T(int32(src[5]>>2&0x7ffff)>>1 ^ -(int32(src[5]>>2&0x7ffff) & 1))
+	out9 := offset
+	offset -= T(int32((src[5]<<17&0x60000)|src[6]>>15)>>1 ^ -(int32((src[5]<<17&0x60000)|src[6]>>15) & 1))
+	out10 := offset
+	offset -= T(int32((src[6]<<4&0x7fff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0x7fff0)|src[7]>>28) & 1))
+	out11 := offset
+	offset -= T(int32(src[7]>>9&0x7ffff)>>1 ^ -(int32(src[7]>>9&0x7ffff) & 1))
+	out12 := offset
+	offset -= T(int32((src[7]<<10&0x7fc00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x7fc00)|src[8]>>22) & 1))
+	out13 := offset
+	offset -= T(int32(src[8]>>3&0x7ffff)>>1 ^ -(int32(src[8]>>3&0x7ffff) & 1))
+	out14 := offset
+	offset -= T(int32((src[8]<<16&0x70000)|src[9]>>16)>>1 ^ -(int32((src[8]<<16&0x70000)|src[9]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[9]<<3&0x7fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x7fff8)|src[10]>>29) & 1))
+	out16 := offset
+	offset -= T(int32(src[10]>>10&0x7ffff)>>1 ^ -(int32(src[10]>>10&0x7ffff) & 1))
+	out17 := offset
+	offset -= T(int32((src[10]<<9&0x7fe00)|src[11]>>23)>>1 ^ -(int32((src[10]<<9&0x7fe00)|src[11]>>23) & 1))
+	out18 := offset
+	offset -= T(int32(src[11]>>4&0x7ffff)>>1 ^ -(int32(src[11]>>4&0x7ffff) & 1))
+	out19 := offset
+	offset -= T(int32((src[11]<<15&0x78000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x78000)|src[12]>>17) & 1))
+	out20 := offset
+	offset -= T(int32((src[12]<<2&0x7fffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x7fffc)|src[13]>>30) & 1))
+	out21 := offset
+	offset -= T(int32(src[13]>>11&0x7ffff)>>1 ^ -(int32(src[13]>>11&0x7ffff) & 1))
+	out22 := offset
+	offset -= T(int32((src[13]<<8&0x7ff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0x7ff00)|src[14]>>24) & 1))
+	out23 := offset
+	offset -= T(int32(src[14]>>5&0x7ffff)>>1 ^ -(int32(src[14]>>5&0x7ffff) & 1))
+	out24 := offset
+	offset -= T(int32((src[14]<<14&0x7c000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x7c000)|src[15]>>18) & 1))
+	out25 := offset
+	offset -= T(int32((src[15]<<1&0x7fffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffe)|src[16]>>31) & 1))
+	out26 := offset
+	offset -= T(int32(src[16]>>12&0x7ffff)>>1 ^ -(int32(src[16]>>12&0x7ffff) & 1))
+	out27 := offset
+	offset -= T(int32((src[16]<<7&0x7ff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x7ff80)|src[17]>>25) & 1))
+	out28 := offset
+	offset -= T(int32(src[17]>>6&0x7ffff)>>1 ^ -(int32(src[17]>>6&0x7ffff) & 1))
+	out29 := offset
+	offset -= T(int32((src[17]<<13&0x7e000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7e000)|src[18]>>19) & 1))
+	out30 := offset
+	offset -= T(int32(src[18]>>0&0x7ffff)>>1 ^ -(int32(src[18]>>0&0x7ffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append20BitDeltaDecode[T Integer](dst []T, src *[20]Word, offset T) []T {
+	offset -= T(int32(src[0]>>12&0xfffff)>>1 ^ -(int32(src[0]>>12&0xfffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<8&0xfff00)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0xfff00)|src[1]>>24) & 1))
+	out1 := offset
+	offset -= T(int32(src[1]>>4&0xfffff)>>1 ^ -(int32(src[1]>>4&0xfffff) & 1))
+	out2 := offset
+	offset -= T(int32((src[1]<<16&0xf0000)|src[2]>>16)>>1 ^ -(int32((src[1]<<16&0xf0000)|src[2]>>16) & 1))
+	out3 := offset
+	offset -= T(int32((src[2]<<4&0xffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0xffff0)|src[3]>>28) & 1))
+	out4 := offset
+	offset -= T(int32(src[3]>>8&0xfffff)>>1 ^ -(int32(src[3]>>8&0xfffff) & 1))
+	out5 := offset
+	offset -= T(int32((src[3]<<12&0xff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xff000)|src[4]>>20) & 1))
+	out6 := offset
+	offset -= T(int32(src[4]>>0&0xfffff)>>1 ^ -(int32(src[4]>>0&0xfffff) & 1))
+	out7 := offset
+	offset -= T(int32(src[5]>>12&0xfffff)>>1 ^ -(int32(src[5]>>12&0xfffff) & 1))
+	out8 := offset
+	offset -= T(int32((src[5]<<8&0xfff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0xfff00)|src[6]>>24) & 1))
+	out9 := offset
+	offset -= T(int32(src[6]>>4&0xfffff)>>1 ^ -(int32(src[6]>>4&0xfffff) & 1))
+	out10 := offset
+	offset -= T(int32((src[6]<<16&0xf0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xf0000)|src[7]>>16) & 1))
+	out11 := offset
+	offset -= T(int32((src[7]<<4&0xffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0xffff0)|src[8]>>28) & 1))
+	out12 := offset
+	offset -= T(int32(src[8]>>8&0xfffff)>>1 ^ -(int32(src[8]>>8&0xfffff) & 1))
+	out13 := offset
+	offset -= T(int32((src[8]<<12&0xff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0xff000)|src[9]>>20) & 1))
+	out14 := offset
+	offset -= T(int32(src[9]>>0&0xfffff)>>1 ^ -(int32(src[9]>>0&0xfffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[10]>>12&0xfffff)>>1 ^ -(int32(src[10]>>12&0xfffff) & 1))
+	out16 := offset
+	offset -= T(int32((src[10]<<8&0xfff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xfff00)|src[11]>>24) & 1))
+	out17 := offset
+	offset -= T(int32(src[11]>>4&0xfffff)>>1 ^ -(int32(src[11]>>4&0xfffff) & 1))
+	out18 := offset
+	offset -= T(int32((src[11]<<16&0xf0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0xf0000)|src[12]>>16) & 1))
+	out19 := offset
+	offset -= T(int32((src[12]<<4&0xffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffff0)|src[13]>>28) & 1))
+	out20 := offset
+	offset -= T(int32(src[13]>>8&0xfffff)>>1 ^ -(int32(src[13]>>8&0xfffff) & 1))
+	out21 := offset
+	offset -= T(int32((src[13]<<12&0xff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0xff000)|src[14]>>20) & 1))
+	out22 := offset
+	offset -= T(int32(src[14]>>0&0xfffff)>>1 ^ -(int32(src[14]>>0&0xfffff) & 1))
+	out23 := offset
+	offset -= T(int32(src[15]>>12&0xfffff)>>1 ^ -(int32(src[15]>>12&0xfffff) & 1))
+	out24 := offset
+	offset -= T(int32((src[15]<<8&0xfff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0xfff00)|src[16]>>24) & 1))
+	out25 := offset
+	offset -= T(int32(src[16]>>4&0xfffff)>>1 ^ -(int32(src[16]>>4&0xfffff) & 1))
+	out26 := offset
+	offset -= T(int32((src[16]<<16&0xf0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xf0000)|src[17]>>16) & 1))
+	out27 := offset
+	offset -= T(int32((src[17]<<4&0xffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0xffff0)|src[18]>>28) & 1))
+	out28 := offset
+	offset -= T(int32(src[18]>>8&0xfffff)>>1 ^ -(int32(src[18]>>8&0xfffff) & 1))
+	out29 := offset
+	offset -= T(int32((src[18]<<12&0xff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0xff000)|src[19]>>20) & 1))
+	out30 := offset
+	offset -= T(int32(src[19]>>0&0xfffff)>>1 ^ -(int32(src[19]>>0&0xfffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append21BitDeltaDecode[T Integer](dst []T, src *[21]Word, offset T) []T {
+	offset -= T(int32(src[0]>>11&0x1fffff)>>1 ^ -(int32(src[0]>>11&0x1fffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<10&0x1ffc00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x1ffc00)|src[1]>>22) & 1))
+	out1 := offset
+	offset -= T(int32(src[1]>>1&0x1fffff)>>1 ^ -(int32(src[1]>>1&0x1fffff) & 1))
+	out2 := offset
+	offset -= T(int32((src[1]<<20&0x100000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0x100000)|src[2]>>12) & 1))
+	out3 := offset
+	offset -= T(int32((src[2]<<9&0x1ffe00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x1ffe00)|src[3]>>23) & 1))
+	out4 := offset
+	offset -= T(int32(src[3]>>2&0x1fffff)>>1 ^ -(int32(src[3]>>2&0x1fffff) & 1))
+	out5 := offset
+	offset -= T(int32((src[3]<<19&0x180000)|src[4]>>13)>>1 ^ -(int32((src[3]<<19&0x180000)|src[4]>>13) & 1))
+	out6 := offset
+	offset -= T(int32((src[4]<<8&0x1fff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x1fff00)|src[5]>>24) & 1))
+	out7 := offset
+	offset -= T(int32(src[5]>>3&0x1fffff)>>1 ^ -(int32(src[5]>>3&0x1fffff) & 1))
+	out8 := offset
+	offset -= T(int32((src[5]<<18&0x1c0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x1c0000)|src[6]>>14) & 1))
+	out9 := offset
+	offset -= T(int32((src[6]<<7&0x1fff80)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x1fff80)|src[7]>>25) & 1))
+	out10 := offset
+	offset -= T(int32(src[7]>>4&0x1fffff)>>1 ^ -(int32(src[7]>>4&0x1fffff) & 1))
+	out11 := offset
+	offset -= T(int32((src[7]<<17&0x1e0000)|src[8]>>15)>>1 ^ -(int32((src[7]<<17&0x1e0000)|src[8]>>15) & 1))
+	out12 := offset
+	offset -= T(int32((src[8]<<6&0x1fffc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x1fffc0)|src[9]>>26) & 1))
+	out13 := offset
+	offset -= T(int32(src[9]>>5&0x1fffff)>>1 ^ -(int32(src[9]>>5&0x1fffff) & 1))
+	out14 := offset
+	offset -= T(int32((src[9]<<16&0x1f0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0x1f0000)|src[10]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[10]<<5&0x1fffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1fffe0)|src[11]>>27) & 1))
+	out16 := offset
+	offset -= T(int32(src[11]>>6&0x1fffff)>>1 ^ -(int32(src[11]>>6&0x1fffff) & 1))
+	out17 := offset
+	offset -= T(int32((src[11]<<15&0x1f8000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x1f8000)|src[12]>>17) & 1))
+	out18 := offset
+	offset -= T(int32((src[12]<<4&0x1ffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x1ffff0)|src[13]>>28) & 1))
+	out19 := offset
+	offset -= T(int32(src[13]>>7&0x1fffff)>>1 ^ -(int32(src[13]>>7&0x1fffff) & 1))
+	out20 := offset
+	offset -= T(int32((src[13]<<14&0x1fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x1fc000)|src[14]>>18) & 1))
+	out21 := offset
+	offset -= T(int32((src[14]<<3&0x1ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x1ffff8)|src[15]>>29) & 1))
+	out22 := offset
+	offset -= T(int32(src[15]>>8&0x1fffff)>>1 ^ -(int32(src[15]>>8&0x1fffff) & 1))
+	out23 := offset
+	offset -= T(int32((src[15]<<13&0x1fe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1fe000)|src[16]>>19) & 1))
+	out24 := offset
+	offset -= T(int32((src[16]<<2&0x1ffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x1ffffc)|src[17]>>30) & 1))
+	out25 := offset
+	offset -= T(int32(src[17]>>9&0x1fffff)>>1 ^ -(int32(src[17]>>9&0x1fffff) & 1))
+	out26 := offset
+	offset -= T(int32((src[17]<<12&0x1ff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0x1ff000)|src[18]>>20) & 1))
+	out27 := offset
+	offset -= T(int32((src[18]<<1&0x1ffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffe)|src[19]>>31) & 1))
+	out28 := offset
+	offset -= T(int32(src[19]>>10&0x1fffff)>>1 ^ -(int32(src[19]>>10&0x1fffff) & 1))
+	out29 := offset
+	offset -= T(int32((src[19]<<11&0x1ff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x1ff800)|src[20]>>21) & 1))
+	out30 := offset
+	offset -= T(int32(src[20]>>0&0x1fffff)>>1 ^ -(int32(src[20]>>0&0x1fffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append22BitDeltaDecode[T Integer](dst []T, src *[22]Word, offset T) []T {
+	offset -= T(int32(src[0]>>10&0x3fffff)>>1 ^ -(int32(src[0]>>10&0x3fffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<12&0x3ff000)|src[1]>>20)>>1 ^ -(int32((src[0]<<12&0x3ff000)|src[1]>>20) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<2&0x3ffffc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3ffffc)|src[2]>>30) & 1))
+	out2 := offset
+	offset -= T(int32(src[2]>>8&0x3fffff)>>1 ^ -(int32(src[2]>>8&0x3fffff) & 1))
+	out3 := offset
+	offset -= T(int32((src[2]<<14&0x3fc000)|src[3]>>18)>>1 ^ -(int32((src[2]<<14&0x3fc000)|src[3]>>18) & 1))
+	out4 := offset
+	offset -= T(int32((src[3]<<4&0x3ffff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x3ffff0)|src[4]>>28) & 1))
+	out5 := offset
+	offset -= T(int32(src[4]>>6&0x3fffff)>>1 ^ -(int32(src[4]>>6&0x3fffff) & 1))
+	out6 := offset
+	offset -= T(int32((src[4]<<16&0x3f0000)|src[5]>>16)>>1 ^ -(int32((src[4]<<16&0x3f0000)|src[5]>>16) & 1))
+	out7 := offset
+	offset -= T(int32((src[5]<<6&0x3fffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3fffc0)|src[6]>>26) & 1))
+	out8 := offset
+	offset -= T(int32(src[6]>>4&0x3fffff)>>1 ^ -(int32(src[6]>>4&0x3fffff) & 1))
+	out9 := offset
+	offset -= T(int32((src[6]<<18&0x3c0000)|src[7]>>14)>>1 ^ -(int32((src[6]<<18&0x3c0000)|src[7]>>14) & 1))
+	out10 := offset
+	offset -= T(int32((src[7]<<8&0x3fff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x3fff00)|src[8]>>24) & 1))
+	out11 := offset
+	offset -= T(int32(src[8]>>2&0x3fffff)>>1 ^ -(int32(src[8]>>2&0x3fffff) & 1))
+	out12 := offset
+	offset -= T(int32((src[8]<<20&0x300000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0x300000)|src[9]>>12) & 1))
+	out13 := offset
+	offset -= T(int32((src[9]<<10&0x3ffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffc00)|src[10]>>22) & 1))
+	out14 := offset
+	offset -= T(int32(src[10]>>0&0x3fffff)>>1 ^ -(int32(src[10]>>0&0x3fffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[11]>>10&0x3fffff)>>1 ^ -(int32(src[11]>>10&0x3fffff) & 1))
+	out16 := offset
+	offset -= T(int32((src[11]<<12&0x3ff000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3ff000)|src[12]>>20) & 1))
+	out17 := offset
+	offset -= T(int32((src[12]<<2&0x3ffffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x3ffffc)|src[13]>>30) & 1))
+	out18 := offset
+	offset -= T(int32(src[13]>>8&0x3fffff)>>1 ^ -(int32(src[13]>>8&0x3fffff) & 1))
+	out19 := offset
+	offset -= T(int32((src[13]<<14&0x3fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x3fc000)|src[14]>>18) & 1))
+	out20 := offset
+	offset -= T(int32((src[14]<<4&0x3ffff0)|src[15]>>28)>>1 ^ -(int32((src[14]<<4&0x3ffff0)|src[15]>>28) & 1))
+	out21 := offset
+	offset -= T(int32(src[15]>>6&0x3fffff)>>1 ^ -(int32(src[15]>>6&0x3fffff) & 1))
+	out22 := offset
+	offset -= T(int32((src[15]<<16&0x3f0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0x3f0000)|src[16]>>16) & 1))
+	out23 := offset
+	offset -= T(int32((src[16]<<6&0x3fffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x3fffc0)|src[17]>>26) & 1))
+	out24 := offset
+	offset -= T(int32(src[17]>>4&0x3fffff)>>1 ^ -(int32(src[17]>>4&0x3fffff) & 1))
+	out25 := offset
+	offset -= T(int32((src[17]<<18&0x3c0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x3c0000)|src[18]>>14) & 1))
+	out26 := offset
+	offset -= T(int32((src[18]<<8&0x3fff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0x3fff00)|src[19]>>24) & 1))
+	out27 := offset
+	offset -= T(int32(src[19]>>2&0x3fffff)>>1 ^ -(int32(src[19]>>2&0x3fffff) & 1))
+	out28 := offset
+	offset -= T(int32((src[19]<<20&0x300000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x300000)|src[20]>>12) & 1))
+	out29 := offset
+	offset -= T(int32((src[20]<<10&0x3ffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x3ffc00)|src[21]>>22) & 1))
+	out30 := offset
+	offset -= T(int32(src[21]>>0&0x3fffff)>>1 ^ -(int32(src[21]>>0&0x3fffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append23BitDeltaDecode[T Integer](dst []T, src *[23]Word, offset T) []T {
+	offset -= T(int32(src[0]>>9&0x7fffff)>>1 ^ -(int32(src[0]>>9&0x7fffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<14&0x7fc000)|src[1]>>18)>>1 ^ -(int32((src[0]<<14&0x7fc000)|src[1]>>18) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<5&0x7fffe0)|src[2]>>27)>>1 ^ -(int32((src[1]<<5&0x7fffe0)|src[2]>>27) & 1))
+	out2 := offset
+	offset -= T(int32(src[2]>>4&0x7fffff)>>1 ^ -(int32(src[2]>>4&0x7fffff) & 1))
+	out3 := offset
+	offset -= T(int32((src[2]<<19&0x780000)|src[3]>>13)>>1 ^ -(int32((src[2]<<19&0x780000)|src[3]>>13) & 1))
+	out4 := offset
+	offset -= T(int32((src[3]<<10&0x7ffc00)|src[4]>>22)>>1 ^ -(int32((src[3]<<10&0x7ffc00)|src[4]>>22) & 1))
+	out5 := offset
+	offset -= T(int32((src[4]<<1&0x7ffffe)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7ffffe)|src[5]>>31) & 1))
+	out6 := offset
+	offset -= T(int32(src[5]>>8&0x7fffff)>>1 ^ -(int32(src[5]>>8&0x7fffff) & 1))
+	out7 := offset
+	offset -= T(int32((src[5]<<15&0x7f8000)|src[6]>>17)>>1 ^ -(int32((src[5]<<15&0x7f8000)|src[6]>>17) & 1))
+	out8 := offset
+	offset -= T(int32((src[6]<<6&0x7fffc0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x7fffc0)|src[7]>>26) & 1))
+	out9 := offset
+	offset -= T(int32(src[7]>>3&0x7fffff)>>1 ^ -(int32(src[7]>>3&0x7fffff) & 1))
+	out10 := offset
+	offset -= T(int32((src[7]<<20&0x700000)|src[8]>>12)>>1 ^ -(int32((src[7]<<20&0x700000)|src[8]>>12) & 1))
+	out11 := offset
+	offset -= T(int32((src[8]<<11&0x7ff800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x7ff800)|src[9]>>21) & 1))
+	out12 := offset
+	offset -= T(int32((src[9]<<2&0x7ffffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x7ffffc)|src[10]>>30) & 1))
+	out13 := offset
+	offset -= T(int32(src[10]>>7&0x7fffff)>>1 ^ -(int32(src[10]>>7&0x7fffff) & 1))
+	out14 := offset
+	offset -= T(int32((src[10]<<16&0x7f0000)|src[11]>>16)>>1 ^ -(int32((src[10]<<16&0x7f0000)|src[11]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[11]<<7&0x7fff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x7fff80)|src[12]>>25) & 1))
+	out16 := offset
+	offset -= T(int32(src[12]>>2&0x7fffff)>>1 ^ -(int32(src[12]>>2&0x7fffff) & 1))
+	out17 := offset
+	offset -= T(int32((src[12]<<21&0x600000)|src[13]>>11)>>1 ^ -(int32((src[12]<<21&0x600000)|src[13]>>11) & 1))
+	out18 := offset
+	offset -= T(int32((src[13]<<12&0x7ff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0x7ff000)|src[14]>>20) & 1))
+	out19 := offset
+	offset -= T(int32((src[14]<<3&0x7ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x7ffff8)|src[15]>>29) & 1))
+	out20 := offset
+	offset -= T(int32(src[15]>>6&0x7fffff)>>1 ^ -(int32(src[15]>>6&0x7fffff) & 1))
+	out21 := offset
+	offset -= T(int32((src[15]<<17&0x7e0000)|src[16]>>15)>>1 ^ -(int32((src[15]<<17&0x7e0000)|src[16]>>15) & 1))
+	out22 := offset
+	offset -= T(int32((src[16]<<8&0x7fff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0x7fff00)|src[17]>>24) & 1))
+	out23 := offset
+	offset -= T(int32(src[17]>>1&0x7fffff)>>1 ^ -(int32(src[17]>>1&0x7fffff) & 1))
+	out24 := offset
+	offset -= T(int32((src[17]<<22&0x400000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x400000)|src[18]>>10) & 1))
+	out25 := offset
+	offset -= T(int32((src[18]<<13&0x7fe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7fe000)|src[19]>>19) & 1))
+	out26 := offset
+	offset -= T(int32((src[19]<<4&0x7ffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0x7ffff0)|src[20]>>28) & 1))
+	out27 := offset
+	offset -= T(int32(src[20]>>5&0x7fffff)>>1 ^ -(int32(src[20]>>5&0x7fffff) & 1))
+	out28 := offset
+	offset -= T(int32((src[20]<<18&0x7c0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x7c0000)|src[21]>>14) & 1))
+	out29 := offset
+	offset -= T(int32((src[21]<<9&0x7ffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffe00)|src[22]>>23) & 1))
+	out30 := offset
+	offset -= T(int32(src[22]>>0&0x7fffff)>>1 ^ -(int32(src[22]>>0&0x7fffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append24BitDeltaDecode[T Integer](dst []T, src *[24]Word, offset T) []T {
+	offset -= T(int32(src[0]>>8&0xffffff)>>1 ^ -(int32(src[0]>>8&0xffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<16&0xff0000)|src[1]>>16)>>1 ^ -(int32((src[0]<<16&0xff0000)|src[1]>>16) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<8&0xffff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xffff00)|src[2]>>24) & 1))
+	out2 := offset
+	offset -= T(int32(src[2]>>0&0xffffff)>>1 ^ -(int32(src[2]>>0&0xffffff) & 1))
+	out3 := offset
+	offset -= T(int32(src[3]>>8&0xffffff)>>1 ^ -(int32(src[3]>>8&0xffffff) & 1))
+	out4 := offset
+	offset -= T(int32((src[3]<<16&0xff0000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0xff0000)|src[4]>>16) & 1))
+	out5 := offset
+	offset -= T(int32((src[4]<<8&0xffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xffff00)|src[5]>>24) & 1))
+	out6 := offset
+	offset -= T(int32(src[5]>>0&0xffffff)>>1 ^ -(int32(src[5]>>0&0xffffff) & 1))
+	out7 := offset
+	offset -= T(int32(src[6]>>8&0xffffff)>>1 ^ -(int32(src[6]>>8&0xffffff) & 1))
+	out8 := offset
+	offset -= T(int32((src[6]<<16&0xff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xff0000)|src[7]>>16) & 1))
+	out9 := offset
+	offset -= T(int32((src[7]<<8&0xffff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xffff00)|src[8]>>24) & 1))
+	out10 := offset
+	offset -= T(int32(src[8]>>0&0xffffff)>>1 ^ -(int32(src[8]>>0&0xffffff) & 1))
+	out11 := offset
+	offset -= T(int32(src[9]>>8&0xffffff)>>1 ^ -(int32(src[9]>>8&0xffffff) & 1))
+	out12 := offset
+	offset -= T(int32((src[9]<<16&0xff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xff0000)|src[10]>>16) & 1))
+	out13 := offset
+	offset -= T(int32((src[10]<<8&0xffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xffff00)|src[11]>>24) & 1))
+	out14 := offset
+	offset -= T(int32(src[11]>>0&0xffffff)>>1 ^ -(int32(src[11]>>0&0xffffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[12]>>8&0xffffff)>>1 ^ -(int32(src[12]>>8&0xffffff) & 1))
+	out16 := offset
+	offset -= T(int32((src[12]<<16&0xff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0xff0000)|src[13]>>16) & 1))
+	out17 := offset
+	offset -= T(int32((src[13]<<8&0xffff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0xffff00)|src[14]>>24) & 1))
+	out18 := offset
+	offset -= T(int32(src[14]>>0&0xffffff)>>1 ^ -(int32(src[14]>>0&0xffffff) & 1))
+	out19 := offset
+	offset -= T(int32(src[15]>>8&0xffffff)>>1 ^ -(int32(src[15]>>8&0xffffff) & 1))
+	out20 := offset
+	offset -= T(int32((src[15]<<16&0xff0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0xff0000)|src[16]>>16) & 1))
+	out21 := offset
+	offset -= T(int32((src[16]<<8&0xffff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0xffff00)|src[17]>>24) & 1))
+	out22 := offset
+	offset -= T(int32(src[17]>>0&0xffffff)>>1 ^ -(int32(src[17]>>0&0xffffff) & 1))
+	out23 := offset
+	offset -= T(int32(src[18]>>8&0xffffff)>>1 ^ -(int32(src[18]>>8&0xffffff) & 1))
+	out24 := offset
+	offset -= T(int32((src[18]<<16&0xff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0xff0000)|src[19]>>16) & 1))
+	out25 := offset
+	offset -= T(int32((src[19]<<8&0xffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0xffff00)|src[20]>>24) & 1))
+	out26 := offset
+	offset -= T(int32(src[20]>>0&0xffffff)>>1 ^ -(int32(src[20]>>0&0xffffff) & 1))
+	out27 := offset
+	offset -= T(int32(src[21]>>8&0xffffff)>>1 ^ -(int32(src[21]>>8&0xffffff) & 1))
+	out28 := offset
+	offset -= T(int32((src[21]<<16&0xff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0xff0000)|src[22]>>16) & 1))
+	out29 := offset
+	offset -= T(int32((src[22]<<8&0xffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0xffff00)|src[23]>>24) & 1))
+	out30 := offset
+	offset -= T(int32(src[23]>>0&0xffffff)>>1 ^ -(int32(src[23]>>0&0xffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append25BitDeltaDecode[T Integer](dst []T, src *[25]Word, offset T) []T {
+	offset -= T(int32(src[0]>>7&0x1ffffff)>>1 ^ -(int32(src[0]>>7&0x1ffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<18&0x1fc0000)|src[1]>>14)>>1 ^ -(int32((src[0]<<18&0x1fc0000)|src[1]>>14) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<11&0x1fff800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x1fff800)|src[2]>>21) & 1))
+	out2 := offset
+	offset -= T(int32((src[2]<<4&0x1fffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x1fffff0)|src[3]>>28) & 1))
+	out3 := offset
+	offset -= T(int32(src[3]>>3&0x1ffffff)>>1 ^ -(int32(src[3]>>3&0x1ffffff) & 1))
+	out4 := offset
+	offset -= T(int32((src[3]<<22&0x1c00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x1c00000)|src[4]>>10) & 1))
+	out5 := offset
+	offset -= T(int32((src[4]<<15&0x1ff8000)|src[5]>>17)>>1 ^ -(int32((src[4]<<15&0x1ff8000)|src[5]>>17) & 1))
+	out6 := offset
+	offset -= T(int32((src[5]<<8&0x1ffff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x1ffff00)|src[6]>>24) & 1))
+	out7 := offset
+	offset -= T(int32((src[6]<<1&0x1fffffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fffffe)|src[7]>>31) & 1))
+	out8 := offset
+	offset -= T(int32(src[7]>>6&0x1ffffff)>>1 ^ -(int32(src[7]>>6&0x1ffffff) & 1))
+	out9 := offset
+	offset -= T(int32((src[7]<<19&0x1f80000)|src[8]>>13)>>1 ^ -(int32((src[7]<<19&0x1f80000)|src[8]>>13) & 1))
+	out10 := offset
+	offset -= T(int32((src[8]<<12&0x1fff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x1fff000)|src[9]>>20) & 1))
+	out11 := offset
+	offset -= T(int32((src[9]<<5&0x1ffffe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1ffffe0)|src[10]>>27) & 1))
+	out12 := offset
+	offset -= T(int32(src[10]>>2&0x1ffffff)>>1 ^ -(int32(src[10]>>2&0x1ffffff) & 1))
+	out13 := offset
+	offset -= T(int32((src[10]<<23&0x1800000)|src[11]>>9)>>1 ^ -(int32((src[10]<<23&0x1800000)|src[11]>>9) & 1))
+	out14 := offset
+	offset -= T(int32((src[11]<<16&0x1ff0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0x1ff0000)|src[12]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[12]<<9&0x1fffe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fffe00)|src[13]>>23) & 1))
+	out16 := offset
+	offset -= T(int32((src[13]<<2&0x1fffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x1fffffc)|src[14]>>30) & 1))
+	out17 := offset
+	offset -= T(int32(src[14]>>5&0x1ffffff)>>1 ^ -(int32(src[14]>>5&0x1ffffff) & 1))
+	out18 := offset
+	offset -= T(int32((src[14]<<20&0x1f00000)|src[15]>>12)>>1 ^ -(int32((src[14]<<20&0x1f00000)|src[15]>>12) & 1))
+	out19 := offset
+	offset -= T(int32((src[15]<<13&0x1ffe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1ffe000)|src[16]>>19) & 1))
+	out20 := offset
+	offset -= T(int32((src[16]<<6&0x1ffffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x1ffffc0)|src[17]>>26) & 1))
+	out21 := offset
+	offset -= T(int32(src[17]>>1&0x1ffffff)>>1 ^ -(int32(src[17]>>1&0x1ffffff) & 1))
+	out22 := offset
+	offset -= T(int32((src[17]<<24&0x1000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x1000000)|src[18]>>8) & 1))
+	out23 := offset
+	offset -= T(int32((src[18]<<17&0x1fe0000)|src[19]>>15)>>1 ^ -(int32((src[18]<<17&0x1fe0000)|src[19]>>15) & 1))
+	out24 := offset
+	offset -= T(int32((src[19]<<10&0x1fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x1fffc00)|src[20]>>22) & 1))
+	out25 := offset
+	offset -= T(int32((src[20]<<3&0x1fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x1fffff8)|src[21]>>29) & 1))
+	out26 := offset
+	offset -= T(int32(src[21]>>4&0x1ffffff)>>1 ^ -(int32(src[21]>>4&0x1ffffff) & 1))
+	out27 := offset
+	offset -= T(int32((src[21]<<21&0x1e00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1e00000)|src[22]>>11) & 1))
+	out28 := offset
+	offset -= T(int32((src[22]<<14&0x1ffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x1ffc000)|src[23]>>18) & 1))
+	out29 := offset
+	offset -= T(int32((src[23]<<7&0x1ffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x1ffff80)|src[24]>>25) & 1))
+	out30 := offset
+	offset -= T(int32(src[24]>>0&0x1ffffff)>>1 ^ -(int32(src[24]>>0&0x1ffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append26BitDeltaDecode[T Integer](dst []T, src *[26]Word, offset T) []T {
+	offset -= T(int32(src[0]>>6&0x3ffffff)>>1 ^ -(int32(src[0]>>6&0x3ffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<20&0x3f00000)|src[1]>>12)>>1 ^ -(int32((src[0]<<20&0x3f00000)|src[1]>>12) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<14&0x3ffc000)|src[2]>>18)>>1 ^ -(int32((src[1]<<14&0x3ffc000)|src[2]>>18) & 1))
+	out2 := offset
+	offset -= T(int32((src[2]<<8&0x3ffff00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x3ffff00)|src[3]>>24) & 1))
+	out3 := offset
+	offset -= T(int32((src[3]<<2&0x3fffffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fffffc)|src[4]>>30) & 1))
+	out4 := offset
+	offset -= T(int32(src[4]>>4&0x3ffffff)>>1 ^ -(int32(src[4]>>4&0x3ffffff) & 1))
+	out5 := offset
+	offset -= T(int32((src[4]<<22&0x3c00000)|src[5]>>10)>>1 ^ -(int32((src[4]<<22&0x3c00000)|src[5]>>10) & 1))
+	out6 := offset
+	offset -= T(int32((src[5]<<16&0x3ff0000)|src[6]>>16)>>1 ^ -(int32((src[5]<<16&0x3ff0000)|src[6]>>16) & 1))
+	out7 := offset
+	offset -= T(int32((src[6]<<10&0x3fffc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fffc00)|src[7]>>22) & 1))
+	out8 := offset
+	offset -= T(int32((src[7]<<4&0x3fffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3fffff0)|src[8]>>28) & 1))
+	out9 := offset
+	offset -= T(int32(src[8]>>2&0x3ffffff)>>1 ^ -(int32(src[8]>>2&0x3ffffff) & 1))
+	out10 := offset
+	offset -= T(int32((src[8]<<24&0x3000000)|src[9]>>8)>>1 ^ -(int32((src[8]<<24&0x3000000)|src[9]>>8) & 1))
+	out11 := offset
+	offset -= T(int32((src[9]<<18&0x3fc0000)|src[10]>>14)>>1 ^ -(int32((src[9]<<18&0x3fc0000)|src[10]>>14) & 1))
+	out12 := offset
+	offset -= T(int32((src[10]<<12&0x3fff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3fff000)|src[11]>>20) & 1))
+	out13 := offset
+	offset -= T(int32((src[11]<<6&0x3ffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3ffffc0)|src[12]>>26) & 1))
+	out14 := offset
+	offset -= T(int32(src[12]>>0&0x3ffffff)>>1 ^ -(int32(src[12]>>0&0x3ffffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[13]>>6&0x3ffffff)>>1 ^ -(int32(src[13]>>6&0x3ffffff) & 1))
+	out16 := offset
+	offset -= T(int32((src[13]<<20&0x3f00000)|src[14]>>12)>>1 ^ -(int32((src[13]<<20&0x3f00000)|src[14]>>12) & 1))
+	out17 := offset
+	offset -= T(int32((src[14]<<14&0x3ffc000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x3ffc000)|src[15]>>18) & 1))
+	out18 := offset
+	offset -= T(int32((src[15]<<8&0x3ffff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0x3ffff00)|src[16]>>24) & 1))
+	out19 := offset
+	offset -= T(int32((src[16]<<2&0x3fffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x3fffffc)|src[17]>>30) & 1))
+	out20 := offset
+	offset -= T(int32(src[17]>>4&0x3ffffff)>>1 ^ -(int32(src[17]>>4&0x3ffffff) & 1))
+	out21 := offset
+	offset -= T(int32((src[17]<<22&0x3c00000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x3c00000)|src[18]>>10) & 1))
+	out22 := offset
+	offset -= T(int32((src[18]<<16&0x3ff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0x3ff0000)|src[19]>>16) & 1))
+	out23 := offset
+	offset -= T(int32((src[19]<<10&0x3fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x3fffc00)|src[20]>>22) & 1))
+	out24 := offset
+	offset -= T(int32((src[20]<<4&0x3fffff0)|src[21]>>28)>>1 ^ -(int32((src[20]<<4&0x3fffff0)|src[21]>>28) & 1))
+	out25 := offset
+	offset -= T(int32(src[21]>>2&0x3ffffff)>>1 ^ -(int32(src[21]>>2&0x3ffffff) & 1))
+	out26 := offset
+	offset -= T(int32((src[21]<<24&0x3000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0x3000000)|src[22]>>8) & 1))
+	out27 := offset
+	offset -= T(int32((src[22]<<18&0x3fc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x3fc0000)|src[23]>>14) & 1))
+	out28 := offset
+	offset -= T(int32((src[23]<<12&0x3fff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3fff000)|src[24]>>20) & 1))
+	out29 := offset
+	offset -= T(int32((src[24]<<6&0x3ffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x3ffffc0)|src[25]>>26) & 1))
+	out30 := offset
+	offset -= T(int32(src[25]>>0&0x3ffffff)>>1 ^ -(int32(src[25]>>0&0x3ffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append27BitDeltaDecode[T Integer](dst []T, src *[27]Word, offset T) []T {
+	offset -= T(int32(src[0]>>5&0x7ffffff)>>1 ^ -(int32(src[0]>>5&0x7ffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<22&0x7c00000)|src[1]>>10)>>1 ^ -(int32((src[0]<<22&0x7c00000)|src[1]>>10) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<17&0x7fe0000)|src[2]>>15)>>1 ^ -(int32((src[1]<<17&0x7fe0000)|src[2]>>15) & 1))
+	out2 := offset
+	offset -= T(int32((src[2]<<12&0x7fff000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x7fff000)|src[3]>>20) & 1))
+	out3 := offset
+	offset -= T(int32((src[3]<<7&0x7ffff80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7ffff80)|src[4]>>25) & 1))
+	out4 := offset
+	offset -= T(int32((src[4]<<2&0x7fffffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x7fffffc)|src[5]>>30) & 1))
+	out5 := offset
+	offset -= T(int32(src[5]>>3&0x7ffffff)>>1 ^ -(int32(src[5]>>3&0x7ffffff) & 1))
+	out6 := offset
+	offset -= T(int32((src[5]<<24&0x7000000)|src[6]>>8)>>1 ^ -(int32((src[5]<<24&0x7000000)|src[6]>>8) & 1))
+	out7 := offset
+	offset -= T(int32((src[6]<<19&0x7f80000)|src[7]>>13)>>1 ^ -(int32((src[6]<<19&0x7f80000)|src[7]>>13) & 1))
+	out8 := offset
+	offset -= T(int32((src[7]<<14&0x7ffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x7ffc000)|src[8]>>18) & 1))
+	out9 := offset
+	offset -= T(int32((src[8]<<9&0x7fffe00)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x7fffe00)|src[9]>>23) & 1))
+	out10 := offset
+	offset -= T(int32((src[9]<<4&0x7fffff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x7fffff0)|src[10]>>28) & 1))
+	out11 := offset
+	offset -= T(int32(src[10]>>1&0x7ffffff)>>1 ^ -(int32(src[10]>>1&0x7ffffff) & 1))
+	out12 := offset
+	offset -= T(int32((src[10]<<26&0x4000000)|src[11]>>6)>>1 ^ -(int32((src[10]<<26&0x4000000)|src[11]>>6) & 1))
+	out13 := offset
+	offset -= T(int32((src[11]<<21&0x7e00000)|src[12]>>11)>>1 ^ -(int32((src[11]<<21&0x7e00000)|src[12]>>11) & 1))
+	out14 := offset
+	offset -= T(int32((src[12]<<16&0x7ff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x7ff0000)|src[13]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[13]<<11&0x7fff800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x7fff800)|src[14]>>21) & 1))
+	out16 := offset
+	offset -= T(int32((src[14]<<6&0x7ffffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x7ffffc0)|src[15]>>26) & 1))
+	out17 := offset
+	offset -= T(int32((src[15]<<1&0x7fffffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffffe)|src[16]>>31) & 1))
+	out18 := offset
+	offset -= T(int32(src[16]>>4&0x7ffffff)>>1 ^ -(int32(src[16]>>4&0x7ffffff) & 1))
+	out19 := offset
+	offset -= T(int32((src[16]<<23&0x7800000)|src[17]>>9)>>1 ^ -(int32((src[16]<<23&0x7800000)|src[17]>>9) & 1))
+	out20 := offset
+	offset -= T(int32((src[17]<<18&0x7fc0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x7fc0000)|src[18]>>14) & 1))
+	out21 := offset
+	offset -= T(int32((src[18]<<13&0x7ffe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7ffe000)|src[19]>>19) & 1))
+	out22 := offset
+	offset -= T(int32((src[19]<<8&0x7ffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0x7ffff00)|src[20]>>24) & 1))
+	out23 := offset
+	offset -= T(int32((src[20]<<3&0x7fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x7fffff8)|src[21]>>29) & 1))
+	out24 := offset
+	offset -= T(int32(src[21]>>2&0x7ffffff)>>1 ^ -(int32(src[21]>>2&0x7ffffff) & 1))
+	out25 := offset
+	offset -= T(int32((src[21]<<25&0x6000000)|src[22]>>7)>>1 ^ -(int32((src[21]<<25&0x6000000)|src[22]>>7) & 1))
+	out26 := offset
+	offset -= T(int32((src[22]<<20&0x7f00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0x7f00000)|src[23]>>12) & 1))
+	out27 := offset
+	offset -= T(int32((src[23]<<15&0x7ff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x7ff8000)|src[24]>>17) & 1))
+	out28 := offset
+	offset -= T(int32((src[24]<<10&0x7fffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x7fffc00)|src[25]>>22) & 1))
+	out29 := offset
+	offset -= T(int32((src[25]<<5&0x7ffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7ffffe0)|src[26]>>27) & 1))
+	out30 := offset
+	offset -= T(int32(src[26]>>0&0x7ffffff)>>1 ^ -(int32(src[26]>>0&0x7ffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append28BitDeltaDecode[T Integer](dst []T, src *[28]Word, offset T) []T {
+	offset -= T(int32(src[0]>>4&0xfffffff)>>1 ^ -(int32(src[0]>>4&0xfffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<24&0xf000000)|src[1]>>8)>>1 ^ -(int32((src[0]<<24&0xf000000)|src[1]>>8) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<20&0xff00000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0xff00000)|src[2]>>12) & 1))
+	out2 := offset
+	offset -= T(int32((src[2]<<16&0xfff0000)|src[3]>>16)>>1 ^ -(int32((src[2]<<16&0xfff0000)|src[3]>>16) & 1))
+	out3 := offset
+	offset -= T(int32((src[3]<<12&0xffff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xffff000)|src[4]>>20) & 1))
+	out4 := offset
+	offset -= T(int32((src[4]<<8&0xfffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xfffff00)|src[5]>>24) & 1))
+	out5 := offset
+	offset -= T(int32((src[5]<<4&0xffffff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0xffffff0)|src[6]>>28) & 1))
+	out6 := offset
+	offset -= T(int32(src[6]>>0&0xfffffff)>>1 ^ -(int32(src[6]>>0&0xfffffff) & 1))
+	out7 := offset
+	offset -= T(int32(src[7]>>4&0xfffffff)>>1 ^ -(int32(src[7]>>4&0xfffffff) & 1))
+	out8 := offset
+	offset -= T(int32((src[7]<<24&0xf000000)|src[8]>>8)>>1 ^ -(int32((src[7]<<24&0xf000000)|src[8]>>8) & 1))
+	out9 := offset
+	offset -= T(int32((src[8]<<20&0xff00000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0xff00000)|src[9]>>12) & 1))
+	out10 := offset
+	offset -= T(int32((src[9]<<16&0xfff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xfff0000)|src[10]>>16) & 1))
+	out11 := offset
+	offset -= T(int32((src[10]<<12&0xffff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0xffff000)|src[11]>>20) & 1))
+	out12 := offset
+	offset -= T(int32((src[11]<<8&0xfffff00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0xfffff00)|src[12]>>24) & 1))
+	out13 := offset
+	offset -= T(int32((src[12]<<4&0xffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffffff0)|src[13]>>28) & 1))
+	out14 := offset
+	offset -= T(int32(src[13]>>0&0xfffffff)>>1 ^ -(int32(src[13]>>0&0xfffffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[14]>>4&0xfffffff)>>1 ^ -(int32(src[14]>>4&0xfffffff) & 1))
+	out16 := offset
+	offset -= T(int32((src[14]<<24&0xf000000)|src[15]>>8)>>1 ^ -(int32((src[14]<<24&0xf000000)|src[15]>>8) & 1))
+	out17 := offset
+	offset -= T(int32((src[15]<<20&0xff00000)|src[16]>>12)>>1 ^ -(int32((src[15]<<20&0xff00000)|src[16]>>12) & 1))
+	out18 := offset
+	offset -= T(int32((src[16]<<16&0xfff0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xfff0000)|src[17]>>16) & 1))
+	out19 := offset
+	offset -= T(int32((src[17]<<12&0xffff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0xffff000)|src[18]>>20) & 1))
+	out20 := offset
+	offset -= T(int32((src[18]<<8&0xfffff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0xfffff00)|src[19]>>24) & 1))
+	out21 := offset
+	offset -= T(int32((src[19]<<4&0xffffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0xffffff0)|src[20]>>28) & 1))
+	out22 := offset
+	offset -= T(int32(src[20]>>0&0xfffffff)>>1 ^ -(int32(src[20]>>0&0xfffffff) & 1))
+	out23 := offset
+	offset -= T(int32(src[21]>>4&0xfffffff)>>1 ^ -(int32(src[21]>>4&0xfffffff) & 1))
+	out24 := offset
+	offset -= T(int32((src[21]<<24&0xf000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0xf000000)|src[22]>>8) & 1))
+	out25 := offset
+	offset -= T(int32((src[22]<<20&0xff00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0xff00000)|src[23]>>12) & 1))
+	out26 := offset
+	offset -= T(int32((src[23]<<16&0xfff0000)|src[24]>>16)>>1 ^ -(int32((src[23]<<16&0xfff0000)|src[24]>>16) & 1))
+	out27 := offset
+	offset -= T(int32((src[24]<<12&0xffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0xffff000)|src[25]>>20) & 1))
+	out28 := offset
+	offset -= T(int32((src[25]<<8&0xfffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0xfffff00)|src[26]>>24) & 1))
+	out29 := offset
+	offset -= T(int32((src[26]<<4&0xffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0xffffff0)|src[27]>>28) & 1))
+	out30 := offset
+	offset -= T(int32(src[27]>>0&0xfffffff)>>1 ^ -(int32(src[27]>>0&0xfffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append29BitDeltaDecode[T Integer](dst []T, src *[29]Word, offset T) []T {
+	offset -= T(int32(src[0]>>3&0x1fffffff)>>1 ^ -(int32(src[0]>>3&0x1fffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<26&0x1c000000)|src[1]>>6)>>1 ^ -(int32((src[0]<<26&0x1c000000)|src[1]>>6) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<23&0x1f800000)|src[2]>>9)>>1 ^ -(int32((src[1]<<23&0x1f800000)|src[2]>>9) & 1))
+	out2 := offset
+	offset -= T(int32((src[2]<<20&0x1ff00000)|src[3]>>12)>>1 ^ -(int32((src[2]<<20&0x1ff00000)|src[3]>>12) & 1))
+	out3 := offset
+	offset -= T(int32((src[3]<<17&0x1ffe0000)|src[4]>>15)>>1 ^ -(int32((src[3]<<17&0x1ffe0000)|src[4]>>15) & 1))
+	out4 := offset
+	offset -= T(int32((src[4]<<14&0x1fffc000)|src[5]>>18)>>1 ^ -(int32((src[4]<<14&0x1fffc000)|src[5]>>18) & 1))
+	out5 := offset
+	offset -= T(int32((src[5]<<11&0x1ffff800)|src[6]>>21)>>1 ^ -(int32((src[5]<<11&0x1ffff800)|src[6]>>21) & 1))
+	out6 := offset
+	offset -= T(int32((src[6]<<8&0x1fffff00)|src[7]>>24)>>1 ^ -(int32((src[6]<<8&0x1fffff00)|src[7]>>24) & 1))
+	out7 := offset
+	offset -= T(int32((src[7]<<5&0x1fffffe0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1fffffe0)|src[8]>>27) & 1))
+	out8 := offset
+	offset -= T(int32((src[8]<<2&0x1ffffffc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x1ffffffc)|src[9]>>30) & 1))
+	out9 := offset
+	offset -= T(int32(src[9]>>1&0x1fffffff)>>1 ^ -(int32(src[9]>>1&0x1fffffff) & 1))
+	out10 := offset
+	offset -= T(int32((src[9]<<28&0x10000000)|src[10]>>4)>>1 ^ -(int32((src[9]<<28&0x10000000)|src[10]>>4) & 1))
+	out11 := offset
+	offset -= T(int32((src[10]<<25&0x1e000000)|src[11]>>7)>>1 ^ -(int32((src[10]<<25&0x1e000000)|src[11]>>7) & 1))
+	out12 := offset
+	offset -= T(int32((src[11]<<22&0x1fc00000)|src[12]>>10)>>1 ^ -(int32((src[11]<<22&0x1fc00000)|src[12]>>10) & 1))
+	out13 := offset
+	offset -= T(int32((src[12]<<19&0x1ff80000)|src[13]>>13)>>1 ^ -(int32((src[12]<<19&0x1ff80000)|src[13]>>13) & 1))
+	out14 := offset
+	offset -= T(int32((src[13]<<16&0x1fff0000)|src[14]>>16)>>1 ^ -(int32((src[13]<<16&0x1fff0000)|src[14]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[14]<<13&0x1fffe000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1fffe000)|src[15]>>19) & 1))
+	out16 := offset
+	offset -= T(int32((src[15]<<10&0x1ffffc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x1ffffc00)|src[16]>>22) & 1))
+	out17 := offset
+	offset -= T(int32((src[16]<<7&0x1fffff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x1fffff80)|src[17]>>25) & 1))
+	out18 := offset
+	offset -= T(int32((src[17]<<4&0x1ffffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0x1ffffff0)|src[18]>>28) & 1))
+	out19 := offset
+	offset -= T(int32((src[18]<<1&0x1ffffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffffe)|src[19]>>31) & 1))
+	out20 := offset
+	offset -= T(int32(src[19]>>2&0x1fffffff)>>1 ^ -(int32(src[19]>>2&0x1fffffff) & 1))
+	out21 := offset
+	offset -= T(int32((src[19]<<27&0x18000000)|src[20]>>5)>>1 ^ -(int32((src[19]<<27&0x18000000)|src[20]>>5) & 1))
+	out22 := offset
+	offset -= T(int32((src[20]<<24&0x1f000000)|src[21]>>8)>>1 ^ -(int32((src[20]<<24&0x1f000000)|src[21]>>8) & 1))
+	out23 := offset
+	offset -= T(int32((src[21]<<21&0x1fe00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1fe00000)|src[22]>>11) & 1))
+	out24 := offset
+	offset -= T(int32((src[22]<<18&0x1ffc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x1ffc0000)|src[23]>>14) & 1))
+	out25 := offset
+	offset -= T(int32((src[23]<<15&0x1fff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x1fff8000)|src[24]>>17) & 1))
+	out26 := offset
+	offset -= T(int32((src[24]<<12&0x1ffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0x1ffff000)|src[25]>>20) & 1))
+	out27 := offset
+	offset -= T(int32((src[25]<<9&0x1ffffe00)|src[26]>>23)>>1 ^ -(int32((src[25]<<9&0x1ffffe00)|src[26]>>23) & 1))
+	out28 := offset
+	offset -= T(int32((src[26]<<6&0x1fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x1fffffc0)|src[27]>>26) & 1))
+	out29 := offset
+	offset -= T(int32((src[27]<<3&0x1ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x1ffffff8)|src[28]>>29) & 1))
+	out30 := offset
+	offset -= T(int32(src[28]>>0&0x1fffffff)>>1 ^ -(int32(src[28]>>0&0x1fffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append30BitDeltaDecode[T Integer](dst []T, src *[30]Word, offset T) []T {
+	offset -= T(int32(src[0]>>2&0x3fffffff)>>1 ^ -(int32(src[0]>>2&0x3fffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<28&0x30000000)|src[1]>>4)>>1 ^ -(int32((src[0]<<28&0x30000000)|src[1]>>4) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<26&0x3c000000)|src[2]>>6)>>1 ^ -(int32((src[1]<<26&0x3c000000)|src[2]>>6) & 1))
+	out2 := offset
+	offset -= T(int32((src[2]<<24&0x3f000000)|src[3]>>8)>>1 ^ -(int32((src[2]<<24&0x3f000000)|src[3]>>8) & 1))
+	out3 := offset
+	offset -= T(int32((src[3]<<22&0x3fc00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x3fc00000)|src[4]>>10) & 1))
+	out4 := offset
+	offset -= T(int32((src[4]<<20&0x3ff00000)|src[5]>>12)>>1 ^ -(int32((src[4]<<20&0x3ff00000)|src[5]>>12) & 1))
+	out5 := offset
+	offset -= T(int32((src[5]<<18&0x3ffc0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x3ffc0000)|src[6]>>14) & 1))
+	out6 := offset
+	offset -= T(int32((src[6]<<16&0x3fff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0x3fff0000)|src[7]>>16) & 1))
+	out7 := offset
+	offset -= T(int32((src[7]<<14&0x3fffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3fffc000)|src[8]>>18) & 1))
+	out8 := offset
+	offset -= T(int32((src[8]<<12&0x3ffff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x3ffff000)|src[9]>>20) & 1))
+	out9 := offset
+	offset -= T(int32((src[9]<<10&0x3ffffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffffc00)|src[10]>>22) & 1))
+	out10 := offset
+	offset -= T(int32((src[10]<<8&0x3fffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3fffff00)|src[11]>>24) & 1))
+	out11 := offset
+	offset -= T(int32((src[11]<<6&0x3fffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3fffffc0)|src[12]>>26) & 1))
+	out12 := offset
+	offset -= T(int32((src[12]<<4&0x3ffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ffffff0)|src[13]>>28) & 1))
+	out13 := offset
+	offset -= T(int32((src[13]<<2&0x3ffffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3ffffffc)|src[14]>>30) & 1))
+	out14 := offset
+	offset -= T(int32(src[14]>>0&0x3fffffff)>>1 ^ -(int32(src[14]>>0&0x3fffffff) & 1))
+	out15 := offset
+	offset -= T(int32(src[15]>>2&0x3fffffff)>>1 ^ -(int32(src[15]>>2&0x3fffffff) & 1))
+	out16 := offset
+	offset -= T(int32((src[15]<<28&0x30000000)|src[16]>>4)>>1 ^ -(int32((src[15]<<28&0x30000000)|src[16]>>4) & 1))
+	out17 := offset
+	offset -= T(int32((src[16]<<26&0x3c000000)|src[17]>>6)>>1 ^ -(int32((src[16]<<26&0x3c000000)|src[17]>>6) & 1))
+	out18 := offset
+	offset -= T(int32((src[17]<<24&0x3f000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x3f000000)|src[18]>>8) & 1))
+	out19 := offset
+	offset -= T(int32((src[18]<<22&0x3fc00000)|src[19]>>10)>>1 ^ -(int32((src[18]<<22&0x3fc00000)|src[19]>>10) & 1))
+	out20 := offset
+	offset -= T(int32((src[19]<<20&0x3ff00000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x3ff00000)|src[20]>>12) & 1))
+	out21 := offset
+	offset -= T(int32((src[20]<<18&0x3ffc0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x3ffc0000)|src[21]>>14) & 1))
+	out22 := offset
+	offset -= T(int32((src[21]<<16&0x3fff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0x3fff0000)|src[22]>>16) & 1))
+	out23 := offset
+	offset -= T(int32((src[22]<<14&0x3fffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x3fffc000)|src[23]>>18) & 1))
+	out24 := offset
+	offset -= T(int32((src[23]<<12&0x3ffff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3ffff000)|src[24]>>20) & 1))
+	out25 := offset
+	offset -= T(int32((src[24]<<10&0x3ffffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x3ffffc00)|src[25]>>22) & 1))
+	out26 := offset
+	offset -= T(int32((src[25]<<8&0x3fffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0x3fffff00)|src[26]>>24) & 1))
+	out27 := offset
+	offset -= T(int32((src[26]<<6&0x3fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x3fffffc0)|src[27]>>26) & 1))
+	out28 := offset
+	offset -= T(int32((src[27]<<4&0x3ffffff0)|src[28]>>28)>>1 ^ -(int32((src[27]<<4&0x3ffffff0)|src[28]>>28) & 1))
+	out29 := offset
+	offset -= T(int32((src[28]<<2&0x3ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x3ffffffc)|src[29]>>30) & 1))
+	out30 := offset
+	offset -= T(int32(src[29]>>0&0x3fffffff)>>1 ^ -(int32(src[29]>>0&0x3fffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func append31BitDeltaDecode[T Integer](dst []T, src *[31]Word, offset T) []T {
+	offset -= T(int32(src[0]>>1&0x7fffffff)>>1 ^ -(int32(src[0]>>1&0x7fffffff) & 1))
+	out0 := offset
+	offset -= T(int32((src[0]<<30&0x40000000)|src[1]>>2)>>1 ^ -(int32((src[0]<<30&0x40000000)|src[1]>>2) & 1))
+	out1 := offset
+	offset -= T(int32((src[1]<<29&0x60000000)|src[2]>>3)>>1 ^ -(int32((src[1]<<29&0x60000000)|src[2]>>3) & 1))
+	out2 := offset
+	offset -= T(int32((src[2]<<28&0x70000000)|src[3]>>4)>>1 ^ -(int32((src[2]<<28&0x70000000)|src[3]>>4) & 1))
+	out3 := offset
+	offset -= T(int32((src[3]<<27&0x78000000)|src[4]>>5)>>1 ^ -(int32((src[3]<<27&0x78000000)|src[4]>>5) & 1))
+	out4 := offset
+	offset -= T(int32((src[4]<<26&0x7c000000)|src[5]>>6)>>1 ^ -(int32((src[4]<<26&0x7c000000)|src[5]>>6) & 1))
+	out5 := offset
+	offset -= T(int32((src[5]<<25&0x7e000000)|src[6]>>7)>>1 ^ -(int32((src[5]<<25&0x7e000000)|src[6]>>7) & 1))
+	out6 := offset
+	offset -= T(int32((src[6]<<24&0x7f000000)|src[7]>>8)>>1 ^ -(int32((src[6]<<24&0x7f000000)|src[7]>>8) & 1))
+	out7 := offset
+	offset -= T(int32((src[7]<<23&0x7f800000)|src[8]>>9)>>1 ^ -(int32((src[7]<<23&0x7f800000)|src[8]>>9) & 1))
+	out8 := offset
+	offset -= T(int32((src[8]<<22&0x7fc00000)|src[9]>>10)>>1 ^ -(int32((src[8]<<22&0x7fc00000)|src[9]>>10) & 1))
+	out9 := offset
+	offset -= T(int32((src[9]<<21&0x7fe00000)|src[10]>>11)>>1 ^ -(int32((src[9]<<21&0x7fe00000)|src[10]>>11) & 1))
+	out10 := offset
+	offset -= T(int32((src[10]<<20&0x7ff00000)|src[11]>>12)>>1 ^ -(int32((src[10]<<20&0x7ff00000)|src[11]>>12) & 1))
+	out11 := offset
+	offset -= T(int32((src[11]<<19&0x7ff80000)|src[12]>>13)>>1 ^ -(int32((src[11]<<19&0x7ff80000)|src[12]>>13) & 1))
+	out12 := offset
+	offset -= T(int32((src[12]<<18&0x7ffc0000)|src[13]>>14)>>1 ^ -(int32((src[12]<<18&0x7ffc0000)|src[13]>>14) & 1))
+	out13 := offset
+	offset -= T(int32((src[13]<<17&0x7ffe0000)|src[14]>>15)>>1 ^ -(int32((src[13]<<17&0x7ffe0000)|src[14]>>15) & 1))
+	out14 := offset
+	offset -= T(int32((src[14]<<16&0x7fff0000)|src[15]>>16)>>1 ^ -(int32((src[14]<<16&0x7fff0000)|src[15]>>16) & 1))
+	out15 := offset
+	offset -= T(int32((src[15]<<15&0x7fff8000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x7fff8000)|src[16]>>17) & 1))
+	out16 := offset
+	offset -= T(int32((src[16]<<14&0x7fffc000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x7fffc000)|src[17]>>18) & 1))
+	out17 := offset
+	offset -= T(int32((src[17]<<13&0x7fffe000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7fffe000)|src[18]>>19) & 1))
+	out18 := offset
+	offset -= T(int32((src[18]<<12&0x7ffff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0x7ffff000)|src[19]>>20) & 1))
+	out19 := offset
+	offset -= T(int32((src[19]<<11&0x7ffff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x7ffff800)|src[20]>>21) & 1))
+	out20 := offset
+	offset -= T(int32((src[20]<<10&0x7ffffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x7ffffc00)|src[21]>>22) & 1))
+	out21 := offset
+	offset -= T(int32((src[21]<<9&0x7ffffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffffe00)|src[22]>>23) & 1))
+	out22 := offset
+	offset -= T(int32((src[22]<<8&0x7fffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0x7fffff00)|src[23]>>24) & 1))
+	out23 := offset
+	offset -= T(int32((src[23]<<7&0x7fffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x7fffff80)|src[24]>>25) & 1))
+	out24 := offset
+	offset -= T(int32((src[24]<<6&0x7fffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x7fffffc0)|src[25]>>26) & 1))
+	out25 := offset
+	offset -= T(int32((src[25]<<5&0x7fffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7fffffe0)|src[26]>>27) & 1))
+	out26 := offset
+	offset -= T(int32((src[26]<<4&0x7ffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0x7ffffff0)|src[27]>>28) & 1))
+	out27 := offset
+	offset -= T(int32((src[27]<<3&0x7ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x7ffffff8)|src[28]>>29) & 1))
+	out28 := offset
+	offset -= T(int32((src[28]<<2&0x7ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x7ffffffc)|src[29]>>30) & 1))
+	out29 := offset
+	offset -= T(int32((src[29]<<1&0x7ffffffe)|src[30]>>31)>>1 ^ -(int32((src[29]<<1&0x7ffffffe)|src[30]>>31) & 1))
+	out30 := offset
+	offset -= T(int32(src[30]>>0&0x7fffffff)>>1 ^ -(int32(src[30]>>0&0x7fffffff) & 1))
+	out31 := offset
+
+	return append(dst,
+		out0,
+		out1,
+		out2,
+		out3,
+		out4,
+		out5,
+		out6,
+		out7,
+		out8,
+		out9,
+		out10,
+		out11,
+		out12,
+		out13,
+		out14,
+		out15,
+		out16,
+		out17,
+		out18,
+		out19,
+		out20,
+		out21,
+		out22,
+		out23,
+		out24,
+		out25,
+		out26,
+		out27,
+		out28,
+		out29,
+		out30,
+		out31,
+	)
+}
+
+func decode1BitDeltaInto[T Integer](dst *[32]T, src *[1]Word, offset T) {
+	offset -= T(int32(src[0]>>31&0x1)>>1 ^ -(int32(src[0]>>31&0x1) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>30&0x1)>>1 ^ -(int32(src[0]>>30&0x1) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>29&0x1)>>1 ^ -(int32(src[0]>>29&0x1) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>28&0x1)>>1 ^ -(int32(src[0]>>28&0x1) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[0]>>27&0x1)>>1 ^ -(int32(src[0]>>27&0x1) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[0]>>26&0x1)>>1 ^ -(int32(src[0]>>26&0x1) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[0]>>25&0x1)>>1 ^ -(int32(src[0]>>25&0x1) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[0]>>24&0x1)>>1 ^ -(int32(src[0]>>24&0x1) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[0]>>23&0x1)>>1 ^ -(int32(src[0]>>23&0x1) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[0]>>22&0x1)>>1 ^ -(int32(src[0]>>22&0x1) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[0]>>21&0x1)>>1 ^ -(int32(src[0]>>21&0x1) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[0]>>20&0x1)>>1 ^ -(int32(src[0]>>20&0x1) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[0]>>19&0x1)>>1 ^ -(int32(src[0]>>19&0x1) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[0]>>18&0x1)>>1 ^ -(int32(src[0]>>18&0x1) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[0]>>17&0x1)>>1 ^ -(int32(src[0]>>17&0x1) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[0]>>16&0x1)>>1 ^ -(int32(src[0]>>16&0x1) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[0]>>15&0x1)>>1 ^ -(int32(src[0]>>15&0x1) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[0]>>14&0x1)>>1 ^ -(int32(src[0]>>14&0x1) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[0]>>13&0x1)>>1 ^ -(int32(src[0]>>13&0x1) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[0]>>12&0x1)>>1 ^ -(int32(src[0]>>12&0x1) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[0]>>11&0x1)>>1 ^ -(int32(src[0]>>11&0x1) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[0]>>10&0x1)>>1 ^ -(int32(src[0]>>10&0x1) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[0]>>9&0x1)>>1 ^ -(int32(src[0]>>9&0x1) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[0]>>8&0x1)>>1 ^ -(int32(src[0]>>8&0x1) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[0]>>7&0x1)>>1 ^ -(int32(src[0]>>7&0x1) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[0]>>6&0x1)>>1 ^ -(int32(src[0]>>6&0x1) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[0]>>5&0x1)>>1 ^ -(int32(src[0]>>5&0x1) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[0]>>4&0x1)>>1 ^ -(int32(src[0]>>4&0x1) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[0]>>3&0x1)>>1 ^ -(int32(src[0]>>3&0x1) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[0]>>2&0x1)>>1 ^ -(int32(src[0]>>2&0x1) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[0]>>1&0x1)>>1 ^ -(int32(src[0]>>1&0x1) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[0]>>0&0x1)>>1 ^ -(int32(src[0]>>0&0x1) & 1))
+	dst[31] = offset
+}
+
+func decode2BitDeltaInto[T Integer](dst *[32]T, src *[2]Word, offset T) {
+	offset -= T(int32(src[0]>>30&0x3)>>1 ^ -(int32(src[0]>>30&0x3) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>28&0x3)>>1 ^ -(int32(src[0]>>28&0x3) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>26&0x3)>>1 ^ -(int32(src[0]>>26&0x3) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>24&0x3)>>1 ^ -(int32(src[0]>>24&0x3) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[0]>>22&0x3)>>1 ^ -(int32(src[0]>>22&0x3) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[0]>>20&0x3)>>1 ^ -(int32(src[0]>>20&0x3) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[0]>>18&0x3)>>1 ^ -(int32(src[0]>>18&0x3) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[0]>>16&0x3)>>1 ^ -(int32(src[0]>>16&0x3) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[0]>>14&0x3)>>1 ^ -(int32(src[0]>>14&0x3) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[0]>>12&0x3)>>1 ^ -(int32(src[0]>>12&0x3) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[0]>>10&0x3)>>1 ^ -(int32(src[0]>>10&0x3) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[0]>>8&0x3)>>1 ^ -(int32(src[0]>>8&0x3) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[0]>>6&0x3)>>1 ^ -(int32(src[0]>>6&0x3) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[0]>>4&0x3)>>1 ^ -(int32(src[0]>>4&0x3) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[0]>>2&0x3)>>1 ^ -(int32(src[0]>>2&0x3) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[0]>>0&0x3)>>1 ^ -(int32(src[0]>>0&0x3) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[1]>>30&0x3)>>1 ^ -(int32(src[1]>>30&0x3) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[1]>>28&0x3)>>1 ^ -(int32(src[1]>>28&0x3) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[1]>>26&0x3)>>1 ^ -(int32(src[1]>>26&0x3) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[1]>>24&0x3)>>1 ^ -(int32(src[1]>>24&0x3) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[1]>>22&0x3)>>1 ^ -(int32(src[1]>>22&0x3) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[1]>>20&0x3)>>1 ^ -(int32(src[1]>>20&0x3) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[1]>>18&0x3)>>1 ^ -(int32(src[1]>>18&0x3) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[1]>>16&0x3)>>1 ^ -(int32(src[1]>>16&0x3) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[1]>>14&0x3)>>1 ^ -(int32(src[1]>>14&0x3) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[1]>>12&0x3)>>1 ^ -(int32(src[1]>>12&0x3) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[1]>>10&0x3)>>1 ^ -(int32(src[1]>>10&0x3) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[1]>>8&0x3)>>1 ^ -(int32(src[1]>>8&0x3) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[1]>>6&0x3)>>1 ^ -(int32(src[1]>>6&0x3) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[1]>>4&0x3)>>1 ^ -(int32(src[1]>>4&0x3) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[1]>>2&0x3)>>1 ^ -(int32(src[1]>>2&0x3) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[1]>>0&0x3)>>1 ^ -(int32(src[1]>>0&0x3) & 1))
+	dst[31] = offset
+}
+
+func decode3BitDeltaInto[T Integer](dst *[32]T, src *[3]Word, offset T) {
+	offset -= T(int32(src[0]>>29&0x7)>>1 ^ -(int32(src[0]>>29&0x7) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>26&0x7)>>1 ^ -(int32(src[0]>>26&0x7) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>23&0x7)>>1 ^ -(int32(src[0]>>23&0x7) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>20&0x7)>>1 ^ -(int32(src[0]>>20&0x7) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[0]>>17&0x7)>>1 ^ -(int32(src[0]>>17&0x7) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[0]>>14&0x7)>>1 ^ -(int32(src[0]>>14&0x7) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[0]>>11&0x7)>>1 ^ -(int32(src[0]>>11&0x7) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[0]>>8&0x7)>>1 ^ -(int32(src[0]>>8&0x7) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[0]>>5&0x7)>>1 ^ -(int32(src[0]>>5&0x7) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[0]>>2&0x7)>>1 ^ -(int32(src[0]>>2&0x7) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[0]<<1&0x6)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x6)|src[1]>>31) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[1]>>28&0x7)>>1 ^ -(int32(src[1]>>28&0x7) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[1]>>25&0x7)>>1 ^ -(int32(src[1]>>25&0x7) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[1]>>22&0x7)>>1 ^ -(int32(src[1]>>22&0x7) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[1]>>19&0x7)>>1 ^ -(int32(src[1]>>19&0x7) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[1]>>16&0x7)>>1 ^ -(int32(src[1]>>16&0x7) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[1]>>13&0x7)>>1 ^ -(int32(src[1]>>13&0x7) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[1]>>10&0x7)>>1 ^ -(int32(src[1]>>10&0x7) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[1]>>7&0x7)>>1 ^ -(int32(src[1]>>7&0x7) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[1]>>4&0x7)>>1 ^ -(int32(src[1]>>4&0x7) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[1]>>1&0x7)>>1 ^ -(int32(src[1]>>1&0x7) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[1]<<2&0x4)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x4)|src[2]>>30) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[2]>>27&0x7)>>1 ^ -(int32(src[2]>>27&0x7) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[2]>>24&0x7)>>1 ^ -(int32(src[2]>>24&0x7) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[2]>>21&0x7)>>1 ^ -(int32(src[2]>>21&0x7) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[2]>>18&0x7)>>1 ^ -(int32(src[2]>>18&0x7) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[2]>>15&0x7)>>1 ^ -(int32(src[2]>>15&0x7) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[2]>>12&0x7)>>1 ^ -(int32(src[2]>>12&0x7) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[2]>>9&0x7)>>1 ^ -(int32(src[2]>>9&0x7) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[2]>>6&0x7)>>1 ^ -(int32(src[2]>>6&0x7) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[2]>>3&0x7)>>1 ^ -(int32(src[2]>>3&0x7) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[2]>>0&0x7)>>1 ^ -(int32(src[2]>>0&0x7) & 1))
+	dst[31] = offset
+}
+
+func decode4BitDeltaInto[T Integer](dst *[32]T, src *[4]Word, offset T) {
+	offset -= T(int32(src[0]>>28&0xf)>>1 ^ -(int32(src[0]>>28&0xf) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>24&0xf)>>1 ^ -(int32(src[0]>>24&0xf) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>20&0xf)>>1 ^ -(int32(src[0]>>20&0xf) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>16&0xf)>>1 ^ -(int32(src[0]>>16&0xf) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[0]>>12&0xf)>>1 ^ -(int32(src[0]>>12&0xf) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[0]>>8&0xf)>>1 ^ -(int32(src[0]>>8&0xf) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[0]>>4&0xf)>>1 ^ -(int32(src[0]>>4&0xf) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[0]>>0&0xf)>>1 ^ -(int32(src[0]>>0&0xf) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[1]>>28&0xf)>>1 ^ -(int32(src[1]>>28&0xf) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[1]>>24&0xf)>>1 ^ -(int32(src[1]>>24&0xf) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[1]>>20&0xf)>>1 ^ -(int32(src[1]>>20&0xf) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[1]>>16&0xf)>>1 ^ -(int32(src[1]>>16&0xf) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[1]>>12&0xf)>>1 ^ -(int32(src[1]>>12&0xf) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[1]>>8&0xf)>>1 ^ -(int32(src[1]>>8&0xf) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[1]>>4&0xf)>>1 ^ -(int32(src[1]>>4&0xf) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[1]>>0&0xf)>>1 ^ -(int32(src[1]>>0&0xf) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[2]>>28&0xf)>>1 ^ -(int32(src[2]>>28&0xf) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[2]>>24&0xf)>>1 ^ -(int32(src[2]>>24&0xf) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[2]>>20&0xf)>>1 ^ -(int32(src[2]>>20&0xf) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[2]>>16&0xf)>>1 ^ -(int32(src[2]>>16&0xf) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[2]>>12&0xf)>>1 ^ -(int32(src[2]>>12&0xf) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[2]>>8&0xf)>>1 ^ -(int32(src[2]>>8&0xf) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[2]>>4&0xf)>>1 ^ -(int32(src[2]>>4&0xf) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[2]>>0&0xf)>>1 ^ -(int32(src[2]>>0&0xf) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[3]>>28&0xf)>>1 ^ -(int32(src[3]>>28&0xf) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[3]>>24&0xf)>>1 ^ -(int32(src[3]>>24&0xf) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[3]>>20&0xf)>>1 ^ -(int32(src[3]>>20&0xf) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[3]>>16&0xf)>>1 ^ -(int32(src[3]>>16&0xf) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[3]>>12&0xf)>>1 ^ -(int32(src[3]>>12&0xf) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[3]>>8&0xf)>>1 ^ -(int32(src[3]>>8&0xf) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[3]>>4&0xf)>>1 ^ -(int32(src[3]>>4&0xf) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[3]>>0&0xf)>>1 ^ -(int32(src[3]>>0&0xf) & 1))
+	dst[31] = offset
+}
+
+func decode5BitDeltaInto[T Integer](dst *[32]T, src *[5]Word, offset T) {
+	offset -= T(int32(src[0]>>27&0x1f)>>1 ^ -(int32(src[0]>>27&0x1f) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>22&0x1f)>>1 ^ -(int32(src[0]>>22&0x1f) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>17&0x1f)>>1 ^ -(int32(src[0]>>17&0x1f) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>12&0x1f)>>1 ^ -(int32(src[0]>>12&0x1f) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[0]>>7&0x1f)>>1 ^ -(int32(src[0]>>7&0x1f) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[0]>>2&0x1f)>>1 ^ -(int32(src[0]>>2&0x1f) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[0]<<3&0x18)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x18)|src[1]>>29) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[1]>>24&0x1f)>>1 ^ -(int32(src[1]>>24&0x1f) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[1]>>19&0x1f)>>1 ^ -(int32(src[1]>>19&0x1f) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[1]>>14&0x1f)>>1 ^ -(int32(src[1]>>14&0x1f) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[1]>>9&0x1f)>>1 ^ -(int32(src[1]>>9&0x1f) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[1]>>4&0x1f)>>1 ^ -(int32(src[1]>>4&0x1f) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[1]<<1&0x1e)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1e)|src[2]>>31) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[2]>>26&0x1f)>>1 ^ -(int32(src[2]>>26&0x1f) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[2]>>21&0x1f)>>1 ^ -(int32(src[2]>>21&0x1f) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[2]>>16&0x1f)>>1 ^ -(int32(src[2]>>16&0x1f) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[2]>>11&0x1f)>>1 ^ -(int32(src[2]>>11&0x1f) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[2]>>6&0x1f)>>1 ^ -(int32(src[2]>>6&0x1f) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[2]>>1&0x1f)>>1 ^ -(int32(src[2]>>1&0x1f) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[2]<<4&0x10)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x10)|src[3]>>28) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[3]>>23&0x1f)>>1 ^ -(int32(src[3]>>23&0x1f) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[3]>>18&0x1f)>>1 ^ -(int32(src[3]>>18&0x1f) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[3]>>13&0x1f)>>1 ^ -(int32(src[3]>>13&0x1f) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[3]>>8&0x1f)>>1 ^ -(int32(src[3]>>8&0x1f) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[3]>>3&0x1f)>>1 ^ -(int32(src[3]>>3&0x1f) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[3]<<2&0x1c)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1c)|src[4]>>30) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[4]>>25&0x1f)>>1 ^ -(int32(src[4]>>25&0x1f) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[4]>>20&0x1f)>>1 ^ -(int32(src[4]>>20&0x1f) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[4]>>15&0x1f)>>1 ^ -(int32(src[4]>>15&0x1f) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[4]>>10&0x1f)>>1 ^ -(int32(src[4]>>10&0x1f) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[4]>>5&0x1f)>>1 ^ -(int32(src[4]>>5&0x1f) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[4]>>0&0x1f)>>1 ^ -(int32(src[4]>>0&0x1f) & 1))
+	dst[31] = offset
+}
+
+func decode6BitDeltaInto[T Integer](dst *[32]T, src *[6]Word, offset T) {
+	offset -= T(int32(src[0]>>26&0x3f)>>1 ^ -(int32(src[0]>>26&0x3f) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>20&0x3f)>>1 ^ -(int32(src[0]>>20&0x3f) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>14&0x3f)>>1 ^ -(int32(src[0]>>14&0x3f) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>8&0x3f)>>1 ^ -(int32(src[0]>>8&0x3f) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[0]>>2&0x3f)>>1 ^ -(int32(src[0]>>2&0x3f) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[0]<<4&0x30)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x30)|src[1]>>28) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[1]>>22&0x3f)>>1 ^ -(int32(src[1]>>22&0x3f) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[1]>>16&0x3f)>>1 ^ -(int32(src[1]>>16&0x3f) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[1]>>10&0x3f)>>1 ^ -(int32(src[1]>>10&0x3f) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[1]>>4&0x3f)>>1 ^ -(int32(src[1]>>4&0x3f) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[1]<<2&0x3c)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3c)|src[2]>>30) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[2]>>24&0x3f)>>1 ^ -(int32(src[2]>>24&0x3f) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[2]>>18&0x3f)>>1 ^ -(int32(src[2]>>18&0x3f) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[2]>>12&0x3f)>>1 ^ -(int32(src[2]>>12&0x3f) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[2]>>6&0x3f)>>1 ^ -(int32(src[2]>>6&0x3f) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[2]>>0&0x3f)>>1 ^ -(int32(src[2]>>0&0x3f) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[3]>>26&0x3f)>>1 ^ -(int32(src[3]>>26&0x3f) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[3]>>20&0x3f)>>1 ^ -(int32(src[3]>>20&0x3f) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[3]>>14&0x3f)>>1 ^ -(int32(src[3]>>14&0x3f) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[3]>>8&0x3f)>>1 ^ -(int32(src[3]>>8&0x3f) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[3]>>2&0x3f)>>1 ^ -(int32(src[3]>>2&0x3f) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[3]<<4&0x30)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x30)|src[4]>>28) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[4]>>22&0x3f)>>1 ^ -(int32(src[4]>>22&0x3f) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[4]>>16&0x3f)>>1 ^ -(int32(src[4]>>16&0x3f) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[4]>>10&0x3f)>>1 ^ -(int32(src[4]>>10&0x3f) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[4]>>4&0x3f)>>1 ^ -(int32(src[4]>>4&0x3f) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[4]<<2&0x3c)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3c)|src[5]>>30) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[5]>>24&0x3f)>>1 ^ -(int32(src[5]>>24&0x3f) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[5]>>18&0x3f)>>1 ^ -(int32(src[5]>>18&0x3f) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[5]>>12&0x3f)>>1 ^ -(int32(src[5]>>12&0x3f) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[5]>>6&0x3f)>>1 ^ -(int32(src[5]>>6&0x3f) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[5]>>0&0x3f)>>1 ^ -(int32(src[5]>>0&0x3f) & 1))
+	dst[31] = offset
+}
+
+func decode7BitDeltaInto[T Integer](dst *[32]T, src *[7]Word, offset T) {
+	offset -= T(int32(src[0]>>25&0x7f)>>1 ^ -(int32(src[0]>>25&0x7f) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>18&0x7f)>>1 ^ -(int32(src[0]>>18&0x7f) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>11&0x7f)>>1 ^ -(int32(src[0]>>11&0x7f) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>4&0x7f)>>1 ^ -(int32(src[0]>>4&0x7f) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[0]<<3&0x78)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x78)|src[1]>>29) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[1]>>22&0x7f)>>1 ^ -(int32(src[1]>>22&0x7f) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[1]>>15&0x7f)>>1 ^ -(int32(src[1]>>15&0x7f) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[1]>>8&0x7f)>>1 ^ -(int32(src[1]>>8&0x7f) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[1]>>1&0x7f)>>1 ^ -(int32(src[1]>>1&0x7f) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[1]<<6&0x40)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x40)|src[2]>>26) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[2]>>19&0x7f)>>1 ^ -(int32(src[2]>>19&0x7f) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[2]>>12&0x7f)>>1 ^ -(int32(src[2]>>12&0x7f) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[2]>>5&0x7f)>>1 ^ -(int32(src[2]>>5&0x7f) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[2]<<2&0x7c)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x7c)|src[3]>>30) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[3]>>23&0x7f)>>1 ^ -(int32(src[3]>>23&0x7f) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[3]>>16&0x7f)>>1 ^ -(int32(src[3]>>16&0x7f) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[3]>>9&0x7f)>>1 ^ -(int32(src[3]>>9&0x7f) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[3]>>2&0x7f)>>1 ^ -(int32(src[3]>>2&0x7f) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[3]<<5&0x60)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x60)|src[4]>>27) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[4]>>20&0x7f)>>1 ^ -(int32(src[4]>>20&0x7f) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[4]>>13&0x7f)>>1 ^ -(int32(src[4]>>13&0x7f) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[4]>>6&0x7f)>>1 ^ -(int32(src[4]>>6&0x7f) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[4]<<1&0x7e)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7e)|src[5]>>31) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[5]>>24&0x7f)>>1 ^ -(int32(src[5]>>24&0x7f) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[5]>>17&0x7f)>>1 ^ -(int32(src[5]>>17&0x7f) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[5]>>10&0x7f)>>1 ^ -(int32(src[5]>>10&0x7f) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[5]>>3&0x7f)>>1 ^ -(int32(src[5]>>3&0x7f) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[5]<<4&0x70)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x70)|src[6]>>28) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[6]>>21&0x7f)>>1 ^ -(int32(src[6]>>21&0x7f) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[6]>>14&0x7f)>>1 ^ -(int32(src[6]>>14&0x7f) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[6]>>7&0x7f)>>1 ^ -(int32(src[6]>>7&0x7f) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[6]>>0&0x7f)>>1 ^ -(int32(src[6]>>0&0x7f) & 1))
+	dst[31] = offset
+}
+
+func decode8BitDeltaInto[T Integer](dst *[32]T, src *[8]Word, offset T) {
+	offset -= T(int32(src[0]>>24&0xff)>>1 ^ -(int32(src[0]>>24&0xff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>16&0xff)>>1 ^ -(int32(src[0]>>16&0xff) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>8&0xff)>>1 ^ -(int32(src[0]>>8&0xff) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[0]>>0&0xff)>>1 ^ -(int32(src[0]>>0&0xff) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[1]>>24&0xff)>>1 ^ -(int32(src[1]>>24&0xff) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[1]>>16&0xff)>>1 ^ -(int32(src[1]>>16&0xff) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[1]>>8&0xff)>>1 ^ -(int32(src[1]>>8&0xff) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[1]>>0&0xff)>>1 ^ -(int32(src[1]>>0&0xff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[2]>>24&0xff)>>1 ^ -(int32(src[2]>>24&0xff) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[2]>>16&0xff)>>1 ^ -(int32(src[2]>>16&0xff) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[2]>>8&0xff)>>1 ^ -(int32(src[2]>>8&0xff) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[2]>>0&0xff)>>1 ^ -(int32(src[2]>>0&0xff) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[3]>>24&0xff)>>1 ^ -(int32(src[3]>>24&0xff) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[3]>>16&0xff)>>1 ^ -(int32(src[3]>>16&0xff) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[3]>>8&0xff)>>1 ^ -(int32(src[3]>>8&0xff) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[3]>>0&0xff)>>1 ^ -(int32(src[3]>>0&0xff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[4]>>24&0xff)>>1 ^ -(int32(src[4]>>24&0xff) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[4]>>16&0xff)>>1 ^ -(int32(src[4]>>16&0xff) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[4]>>8&0xff)>>1 ^ -(int32(src[4]>>8&0xff) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[4]>>0&0xff)>>1 ^ -(int32(src[4]>>0&0xff) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[5]>>24&0xff)>>1 ^ -(int32(src[5]>>24&0xff) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[5]>>16&0xff)>>1 ^ -(int32(src[5]>>16&0xff) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[5]>>8&0xff)>>1 ^ -(int32(src[5]>>8&0xff) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[5]>>0&0xff)>>1 ^ -(int32(src[5]>>0&0xff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[6]>>24&0xff)>>1 ^ -(int32(src[6]>>24&0xff) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[6]>>16&0xff)>>1 ^ -(int32(src[6]>>16&0xff) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[6]>>8&0xff)>>1 ^ -(int32(src[6]>>8&0xff) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[6]>>0&0xff)>>1 ^ -(int32(src[6]>>0&0xff) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[7]>>24&0xff)>>1 ^ -(int32(src[7]>>24&0xff) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[7]>>16&0xff)>>1 ^ -(int32(src[7]>>16&0xff) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[7]>>8&0xff)>>1 ^ -(int32(src[7]>>8&0xff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[7]>>0&0xff)>>1 ^ -(int32(src[7]>>0&0xff) & 1))
+	dst[31] = offset
+}
+
+func decode9BitDeltaInto[T Integer](dst *[32]T, src *[9]Word, offset T) {
+	offset -= T(int32(src[0]>>23&0x1ff)>>1 ^ -(int32(src[0]>>23&0x1ff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>14&0x1ff)>>1 ^ -(int32(src[0]>>14&0x1ff) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>5&0x1ff)>>1 ^ -(int32(src[0]>>5&0x1ff) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[0]<<4&0x1f0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x1f0)|src[1]>>28) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[1]>>19&0x1ff)>>1 ^ -(int32(src[1]>>19&0x1ff) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[1]>>10&0x1ff)>>1 ^ -(int32(src[1]>>10&0x1ff) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[1]>>1&0x1ff)>>1 ^ -(int32(src[1]>>1&0x1ff) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[1]<<8&0x100)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x100)|src[2]>>24) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[2]>>15&0x1ff)>>1 ^ -(int32(src[2]>>15&0x1ff) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[2]>>6&0x1ff)>>1 ^ -(int32(src[2]>>6&0x1ff) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[2]<<3&0x1f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x1f8)|src[3]>>29) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[3]>>20&0x1ff)>>1 ^ -(int32(src[3]>>20&0x1ff) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[3]>>11&0x1ff)>>1 ^ -(int32(src[3]>>11&0x1ff) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[3]>>2&0x1ff)>>1 ^ -(int32(src[3]>>2&0x1ff) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[3]<<7&0x180)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x180)|src[4]>>25) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[4]>>16&0x1ff)>>1 ^ -(int32(src[4]>>16&0x1ff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[4]>>7&0x1ff)>>1 ^ -(int32(src[4]>>7&0x1ff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[4]<<2&0x1fc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x1fc)|src[5]>>30) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[5]>>21&0x1ff)>>1 ^ -(int32(src[5]>>21&0x1ff) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[5]>>12&0x1ff)>>1 ^ -(int32(src[5]>>12&0x1ff) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[5]>>3&0x1ff)>>1 ^ -(int32(src[5]>>3&0x1ff) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[5]<<6&0x1c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x1c0)|src[6]>>26) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[6]>>17&0x1ff)>>1 ^ -(int32(src[6]>>17&0x1ff) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[6]>>8&0x1ff)>>1 ^ -(int32(src[6]>>8&0x1ff) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[6]<<1&0x1fe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fe)|src[7]>>31) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[7]>>22&0x1ff)>>1 ^ -(int32(src[7]>>22&0x1ff) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[7]>>13&0x1ff)>>1 ^ -(int32(src[7]>>13&0x1ff) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[7]>>4&0x1ff)>>1 ^ -(int32(src[7]>>4&0x1ff) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[7]<<5&0x1e0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1e0)|src[8]>>27) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[8]>>18&0x1ff)>>1 ^ -(int32(src[8]>>18&0x1ff) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[8]>>9&0x1ff)>>1 ^ -(int32(src[8]>>9&0x1ff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[8]>>0&0x1ff)>>1 ^ -(int32(src[8]>>0&0x1ff) & 1))
+	dst[31] = offset
+}
+
+func decode10BitDeltaInto[T Integer](dst *[32]T, src *[10]Word, offset T) {
+	offset -= T(int32(src[0]>>22&0x3ff)>>1 ^ -(int32(src[0]>>22&0x3ff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>12&0x3ff)>>1 ^ -(int32(src[0]>>12&0x3ff) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[0]>>2&0x3ff)>>1 ^ -(int32(src[0]>>2&0x3ff) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[0]<<8&0x300)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0x300)|src[1]>>24) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[1]>>14&0x3ff)>>1 ^ -(int32(src[1]>>14&0x3ff) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[1]>>4&0x3ff)>>1 ^ -(int32(src[1]>>4&0x3ff) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[1]<<6&0x3c0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3c0)|src[2]>>26) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[2]>>16&0x3ff)>>1 ^ -(int32(src[2]>>16&0x3ff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[2]>>6&0x3ff)>>1 ^ -(int32(src[2]>>6&0x3ff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[2]<<4&0x3f0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x3f0)|src[3]>>28) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[3]>>18&0x3ff)>>1 ^ -(int32(src[3]>>18&0x3ff) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[3]>>8&0x3ff)>>1 ^ -(int32(src[3]>>8&0x3ff) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[3]<<2&0x3fc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fc)|src[4]>>30) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[4]>>20&0x3ff)>>1 ^ -(int32(src[4]>>20&0x3ff) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[4]>>10&0x3ff)>>1 ^ -(int32(src[4]>>10&0x3ff) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[4]>>0&0x3ff)>>1 ^ -(int32(src[4]>>0&0x3ff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[5]>>22&0x3ff)>>1 ^ -(int32(src[5]>>22&0x3ff) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[5]>>12&0x3ff)>>1 ^ -(int32(src[5]>>12&0x3ff) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[5]>>2&0x3ff)>>1 ^ -(int32(src[5]>>2&0x3ff) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[5]<<8&0x300)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x300)|src[6]>>24) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[6]>>14&0x3ff)>>1 ^ -(int32(src[6]>>14&0x3ff) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[6]>>4&0x3ff)>>1 ^ -(int32(src[6]>>4&0x3ff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[6]<<6&0x3c0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x3c0)|src[7]>>26) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[7]>>16&0x3ff)>>1 ^ -(int32(src[7]>>16&0x3ff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[7]>>6&0x3ff)>>1 ^ -(int32(src[7]>>6&0x3ff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[7]<<4&0x3f0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3f0)|src[8]>>28) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[8]>>18&0x3ff)>>1 ^ -(int32(src[8]>>18&0x3ff) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[8]>>8&0x3ff)>>1 ^ -(int32(src[8]>>8&0x3ff) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[8]<<2&0x3fc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x3fc)|src[9]>>30) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[9]>>20&0x3ff)>>1 ^ -(int32(src[9]>>20&0x3ff) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[9]>>10&0x3ff)>>1 ^ -(int32(src[9]>>10&0x3ff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[9]>>0&0x3ff)>>1 ^ -(int32(src[9]>>0&0x3ff) & 1))
+	dst[31] = offset
+}
+
+func decode11BitDeltaInto[T Integer](dst *[32]T, src *[11]Word, offset T) {
+	offset -= T(int32(src[0]>>21&0x7ff)>>1 ^ -(int32(src[0]>>21&0x7ff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>10&0x7ff)>>1 ^ -(int32(src[0]>>10&0x7ff) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[0]<<1&0x7fe)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x7fe)|src[1]>>31) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[1]>>20&0x7ff)>>1 ^ -(int32(src[1]>>20&0x7ff) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[1]>>9&0x7ff)>>1 ^ -(int32(src[1]>>9&0x7ff) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[1]<<2&0x7fc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x7fc)|src[2]>>30) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[2]>>19&0x7ff)>>1 ^ -(int32(src[2]>>19&0x7ff) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[2]>>8&0x7ff)>>1 ^ -(int32(src[2]>>8&0x7ff) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[2]<<3&0x7f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x7f8)|src[3]>>29) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[3]>>18&0x7ff)>>1 ^ -(int32(src[3]>>18&0x7ff) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[3]>>7&0x7ff)>>1 ^ -(int32(src[3]>>7&0x7ff) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[3]<<4&0x7f0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x7f0)|src[4]>>28) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[4]>>17&0x7ff)>>1 ^ -(int32(src[4]>>17&0x7ff) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[4]>>6&0x7ff)>>1 ^ -(int32(src[4]>>6&0x7ff) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[4]<<5&0x7e0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7e0)|src[5]>>27) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[5]>>16&0x7ff)>>1 ^ -(int32(src[5]>>16&0x7ff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[5]>>5&0x7ff)>>1 ^ -(int32(src[5]>>5&0x7ff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[5]<<6&0x7c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x7c0)|src[6]>>26) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[6]>>15&0x7ff)>>1 ^ -(int32(src[6]>>15&0x7ff) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[6]>>4&0x7ff)>>1 ^ -(int32(src[6]>>4&0x7ff) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[6]<<7&0x780)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x780)|src[7]>>25) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[7]>>14&0x7ff)>>1 ^ -(int32(src[7]>>14&0x7ff) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[7]>>3&0x7ff)>>1 ^ -(int32(src[7]>>3&0x7ff) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[7]<<8&0x700)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x700)|src[8]>>24) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[8]>>13&0x7ff)>>1 ^ -(int32(src[8]>>13&0x7ff) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[8]>>2&0x7ff)>>1 ^ -(int32(src[8]>>2&0x7ff) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[8]<<9&0x600)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x600)|src[9]>>23) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[9]>>12&0x7ff)>>1 ^ -(int32(src[9]>>12&0x7ff) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[9]>>1&0x7ff)>>1 ^ -(int32(src[9]>>1&0x7ff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[9]<<10&0x400)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x400)|src[10]>>22) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[10]>>11&0x7ff)>>1 ^ -(int32(src[10]>>11&0x7ff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[10]>>0&0x7ff)>>1 ^ -(int32(src[10]>>0&0x7ff) & 1))
+	dst[31] = offset
+}
+
+func decode12BitDeltaInto[T Integer](dst *[32]T, src *[12]Word, offset T) {
+	offset -= T(int32(src[0]>>20&0xfff)>>1 ^ -(int32(src[0]>>20&0xfff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>8&0xfff)>>1 ^ -(int32(src[0]>>8&0xfff) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[0]<<4&0xff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0xff0)|src[1]>>28) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[1]>>16&0xfff)>>1 ^ -(int32(src[1]>>16&0xfff) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[1]>>4&0xfff)>>1 ^ -(int32(src[1]>>4&0xfff) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[1]<<8&0xf00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xf00)|src[2]>>24) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[2]>>12&0xfff)>>1 ^ -(int32(src[2]>>12&0xfff) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[2]>>0&0xfff)>>1 ^ -(int32(src[2]>>0&0xfff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[3]>>20&0xfff)>>1 ^ -(int32(src[3]>>20&0xfff) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[3]>>8&0xfff)>>1 ^ -(int32(src[3]>>8&0xfff) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[3]<<4&0xff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0xff0)|src[4]>>28) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[4]>>16&0xfff)>>1 ^ -(int32(src[4]>>16&0xfff) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[4]>>4&0xfff)>>1 ^ -(int32(src[4]>>4&0xfff) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[4]<<8&0xf00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xf00)|src[5]>>24) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[5]>>12&0xfff)>>1 ^ -(int32(src[5]>>12&0xfff) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[5]>>0&0xfff)>>1 ^ -(int32(src[5]>>0&0xfff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[6]>>20&0xfff)>>1 ^ -(int32(src[6]>>20&0xfff) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[6]>>8&0xfff)>>1 ^ -(int32(src[6]>>8&0xfff) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[6]<<4&0xff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0xff0)|src[7]>>28) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[7]>>16&0xfff)>>1 ^ -(int32(src[7]>>16&0xfff) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[7]>>4&0xfff)>>1 ^ -(int32(src[7]>>4&0xfff) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[7]<<8&0xf00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xf00)|src[8]>>24) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[8]>>12&0xfff)>>1 ^ -(int32(src[8]>>12&0xfff) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[8]>>0&0xfff)>>1 ^ -(int32(src[8]>>0&0xfff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[9]>>20&0xfff)>>1 ^ -(int32(src[9]>>20&0xfff) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[9]>>8&0xfff)>>1 ^ -(int32(src[9]>>8&0xfff) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[9]<<4&0xff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0xff0)|src[10]>>28) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[10]>>16&0xfff)>>1 ^ -(int32(src[10]>>16&0xfff) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[10]>>4&0xfff)>>1 ^ -(int32(src[10]>>4&0xfff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[10]<<8&0xf00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xf00)|src[11]>>24) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[11]>>12&0xfff)>>1 ^ -(int32(src[11]>>12&0xfff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[11]>>0&0xfff)>>1 ^ -(int32(src[11]>>0&0xfff) & 1))
+	dst[31] = offset
+}
+
+func decode13BitDeltaInto[T Integer](dst *[32]T, src *[13]Word, offset T) {
+	offset -= T(int32(src[0]>>19&0x1fff)>>1 ^ -(int32(src[0]>>19&0x1fff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>6&0x1fff)>>1 ^ -(int32(src[0]>>6&0x1fff) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[0]<<7&0x1f80)|src[1]>>25)>>1 ^ -(int32((src[0]<<7&0x1f80)|src[1]>>25) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[1]>>12&0x1fff)>>1 ^ -(int32(src[1]>>12&0x1fff) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[1]<<1&0x1ffe)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1ffe)|src[2]>>31) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[2]>>18&0x1fff)>>1 ^ -(int32(src[2]>>18&0x1fff) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[2]>>5&0x1fff)>>1 ^ -(int32(src[2]>>5&0x1fff) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[2]<<8&0x1f00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x1f00)|src[3]>>24) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[3]>>11&0x1fff)>>1 ^ -(int32(src[3]>>11&0x1fff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[3]<<2&0x1ffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1ffc)|src[4]>>30) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[4]>>17&0x1fff)>>1 ^ -(int32(src[4]>>17&0x1fff) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[4]>>4&0x1fff)>>1 ^ -(int32(src[4]>>4&0x1fff) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[4]<<9&0x1e00)|src[5]>>23)>>1 ^ -(int32((src[4]<<9&0x1e00)|src[5]>>23) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[5]>>10&0x1fff)>>1 ^ -(int32(src[5]>>10&0x1fff) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[5]<<3&0x1ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x1ff8)|src[6]>>29) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[6]>>16&0x1fff)>>1 ^ -(int32(src[6]>>16&0x1fff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[6]>>3&0x1fff)>>1 ^ -(int32(src[6]>>3&0x1fff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[6]<<10&0x1c00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x1c00)|src[7]>>22) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[7]>>9&0x1fff)>>1 ^ -(int32(src[7]>>9&0x1fff) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[7]<<4&0x1ff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x1ff0)|src[8]>>28) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[8]>>15&0x1fff)>>1 ^ -(int32(src[8]>>15&0x1fff) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[8]>>2&0x1fff)>>1 ^ -(int32(src[8]>>2&0x1fff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[8]<<11&0x1800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x1800)|src[9]>>21) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[9]>>8&0x1fff)>>1 ^ -(int32(src[9]>>8&0x1fff) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[9]<<5&0x1fe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1fe0)|src[10]>>27) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[10]>>14&0x1fff)>>1 ^ -(int32(src[10]>>14&0x1fff) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[10]>>1&0x1fff)>>1 ^ -(int32(src[10]>>1&0x1fff) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[10]<<12&0x1000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x1000)|src[11]>>20) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[11]>>7&0x1fff)>>1 ^ -(int32(src[11]>>7&0x1fff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[11]<<6&0x1fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x1fc0)|src[12]>>26) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[12]>>13&0x1fff)>>1 ^ -(int32(src[12]>>13&0x1fff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[12]>>0&0x1fff)>>1 ^ -(int32(src[12]>>0&0x1fff) & 1))
+	dst[31] = offset
+}
+
+func decode14BitDeltaInto[T Integer](dst *[32]T, src *[14]Word, offset T) {
+	offset -= T(int32(src[0]>>18&0x3fff)>>1 ^ -(int32(src[0]>>18&0x3fff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>4&0x3fff)>>1 ^ -(int32(src[0]>>4&0x3fff) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[0]<<10&0x3c00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x3c00)|src[1]>>22) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[1]>>8&0x3fff)>>1 ^ -(int32(src[1]>>8&0x3fff) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[1]<<6&0x3fc0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3fc0)|src[2]>>26) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[2]>>12&0x3fff)>>1 ^ -(int32(src[2]>>12&0x3fff) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[2]<<2&0x3ffc)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x3ffc)|src[3]>>30) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[3]>>16&0x3fff)>>1 ^ -(int32(src[3]>>16&0x3fff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[3]>>2&0x3fff)>>1 ^ -(int32(src[3]>>2&0x3fff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[3]<<12&0x3000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0x3000)|src[4]>>20) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[4]>>6&0x3fff)>>1 ^ -(int32(src[4]>>6&0x3fff) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[4]<<8&0x3f00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x3f00)|src[5]>>24) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[5]>>10&0x3fff)>>1 ^ -(int32(src[5]>>10&0x3fff) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[5]<<4&0x3ff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x3ff0)|src[6]>>28) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[6]>>14&0x3fff)>>1 ^ -(int32(src[6]>>14&0x3fff) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[6]>>0&0x3fff)>>1 ^ -(int32(src[6]>>0&0x3fff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[7]>>18&0x3fff)>>1 ^ -(int32(src[7]>>18&0x3fff) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[7]>>4&0x3fff)>>1 ^ -(int32(src[7]>>4&0x3fff) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[7]<<10&0x3c00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x3c00)|src[8]>>22) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[8]>>8&0x3fff)>>1 ^ -(int32(src[8]>>8&0x3fff) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[8]<<6&0x3fc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x3fc0)|src[9]>>26) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[9]>>12&0x3fff)>>1 ^ -(int32(src[9]>>12&0x3fff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[9]<<2&0x3ffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x3ffc)|src[10]>>30) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[10]>>16&0x3fff)>>1 ^ -(int32(src[10]>>16&0x3fff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[10]>>2&0x3fff)>>1 ^ -(int32(src[10]>>2&0x3fff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[10]<<12&0x3000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3000)|src[11]>>20) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[11]>>6&0x3fff)>>1 ^ -(int32(src[11]>>6&0x3fff) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[11]<<8&0x3f00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0x3f00)|src[12]>>24) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[12]>>10&0x3fff)>>1 ^ -(int32(src[12]>>10&0x3fff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[12]<<4&0x3ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ff0)|src[13]>>28) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[13]>>14&0x3fff)>>1 ^ -(int32(src[13]>>14&0x3fff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[13]>>0&0x3fff)>>1 ^ -(int32(src[13]>>0&0x3fff) & 1))
+	dst[31] = offset
+}
+
+func decode15BitDeltaInto[T Integer](dst *[32]T, src *[15]Word, offset T) {
+	offset -= T(int32(src[0]>>17&0x7fff)>>1 ^ -(int32(src[0]>>17&0x7fff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>2&0x7fff)>>1 ^ -(int32(src[0]>>2&0x7fff) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[0]<<13&0x6000)|src[1]>>19)>>1 ^ -(int32((src[0]<<13&0x6000)|src[1]>>19) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[1]>>4&0x7fff)>>1 ^ -(int32(src[1]>>4&0x7fff) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[1]<<11&0x7800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x7800)|src[2]>>21) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[2]>>6&0x7fff)>>1 ^ -(int32(src[2]>>6&0x7fff) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[2]<<9&0x7e00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x7e00)|src[3]>>23) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[3]>>8&0x7fff)>>1 ^ -(int32(src[3]>>8&0x7fff) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[3]<<7&0x7f80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7f80)|src[4]>>25) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[4]>>10&0x7fff)>>1 ^ -(int32(src[4]>>10&0x7fff) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[4]<<5&0x7fe0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7fe0)|src[5]>>27) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[5]>>12&0x7fff)>>1 ^ -(int32(src[5]>>12&0x7fff) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[5]<<3&0x7ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x7ff8)|src[6]>>29) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[6]>>14&0x7fff)>>1 ^ -(int32(src[6]>>14&0x7fff) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[6]<<1&0x7ffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x7ffe)|src[7]>>31) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[7]>>16&0x7fff)>>1 ^ -(int32(src[7]>>16&0x7fff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[7]>>1&0x7fff)>>1 ^ -(int32(src[7]>>1&0x7fff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[7]<<14&0x4000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x4000)|src[8]>>18) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[8]>>3&0x7fff)>>1 ^ -(int32(src[8]>>3&0x7fff) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[8]<<12&0x7000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x7000)|src[9]>>20) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[9]>>5&0x7fff)>>1 ^ -(int32(src[9]>>5&0x7fff) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[9]<<10&0x7c00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x7c00)|src[10]>>22) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[10]>>7&0x7fff)>>1 ^ -(int32(src[10]>>7&0x7fff) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[10]<<8&0x7f00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x7f00)|src[11]>>24) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[11]>>9&0x7fff)>>1 ^ -(int32(src[11]>>9&0x7fff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[11]<<6&0x7fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x7fc0)|src[12]>>26) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[12]>>11&0x7fff)>>1 ^ -(int32(src[12]>>11&0x7fff) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[12]<<4&0x7ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x7ff0)|src[13]>>28) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[13]>>13&0x7fff)>>1 ^ -(int32(src[13]>>13&0x7fff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[13]<<2&0x7ffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x7ffc)|src[14]>>30) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[14]>>15&0x7fff)>>1 ^ -(int32(src[14]>>15&0x7fff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[14]>>0&0x7fff)>>1 ^ -(int32(src[14]>>0&0x7fff) & 1))
+	dst[31] = offset
+}
+
+func decode16BitDeltaInto[T Integer](dst *[32]T, src *[16]Word, offset T) {
+	offset -= T(int32(src[0]>>16&0xffff)>>1 ^ -(int32(src[0]>>16&0xffff) & 1))
+	dst[0] = offset
+	offset -= T(int32(src[0]>>0&0xffff)>>1 ^ -(int32(src[0]>>0&0xffff) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[1]>>16&0xffff)>>1 ^ -(int32(src[1]>>16&0xffff) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[1]>>0&0xffff)>>1 ^ -(int32(src[1]>>0&0xffff) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[2]>>16&0xffff)>>1 ^ -(int32(src[2]>>16&0xffff) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[2]>>0&0xffff)>>1 ^ -(int32(src[2]>>0&0xffff) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[3]>>16&0xffff)>>1 ^ -(int32(src[3]>>16&0xffff) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[3]>>0&0xffff)>>1 ^ -(int32(src[3]>>0&0xffff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[4]>>16&0xffff)>>1 ^ -(int32(src[4]>>16&0xffff) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[4]>>0&0xffff)>>1 ^ -(int32(src[4]>>0&0xffff) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[5]>>16&0xffff)>>1 ^ -(int32(src[5]>>16&0xffff) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[5]>>0&0xffff)>>1 ^ -(int32(src[5]>>0&0xffff) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[6]>>16&0xffff)>>1 ^ -(int32(src[6]>>16&0xffff) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[6]>>0&0xffff)>>1 ^ -(int32(src[6]>>0&0xffff) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[7]>>16&0xffff)>>1 ^ -(int32(src[7]>>16&0xffff) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[7]>>0&0xffff)>>1 ^ -(int32(src[7]>>0&0xffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[8]>>16&0xffff)>>1 ^ -(int32(src[8]>>16&0xffff) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[8]>>0&0xffff)>>1 ^ -(int32(src[8]>>0&0xffff) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[9]>>16&0xffff)>>1 ^ -(int32(src[9]>>16&0xffff) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[9]>>0&0xffff)>>1 ^ -(int32(src[9]>>0&0xffff) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[10]>>16&0xffff)>>1 ^ -(int32(src[10]>>16&0xffff) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[10]>>0&0xffff)>>1 ^ -(int32(src[10]>>0&0xffff) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[11]>>16&0xffff)>>1 ^ -(int32(src[11]>>16&0xffff) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[11]>>0&0xffff)>>1 ^ -(int32(src[11]>>0&0xffff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[12]>>16&0xffff)>>1 ^ -(int32(src[12]>>16&0xffff) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[12]>>0&0xffff)>>1 ^ -(int32(src[12]>>0&0xffff) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[13]>>16&0xffff)>>1 ^ -(int32(src[13]>>16&0xffff) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[13]>>0&0xffff)>>1 ^ -(int32(src[13]>>0&0xffff) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[14]>>16&0xffff)>>1 ^ -(int32(src[14]>>16&0xffff) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[14]>>0&0xffff)>>1 ^ -(int32(src[14]>>0&0xffff) & 1))
+	dst[29] = offset
+	offset -= T(int32(src[15]>>16&0xffff)>>1 ^ -(int32(src[15]>>16&0xffff) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[15]>>0&0xffff)>>1 ^ -(int32(src[15]>>0&0xffff) & 1))
+	dst[31] = offset
+}
+
+func decode17BitDeltaInto[T Integer](dst *[32]T, src *[17]Word, offset T) {
+	offset -= T(int32(src[0]>>15&0x1ffff)>>1 ^ -(int32(src[0]>>15&0x1ffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<2&0x1fffc)|src[1]>>30)>>1 ^ -(int32((src[0]<<2&0x1fffc)|src[1]>>30) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[1]>>13&0x1ffff)>>1 ^ -(int32(src[1]>>13&0x1ffff) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[1]<<4&0x1fff0)|src[2]>>28)>>1 ^ -(int32((src[1]<<4&0x1fff0)|src[2]>>28) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[2]>>11&0x1ffff)>>1 ^ -(int32(src[2]>>11&0x1ffff) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[2]<<6&0x1ffc0)|src[3]>>26)>>1 ^ -(int32((src[2]<<6&0x1ffc0)|src[3]>>26) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[3]>>9&0x1ffff)>>1 ^ -(int32(src[3]>>9&0x1ffff) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[3]<<8&0x1ff00)|src[4]>>24)>>1 ^ -(int32((src[3]<<8&0x1ff00)|src[4]>>24) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[4]>>7&0x1ffff)>>1 ^ -(int32(src[4]>>7&0x1ffff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[4]<<10&0x1fc00)|src[5]>>22)>>1 ^ -(int32((src[4]<<10&0x1fc00)|src[5]>>22) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[5]>>5&0x1ffff)>>1 ^ -(int32(src[5]>>5&0x1ffff) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[5]<<12&0x1f000)|src[6]>>20)>>1 ^ -(int32((src[5]<<12&0x1f000)|src[6]>>20) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[6]>>3&0x1ffff)>>1 ^ -(int32(src[6]>>3&0x1ffff) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[6]<<14&0x1c000)|src[7]>>18)>>1 ^ -(int32((src[6]<<14&0x1c000)|src[7]>>18) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[7]>>1&0x1ffff)>>1 ^ -(int32(src[7]>>1&0x1ffff) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[7]<<16&0x10000)|src[8]>>16)>>1 ^ -(int32((src[7]<<16&0x10000)|src[8]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[8]<<1&0x1fffe)|src[9]>>31)>>1 ^ -(int32((src[8]<<1&0x1fffe)|src[9]>>31) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[9]>>14&0x1ffff)>>1 ^ -(int32(src[9]>>14&0x1ffff) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[9]<<3&0x1fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x1fff8)|src[10]>>29) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[10]>>12&0x1ffff)>>1 ^ -(int32(src[10]>>12&0x1ffff) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[10]<<5&0x1ffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1ffe0)|src[11]>>27) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[11]>>10&0x1ffff)>>1 ^ -(int32(src[11]>>10&0x1ffff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[11]<<7&0x1ff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x1ff80)|src[12]>>25) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[12]>>8&0x1ffff)>>1 ^ -(int32(src[12]>>8&0x1ffff) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[12]<<9&0x1fe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fe00)|src[13]>>23) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[13]>>6&0x1ffff)>>1 ^ -(int32(src[13]>>6&0x1ffff) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[13]<<11&0x1f800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x1f800)|src[14]>>21) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[14]>>4&0x1ffff)>>1 ^ -(int32(src[14]>>4&0x1ffff) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[14]<<13&0x1e000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1e000)|src[15]>>19) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[15]>>2&0x1ffff)>>1 ^ -(int32(src[15]>>2&0x1ffff) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[15]<<15&0x18000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x18000)|src[16]>>17) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[16]>>0&0x1ffff)>>1 ^ -(int32(src[16]>>0&0x1ffff) & 1))
+	dst[31] = offset
+}
+
+func decode18BitDeltaInto[T Integer](dst *[32]T, src *[18]Word, offset T) {
+	offset -= T(int32(src[0]>>14&0x3ffff)>>1 ^ -(int32(src[0]>>14&0x3ffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<4&0x3fff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x3fff0)|src[1]>>28) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[1]>>10&0x3ffff)>>1 ^ -(int32(src[1]>>10&0x3ffff) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[1]<<8&0x3ff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x3ff00)|src[2]>>24) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[2]>>6&0x3ffff)>>1 ^ -(int32(src[2]>>6&0x3ffff) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[2]<<12&0x3f000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x3f000)|src[3]>>20) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[3]>>2&0x3ffff)>>1 ^ -(int32(src[3]>>2&0x3ffff) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[3]<<16&0x30000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0x30000)|src[4]>>16) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[4]<<2&0x3fffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3fffc)|src[5]>>30) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[5]>>12&0x3ffff)>>1 ^ -(int32(src[5]>>12&0x3ffff) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[5]<<6&0x3ffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3ffc0)|src[6]>>26) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[6]>>8&0x3ffff)>>1 ^ -(int32(src[6]>>8&0x3ffff) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[6]<<10&0x3fc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fc00)|src[7]>>22) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[7]>>4&0x3ffff)>>1 ^ -(int32(src[7]>>4&0x3ffff) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[7]<<14&0x3c000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3c000)|src[8]>>18) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[8]>>0&0x3ffff)>>1 ^ -(int32(src[8]>>0&0x3ffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[9]>>14&0x3ffff)>>1 ^ -(int32(src[9]>>14&0x3ffff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[9]<<4&0x3fff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x3fff0)|src[10]>>28) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[10]>>10&0x3ffff)>>1 ^ -(int32(src[10]>>10&0x3ffff) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[10]<<8&0x3ff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3ff00)|src[11]>>24) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[11]>>6&0x3ffff)>>1 ^ -(int32(src[11]>>6&0x3ffff) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[11]<<12&0x3f000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3f000)|src[12]>>20) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[12]>>2&0x3ffff)>>1 ^ -(int32(src[12]>>2&0x3ffff) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[12]<<16&0x30000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x30000)|src[13]>>16) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[13]<<2&0x3fffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3fffc)|src[14]>>30) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[14]>>12&0x3ffff)>>1 ^ -(int32(src[14]>>12&0x3ffff) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[14]<<6&0x3ffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x3ffc0)|src[15]>>26) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[15]>>8&0x3ffff)>>1 ^ -(int32(src[15]>>8&0x3ffff) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[15]<<10&0x3fc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x3fc00)|src[16]>>22) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[16]>>4&0x3ffff)>>1 ^ -(int32(src[16]>>4&0x3ffff) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[16]<<14&0x3c000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x3c000)|src[17]>>18) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[17]>>0&0x3ffff)>>1 ^ -(int32(src[17]>>0&0x3ffff) & 1))
+	dst[31] = offset
+}
+
+func decode19BitDeltaInto[T Integer](dst *[32]T, src *[19]Word, offset T) {
+	offset -= T(int32(src[0]>>13&0x7ffff)>>1 ^ -(int32(src[0]>>13&0x7ffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<6&0x7ffc0)|src[1]>>26)>>1 ^ -(int32((src[0]<<6&0x7ffc0)|src[1]>>26) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[1]>>7&0x7ffff)>>1 ^ -(int32(src[1]>>7&0x7ffff) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[1]<<12&0x7f000)|src[2]>>20)>>1 ^ -(int32((src[1]<<12&0x7f000)|src[2]>>20) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[2]>>1&0x7ffff)>>1 ^ -(int32(src[2]>>1&0x7ffff) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[2]<<18&0x40000)|src[3]>>14)>>1 ^ -(int32((src[2]<<18&0x40000)|src[3]>>14) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[3]<<5&0x7ffe0)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x7ffe0)|src[4]>>27) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[4]>>8&0x7ffff)>>1 ^ -(int32(src[4]>>8&0x7ffff) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[4]<<11&0x7f800)|src[5]>>21)>>1 ^ -(int32((src[4]<<11&0x7f800)|src[5]>>21) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[5]>>2&0x7ffff)>>1 ^ -(int32(src[5]>>2&0x7ffff) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[5]<<17&0x60000)|src[6]>>15)>>1 ^ -(int32((src[5]<<17&0x60000)|src[6]>>15) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[6]<<4&0x7fff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0x7fff0)|src[7]>>28) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[7]>>9&0x7ffff)>>1 ^ -(int32(src[7]>>9&0x7ffff) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[7]<<10&0x7fc00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x7fc00)|src[8]>>22) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[8]>>3&0x7ffff)>>1 ^ -(int32(src[8]>>3&0x7ffff) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[8]<<16&0x70000)|src[9]>>16)>>1 ^ -(int32((src[8]<<16&0x70000)|src[9]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[9]<<3&0x7fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x7fff8)|src[10]>>29) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[10]>>10&0x7ffff)>>1 ^ -(int32(src[10]>>10&0x7ffff) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[10]<<9&0x7fe00)|src[11]>>23)>>1 ^ -(int32((src[10]<<9&0x7fe00)|src[11]>>23) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[11]>>4&0x7ffff)>>1 ^ -(int32(src[11]>>4&0x7ffff) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[11]<<15&0x78000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x78000)|src[12]>>17) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[12]<<2&0x7fffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x7fffc)|src[13]>>30) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[13]>>11&0x7ffff)>>1 ^ -(int32(src[13]>>11&0x7ffff) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[13]<<8&0x7ff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0x7ff00)|src[14]>>24) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[14]>>5&0x7ffff)>>1 ^ -(int32(src[14]>>5&0x7ffff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[14]<<14&0x7c000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x7c000)|src[15]>>18) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[15]<<1&0x7fffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffe)|src[16]>>31) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[16]>>12&0x7ffff)>>1 ^ -(int32(src[16]>>12&0x7ffff) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[16]<<7&0x7ff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x7ff80)|src[17]>>25) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[17]>>6&0x7ffff)>>1 ^ -(int32(src[17]>>6&0x7ffff) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[17]<<13&0x7e000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7e000)|src[18]>>19) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[18]>>0&0x7ffff)>>1 ^ -(int32(src[18]>>0&0x7ffff) & 1))
+	dst[31] = offset
+}
+
+func decode20BitDeltaInto[T Integer](dst *[32]T, src *[20]Word, offset T) {
+	offset -= T(int32(src[0]>>12&0xfffff)>>1 ^ -(int32(src[0]>>12&0xfffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<8&0xfff00)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0xfff00)|src[1]>>24) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[1]>>4&0xfffff)>>1 ^ -(int32(src[1]>>4&0xfffff) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[1]<<16&0xf0000)|src[2]>>16)>>1 ^ -(int32((src[1]<<16&0xf0000)|src[2]>>16) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[2]<<4&0xffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0xffff0)|src[3]>>28) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[3]>>8&0xfffff)>>1 ^ -(int32(src[3]>>8&0xfffff) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[3]<<12&0xff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xff000)|src[4]>>20) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[4]>>0&0xfffff)>>1 ^ -(int32(src[4]>>0&0xfffff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[5]>>12&0xfffff)>>1 ^ -(int32(src[5]>>12&0xfffff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[5]<<8&0xfff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0xfff00)|src[6]>>24) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[6]>>4&0xfffff)>>1 ^ -(int32(src[6]>>4&0xfffff) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[6]<<16&0xf0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xf0000)|src[7]>>16) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[7]<<4&0xffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0xffff0)|src[8]>>28) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[8]>>8&0xfffff)>>1 ^ -(int32(src[8]>>8&0xfffff) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[8]<<12&0xff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0xff000)|src[9]>>20) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[9]>>0&0xfffff)>>1 ^ -(int32(src[9]>>0&0xfffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[10]>>12&0xfffff)>>1 ^ -(int32(src[10]>>12&0xfffff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[10]<<8&0xfff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xfff00)|src[11]>>24) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[11]>>4&0xfffff)>>1 ^ -(int32(src[11]>>4&0xfffff) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[11]<<16&0xf0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0xf0000)|src[12]>>16) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[12]<<4&0xffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffff0)|src[13]>>28) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[13]>>8&0xfffff)>>1 ^ -(int32(src[13]>>8&0xfffff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[13]<<12&0xff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0xff000)|src[14]>>20) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[14]>>0&0xfffff)>>1 ^ -(int32(src[14]>>0&0xfffff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[15]>>12&0xfffff)>>1 ^ -(int32(src[15]>>12&0xfffff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[15]<<8&0xfff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0xfff00)|src[16]>>24) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[16]>>4&0xfffff)>>1 ^ -(int32(src[16]>>4&0xfffff) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[16]<<16&0xf0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xf0000)|src[17]>>16) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[17]<<4&0xffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0xffff0)|src[18]>>28) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[18]>>8&0xfffff)>>1 ^ -(int32(src[18]>>8&0xfffff) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[18]<<12&0xff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0xff000)|src[19]>>20) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[19]>>0&0xfffff)>>1 ^ -(int32(src[19]>>0&0xfffff) & 1))
+	dst[31] = offset
+}
+
+func decode21BitDeltaInto[T Integer](dst *[32]T, src *[21]Word, offset T) {
+	offset -= T(int32(src[0]>>11&0x1fffff)>>1 ^ -(int32(src[0]>>11&0x1fffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<10&0x1ffc00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x1ffc00)|src[1]>>22) & 1))
+	dst[1] = offset
+	offset -= T(int32(src[1]>>1&0x1fffff)>>1 ^ -(int32(src[1]>>1&0x1fffff) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[1]<<20&0x100000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0x100000)|src[2]>>12) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[2]<<9&0x1ffe00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x1ffe00)|src[3]>>23) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[3]>>2&0x1fffff)>>1 ^ -(int32(src[3]>>2&0x1fffff) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[3]<<19&0x180000)|src[4]>>13)>>1 ^ -(int32((src[3]<<19&0x180000)|src[4]>>13) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[4]<<8&0x1fff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x1fff00)|src[5]>>24) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[5]>>3&0x1fffff)>>1 ^ -(int32(src[5]>>3&0x1fffff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[5]<<18&0x1c0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x1c0000)|src[6]>>14) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[6]<<7&0x1fff80)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x1fff80)|src[7]>>25) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[7]>>4&0x1fffff)>>1 ^ -(int32(src[7]>>4&0x1fffff) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[7]<<17&0x1e0000)|src[8]>>15)>>1 ^ -(int32((src[7]<<17&0x1e0000)|src[8]>>15) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[8]<<6&0x1fffc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x1fffc0)|src[9]>>26) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[9]>>5&0x1fffff)>>1 ^ -(int32(src[9]>>5&0x1fffff) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[9]<<16&0x1f0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0x1f0000)|src[10]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[10]<<5&0x1fffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1fffe0)|src[11]>>27) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[11]>>6&0x1fffff)>>1 ^ -(int32(src[11]>>6&0x1fffff) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[11]<<15&0x1f8000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x1f8000)|src[12]>>17) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[12]<<4&0x1ffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x1ffff0)|src[13]>>28) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[13]>>7&0x1fffff)>>1 ^ -(int32(src[13]>>7&0x1fffff) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[13]<<14&0x1fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x1fc000)|src[14]>>18) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[14]<<3&0x1ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x1ffff8)|src[15]>>29) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[15]>>8&0x1fffff)>>1 ^ -(int32(src[15]>>8&0x1fffff) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[15]<<13&0x1fe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1fe000)|src[16]>>19) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[16]<<2&0x1ffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x1ffffc)|src[17]>>30) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[17]>>9&0x1fffff)>>1 ^ -(int32(src[17]>>9&0x1fffff) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[17]<<12&0x1ff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0x1ff000)|src[18]>>20) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[18]<<1&0x1ffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffe)|src[19]>>31) & 1))
+	dst[28] = offset
+	offset -= T(int32(src[19]>>10&0x1fffff)>>1 ^ -(int32(src[19]>>10&0x1fffff) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[19]<<11&0x1ff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x1ff800)|src[20]>>21) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[20]>>0&0x1fffff)>>1 ^ -(int32(src[20]>>0&0x1fffff) & 1))
+	dst[31] = offset
+}
+
+func decode22BitDeltaInto[T Integer](dst *[32]T, src *[22]Word, offset T) {
+	offset -= T(int32(src[0]>>10&0x3fffff)>>1 ^ -(int32(src[0]>>10&0x3fffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<12&0x3ff000)|src[1]>>20)>>1 ^ -(int32((src[0]<<12&0x3ff000)|src[1]>>20) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<2&0x3ffffc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3ffffc)|src[2]>>30) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[2]>>8&0x3fffff)>>1 ^ -(int32(src[2]>>8&0x3fffff) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[2]<<14&0x3fc000)|src[3]>>18)>>1 ^ -(int32((src[2]<<14&0x3fc000)|src[3]>>18) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[3]<<4&0x3ffff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x3ffff0)|src[4]>>28) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[4]>>6&0x3fffff)>>1 ^ -(int32(src[4]>>6&0x3fffff) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[4]<<16&0x3f0000)|src[5]>>16)>>1 ^ -(int32((src[4]<<16&0x3f0000)|src[5]>>16) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[5]<<6&0x3fffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3fffc0)|src[6]>>26) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[6]>>4&0x3fffff)>>1 ^ -(int32(src[6]>>4&0x3fffff) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[6]<<18&0x3c0000)|src[7]>>14)>>1 ^ -(int32((src[6]<<18&0x3c0000)|src[7]>>14) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[7]<<8&0x3fff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x3fff00)|src[8]>>24) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[8]>>2&0x3fffff)>>1 ^ -(int32(src[8]>>2&0x3fffff) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[8]<<20&0x300000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0x300000)|src[9]>>12) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[9]<<10&0x3ffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffc00)|src[10]>>22) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[10]>>0&0x3fffff)>>1 ^ -(int32(src[10]>>0&0x3fffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[11]>>10&0x3fffff)>>1 ^ -(int32(src[11]>>10&0x3fffff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[11]<<12&0x3ff000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3ff000)|src[12]>>20) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[12]<<2&0x3ffffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x3ffffc)|src[13]>>30) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[13]>>8&0x3fffff)>>1 ^ -(int32(src[13]>>8&0x3fffff) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[13]<<14&0x3fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x3fc000)|src[14]>>18) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[14]<<4&0x3ffff0)|src[15]>>28)>>1 ^ -(int32((src[14]<<4&0x3ffff0)|src[15]>>28) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[15]>>6&0x3fffff)>>1 ^ -(int32(src[15]>>6&0x3fffff) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[15]<<16&0x3f0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0x3f0000)|src[16]>>16) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[16]<<6&0x3fffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x3fffc0)|src[17]>>26) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[17]>>4&0x3fffff)>>1 ^ -(int32(src[17]>>4&0x3fffff) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[17]<<18&0x3c0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x3c0000)|src[18]>>14) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[18]<<8&0x3fff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0x3fff00)|src[19]>>24) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[19]>>2&0x3fffff)>>1 ^ -(int32(src[19]>>2&0x3fffff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[19]<<20&0x300000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x300000)|src[20]>>12) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[20]<<10&0x3ffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x3ffc00)|src[21]>>22) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[21]>>0&0x3fffff)>>1 ^ -(int32(src[21]>>0&0x3fffff) & 1))
+	dst[31] = offset
+}
+
+func decode23BitDeltaInto[T Integer](dst *[32]T, src *[23]Word, offset T) {
+	offset -= T(int32(src[0]>>9&0x7fffff)>>1 ^ -(int32(src[0]>>9&0x7fffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<14&0x7fc000)|src[1]>>18)>>1 ^ -(int32((src[0]<<14&0x7fc000)|src[1]>>18) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<5&0x7fffe0)|src[2]>>27)>>1 ^ -(int32((src[1]<<5&0x7fffe0)|src[2]>>27) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[2]>>4&0x7fffff)>>1 ^ -(int32(src[2]>>4&0x7fffff) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[2]<<19&0x780000)|src[3]>>13)>>1 ^ -(int32((src[2]<<19&0x780000)|src[3]>>13) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[3]<<10&0x7ffc00)|src[4]>>22)>>1 ^ -(int32((src[3]<<10&0x7ffc00)|src[4]>>22) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[4]<<1&0x7ffffe)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7ffffe)|src[5]>>31) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[5]>>8&0x7fffff)>>1 ^ -(int32(src[5]>>8&0x7fffff) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[5]<<15&0x7f8000)|src[6]>>17)>>1 ^ -(int32((src[5]<<15&0x7f8000)|src[6]>>17) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[6]<<6&0x7fffc0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x7fffc0)|src[7]>>26) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[7]>>3&0x7fffff)>>1 ^ -(int32(src[7]>>3&0x7fffff) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[7]<<20&0x700000)|src[8]>>12)>>1 ^ -(int32((src[7]<<20&0x700000)|src[8]>>12) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[8]<<11&0x7ff800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x7ff800)|src[9]>>21) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[9]<<2&0x7ffffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x7ffffc)|src[10]>>30) & 1))
+	dst[13] = offset
+	offset -= T(int32(src[10]>>7&0x7fffff)>>1 ^ -(int32(src[10]>>7&0x7fffff) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[10]<<16&0x7f0000)|src[11]>>16)>>1 ^ -(int32((src[10]<<16&0x7f0000)|src[11]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[11]<<7&0x7fff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x7fff80)|src[12]>>25) & 1))
+	dst[16] = offset
+	offset -= T(int32(src[12]>>2&0x7fffff)>>1 ^ -(int32(src[12]>>2&0x7fffff) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[12]<<21&0x600000)|src[13]>>11)>>1 ^ -(int32((src[12]<<21&0x600000)|src[13]>>11) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[13]<<12&0x7ff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0x7ff000)|src[14]>>20) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[14]<<3&0x7ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x7ffff8)|src[15]>>29) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[15]>>6&0x7fffff)>>1 ^ -(int32(src[15]>>6&0x7fffff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[15]<<17&0x7e0000)|src[16]>>15)>>1 ^ -(int32((src[15]<<17&0x7e0000)|src[16]>>15) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[16]<<8&0x7fff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0x7fff00)|src[17]>>24) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[17]>>1&0x7fffff)>>1 ^ -(int32(src[17]>>1&0x7fffff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[17]<<22&0x400000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x400000)|src[18]>>10) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[18]<<13&0x7fe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7fe000)|src[19]>>19) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[19]<<4&0x7ffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0x7ffff0)|src[20]>>28) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[20]>>5&0x7fffff)>>1 ^ -(int32(src[20]>>5&0x7fffff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[20]<<18&0x7c0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x7c0000)|src[21]>>14) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[21]<<9&0x7ffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffe00)|src[22]>>23) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[22]>>0&0x7fffff)>>1 ^ -(int32(src[22]>>0&0x7fffff) & 1))
+	dst[31] = offset
+}
+
+func decode24BitDeltaInto[T Integer](dst *[32]T, src *[24]Word, offset T) {
+	offset -= T(int32(src[0]>>8&0xffffff)>>1 ^ -(int32(src[0]>>8&0xffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<16&0xff0000)|src[1]>>16)>>1 ^ -(int32((src[0]<<16&0xff0000)|src[1]>>16) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<8&0xffff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xffff00)|src[2]>>24) & 1))
+	dst[2] = offset
+	offset -= T(int32(src[2]>>0&0xffffff)>>1 ^ -(int32(src[2]>>0&0xffffff) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[3]>>8&0xffffff)>>1 ^ -(int32(src[3]>>8&0xffffff) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[3]<<16&0xff0000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0xff0000)|src[4]>>16) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[4]<<8&0xffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xffff00)|src[5]>>24) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[5]>>0&0xffffff)>>1 ^ -(int32(src[5]>>0&0xffffff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[6]>>8&0xffffff)>>1 ^ -(int32(src[6]>>8&0xffffff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[6]<<16&0xff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xff0000)|src[7]>>16) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[7]<<8&0xffff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xffff00)|src[8]>>24) & 1))
+	dst[10] = offset
+	offset -= T(int32(src[8]>>0&0xffffff)>>1 ^ -(int32(src[8]>>0&0xffffff) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[9]>>8&0xffffff)>>1 ^ -(int32(src[9]>>8&0xffffff) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[9]<<16&0xff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xff0000)|src[10]>>16) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[10]<<8&0xffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xffff00)|src[11]>>24) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[11]>>0&0xffffff)>>1 ^ -(int32(src[11]>>0&0xffffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[12]>>8&0xffffff)>>1 ^ -(int32(src[12]>>8&0xffffff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[12]<<16&0xff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0xff0000)|src[13]>>16) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[13]<<8&0xffff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0xffff00)|src[14]>>24) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[14]>>0&0xffffff)>>1 ^ -(int32(src[14]>>0&0xffffff) & 1))
+	dst[19] = offset
+	offset -= T(int32(src[15]>>8&0xffffff)>>1 ^ -(int32(src[15]>>8&0xffffff) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[15]<<16&0xff0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0xff0000)|src[16]>>16) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[16]<<8&0xffff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0xffff00)|src[17]>>24) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[17]>>0&0xffffff)>>1 ^ -(int32(src[17]>>0&0xffffff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[18]>>8&0xffffff)>>1 ^ -(int32(src[18]>>8&0xffffff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[18]<<16&0xff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0xff0000)|src[19]>>16) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[19]<<8&0xffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0xffff00)|src[20]>>24) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[20]>>0&0xffffff)>>1 ^ -(int32(src[20]>>0&0xffffff) & 1))
+	dst[27] = offset
+	offset -= T(int32(src[21]>>8&0xffffff)>>1 ^ -(int32(src[21]>>8&0xffffff) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[21]<<16&0xff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0xff0000)|src[22]>>16) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[22]<<8&0xffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0xffff00)|src[23]>>24) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[23]>>0&0xffffff)>>1 ^ -(int32(src[23]>>0&0xffffff) & 1))
+	dst[31] = offset
+}
+
+func decode25BitDeltaInto[T Integer](dst *[32]T, src *[25]Word, offset T) {
+	offset -= T(int32(src[0]>>7&0x1ffffff)>>1 ^ -(int32(src[0]>>7&0x1ffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<18&0x1fc0000)|src[1]>>14)>>1 ^ -(int32((src[0]<<18&0x1fc0000)|src[1]>>14) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<11&0x1fff800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x1fff800)|src[2]>>21) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[2]<<4&0x1fffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x1fffff0)|src[3]>>28) & 1))
+	dst[3] = offset
+	offset -= T(int32(src[3]>>3&0x1ffffff)>>1 ^ -(int32(src[3]>>3&0x1ffffff) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[3]<<22&0x1c00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x1c00000)|src[4]>>10) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[4]<<15&0x1ff8000)|src[5]>>17)>>1 ^ -(int32((src[4]<<15&0x1ff8000)|src[5]>>17) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[5]<<8&0x1ffff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x1ffff00)|src[6]>>24) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[6]<<1&0x1fffffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fffffe)|src[7]>>31) & 1))
+	dst[8] = offset
+	offset -= T(int32(src[7]>>6&0x1ffffff)>>1 ^ -(int32(src[7]>>6&0x1ffffff) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[7]<<19&0x1f80000)|src[8]>>13)>>1 ^ -(int32((src[7]<<19&0x1f80000)|src[8]>>13) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[8]<<12&0x1fff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x1fff000)|src[9]>>20) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[9]<<5&0x1ffffe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1ffffe0)|src[10]>>27) & 1))
+	dst[12] = offset
+	offset -= T(int32(src[10]>>2&0x1ffffff)>>1 ^ -(int32(src[10]>>2&0x1ffffff) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[10]<<23&0x1800000)|src[11]>>9)>>1 ^ -(int32((src[10]<<23&0x1800000)|src[11]>>9) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[11]<<16&0x1ff0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0x1ff0000)|src[12]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[12]<<9&0x1fffe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fffe00)|src[13]>>23) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[13]<<2&0x1fffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x1fffffc)|src[14]>>30) & 1))
+	dst[17] = offset
+	offset -= T(int32(src[14]>>5&0x1ffffff)>>1 ^ -(int32(src[14]>>5&0x1ffffff) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[14]<<20&0x1f00000)|src[15]>>12)>>1 ^ -(int32((src[14]<<20&0x1f00000)|src[15]>>12) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[15]<<13&0x1ffe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1ffe000)|src[16]>>19) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[16]<<6&0x1ffffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x1ffffc0)|src[17]>>26) & 1))
+	dst[21] = offset
+	offset -= T(int32(src[17]>>1&0x1ffffff)>>1 ^ -(int32(src[17]>>1&0x1ffffff) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[17]<<24&0x1000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x1000000)|src[18]>>8) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[18]<<17&0x1fe0000)|src[19]>>15)>>1 ^ -(int32((src[18]<<17&0x1fe0000)|src[19]>>15) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[19]<<10&0x1fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x1fffc00)|src[20]>>22) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[20]<<3&0x1fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x1fffff8)|src[21]>>29) & 1))
+	dst[26] = offset
+	offset -= T(int32(src[21]>>4&0x1ffffff)>>1 ^ -(int32(src[21]>>4&0x1ffffff) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[21]<<21&0x1e00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1e00000)|src[22]>>11) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[22]<<14&0x1ffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x1ffc000)|src[23]>>18) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[23]<<7&0x1ffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x1ffff80)|src[24]>>25) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[24]>>0&0x1ffffff)>>1 ^ -(int32(src[24]>>0&0x1ffffff) & 1))
+	dst[31] = offset
+}
+
+func decode26BitDeltaInto[T Integer](dst *[32]T, src *[26]Word, offset T) {
+	offset -= T(int32(src[0]>>6&0x3ffffff)>>1 ^ -(int32(src[0]>>6&0x3ffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<20&0x3f00000)|src[1]>>12)>>1 ^ -(int32((src[0]<<20&0x3f00000)|src[1]>>12) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<14&0x3ffc000)|src[2]>>18)>>1 ^ -(int32((src[1]<<14&0x3ffc000)|src[2]>>18) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[2]<<8&0x3ffff00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x3ffff00)|src[3]>>24) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[3]<<2&0x3fffffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fffffc)|src[4]>>30) & 1))
+	dst[4] = offset
+	offset -= T(int32(src[4]>>4&0x3ffffff)>>1 ^ -(int32(src[4]>>4&0x3ffffff) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[4]<<22&0x3c00000)|src[5]>>10)>>1 ^ -(int32((src[4]<<22&0x3c00000)|src[5]>>10) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[5]<<16&0x3ff0000)|src[6]>>16)>>1 ^ -(int32((src[5]<<16&0x3ff0000)|src[6]>>16) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[6]<<10&0x3fffc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fffc00)|src[7]>>22) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[7]<<4&0x3fffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3fffff0)|src[8]>>28) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[8]>>2&0x3ffffff)>>1 ^ -(int32(src[8]>>2&0x3ffffff) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[8]<<24&0x3000000)|src[9]>>8)>>1 ^ -(int32((src[8]<<24&0x3000000)|src[9]>>8) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[9]<<18&0x3fc0000)|src[10]>>14)>>1 ^ -(int32((src[9]<<18&0x3fc0000)|src[10]>>14) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[10]<<12&0x3fff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3fff000)|src[11]>>20) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[11]<<6&0x3ffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3ffffc0)|src[12]>>26) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[12]>>0&0x3ffffff)>>1 ^ -(int32(src[12]>>0&0x3ffffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[13]>>6&0x3ffffff)>>1 ^ -(int32(src[13]>>6&0x3ffffff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[13]<<20&0x3f00000)|src[14]>>12)>>1 ^ -(int32((src[13]<<20&0x3f00000)|src[14]>>12) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[14]<<14&0x3ffc000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x3ffc000)|src[15]>>18) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[15]<<8&0x3ffff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0x3ffff00)|src[16]>>24) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[16]<<2&0x3fffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x3fffffc)|src[17]>>30) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[17]>>4&0x3ffffff)>>1 ^ -(int32(src[17]>>4&0x3ffffff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[17]<<22&0x3c00000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x3c00000)|src[18]>>10) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[18]<<16&0x3ff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0x3ff0000)|src[19]>>16) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[19]<<10&0x3fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x3fffc00)|src[20]>>22) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[20]<<4&0x3fffff0)|src[21]>>28)>>1 ^ -(int32((src[20]<<4&0x3fffff0)|src[21]>>28) & 1))
+	dst[25] = offset
+	offset -= T(int32(src[21]>>2&0x3ffffff)>>1 ^ -(int32(src[21]>>2&0x3ffffff) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[21]<<24&0x3000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0x3000000)|src[22]>>8) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[22]<<18&0x3fc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x3fc0000)|src[23]>>14) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[23]<<12&0x3fff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3fff000)|src[24]>>20) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[24]<<6&0x3ffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x3ffffc0)|src[25]>>26) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[25]>>0&0x3ffffff)>>1 ^ -(int32(src[25]>>0&0x3ffffff) & 1))
+	dst[31] = offset
+}
+
+func decode27BitDeltaInto[T Integer](dst *[32]T, src *[27]Word, offset T) {
+	offset -= T(int32(src[0]>>5&0x7ffffff)>>1 ^ -(int32(src[0]>>5&0x7ffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<22&0x7c00000)|src[1]>>10)>>1 ^ -(int32((src[0]<<22&0x7c00000)|src[1]>>10) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<17&0x7fe0000)|src[2]>>15)>>1 ^ -(int32((src[1]<<17&0x7fe0000)|src[2]>>15) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[2]<<12&0x7fff000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x7fff000)|src[3]>>20) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[3]<<7&0x7ffff80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7ffff80)|src[4]>>25) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[4]<<2&0x7fffffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x7fffffc)|src[5]>>30) & 1))
+	dst[5] = offset
+	offset -= T(int32(src[5]>>3&0x7ffffff)>>1 ^ -(int32(src[5]>>3&0x7ffffff) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[5]<<24&0x7000000)|src[6]>>8)>>1 ^ -(int32((src[5]<<24&0x7000000)|src[6]>>8) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[6]<<19&0x7f80000)|src[7]>>13)>>1 ^ -(int32((src[6]<<19&0x7f80000)|src[7]>>13) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[7]<<14&0x7ffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x7ffc000)|src[8]>>18) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[8]<<9&0x7fffe00)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x7fffe00)|src[9]>>23) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[9]<<4&0x7fffff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x7fffff0)|src[10]>>28) & 1))
+	dst[11] = offset
+	offset -= T(int32(src[10]>>1&0x7ffffff)>>1 ^ -(int32(src[10]>>1&0x7ffffff) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[10]<<26&0x4000000)|src[11]>>6)>>1 ^ -(int32((src[10]<<26&0x4000000)|src[11]>>6) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[11]<<21&0x7e00000)|src[12]>>11)>>1 ^ -(int32((src[11]<<21&0x7e00000)|src[12]>>11) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[12]<<16&0x7ff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x7ff0000)|src[13]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[13]<<11&0x7fff800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x7fff800)|src[14]>>21) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[14]<<6&0x7ffffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x7ffffc0)|src[15]>>26) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[15]<<1&0x7fffffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffffe)|src[16]>>31) & 1))
+	dst[18] = offset
+	offset -= T(int32(src[16]>>4&0x7ffffff)>>1 ^ -(int32(src[16]>>4&0x7ffffff) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[16]<<23&0x7800000)|src[17]>>9)>>1 ^ -(int32((src[16]<<23&0x7800000)|src[17]>>9) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[17]<<18&0x7fc0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x7fc0000)|src[18]>>14) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[18]<<13&0x7ffe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7ffe000)|src[19]>>19) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[19]<<8&0x7ffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0x7ffff00)|src[20]>>24) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[20]<<3&0x7fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x7fffff8)|src[21]>>29) & 1))
+	dst[24] = offset
+	offset -= T(int32(src[21]>>2&0x7ffffff)>>1 ^ -(int32(src[21]>>2&0x7ffffff) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[21]<<25&0x6000000)|src[22]>>7)>>1 ^ -(int32((src[21]<<25&0x6000000)|src[22]>>7) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[22]<<20&0x7f00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0x7f00000)|src[23]>>12) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[23]<<15&0x7ff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x7ff8000)|src[24]>>17) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[24]<<10&0x7fffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x7fffc00)|src[25]>>22) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[25]<<5&0x7ffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7ffffe0)|src[26]>>27) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[26]>>0&0x7ffffff)>>1 ^ -(int32(src[26]>>0&0x7ffffff) & 1))
+	dst[31] = offset
+}
+
+func decode28BitDeltaInto[T Integer](dst *[32]T, src *[28]Word, offset T) {
+	offset -= T(int32(src[0]>>4&0xfffffff)>>1 ^ -(int32(src[0]>>4&0xfffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<24&0xf000000)|src[1]>>8)>>1 ^ -(int32((src[0]<<24&0xf000000)|src[1]>>8) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<20&0xff00000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0xff00000)|src[2]>>12) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[2]<<16&0xfff0000)|src[3]>>16)>>1 ^ -(int32((src[2]<<16&0xfff0000)|src[3]>>16) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[3]<<12&0xffff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xffff000)|src[4]>>20) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[4]<<8&0xfffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xfffff00)|src[5]>>24) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[5]<<4&0xffffff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0xffffff0)|src[6]>>28) & 1))
+	dst[6] = offset
+	offset -= T(int32(src[6]>>0&0xfffffff)>>1 ^ -(int32(src[6]>>0&0xfffffff) & 1))
+	dst[7] = offset
+	offset -= T(int32(src[7]>>4&0xfffffff)>>1 ^ -(int32(src[7]>>4&0xfffffff) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[7]<<24&0xf000000)|src[8]>>8)>>1 ^ -(int32((src[7]<<24&0xf000000)|src[8]>>8) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[8]<<20&0xff00000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0xff00000)|src[9]>>12) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[9]<<16&0xfff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xfff0000)|src[10]>>16) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[10]<<12&0xffff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0xffff000)|src[11]>>20) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[11]<<8&0xfffff00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0xfffff00)|src[12]>>24) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[12]<<4&0xffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffffff0)|src[13]>>28) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[13]>>0&0xfffffff)>>1 ^ -(int32(src[13]>>0&0xfffffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[14]>>4&0xfffffff)>>1 ^ -(int32(src[14]>>4&0xfffffff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[14]<<24&0xf000000)|src[15]>>8)>>1 ^ -(int32((src[14]<<24&0xf000000)|src[15]>>8) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[15]<<20&0xff00000)|src[16]>>12)>>1 ^ -(int32((src[15]<<20&0xff00000)|src[16]>>12) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[16]<<16&0xfff0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xfff0000)|src[17]>>16) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[17]<<12&0xffff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0xffff000)|src[18]>>20) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[18]<<8&0xfffff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0xfffff00)|src[19]>>24) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[19]<<4&0xffffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0xffffff0)|src[20]>>28) & 1))
+	dst[22] = offset
+	offset -= T(int32(src[20]>>0&0xfffffff)>>1 ^ -(int32(src[20]>>0&0xfffffff) & 1))
+	dst[23] = offset
+	offset -= T(int32(src[21]>>4&0xfffffff)>>1 ^ -(int32(src[21]>>4&0xfffffff) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[21]<<24&0xf000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0xf000000)|src[22]>>8) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[22]<<20&0xff00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0xff00000)|src[23]>>12) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[23]<<16&0xfff0000)|src[24]>>16)>>1 ^ -(int32((src[23]<<16&0xfff0000)|src[24]>>16) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[24]<<12&0xffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0xffff000)|src[25]>>20) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[25]<<8&0xfffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0xfffff00)|src[26]>>24) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[26]<<4&0xffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0xffffff0)|src[27]>>28) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[27]>>0&0xfffffff)>>1 ^ -(int32(src[27]>>0&0xfffffff) & 1))
+	dst[31] = offset
+}
+
+func decode29BitDeltaInto[T Integer](dst *[32]T, src *[29]Word, offset T) {
+	offset -= T(int32(src[0]>>3&0x1fffffff)>>1 ^ -(int32(src[0]>>3&0x1fffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<26&0x1c000000)|src[1]>>6)>>1 ^ -(int32((src[0]<<26&0x1c000000)|src[1]>>6) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<23&0x1f800000)|src[2]>>9)>>1 ^ -(int32((src[1]<<23&0x1f800000)|src[2]>>9) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[2]<<20&0x1ff00000)|src[3]>>12)>>1 ^ -(int32((src[2]<<20&0x1ff00000)|src[3]>>12) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[3]<<17&0x1ffe0000)|src[4]>>15)>>1 ^ -(int32((src[3]<<17&0x1ffe0000)|src[4]>>15) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[4]<<14&0x1fffc000)|src[5]>>18)>>1 ^ -(int32((src[4]<<14&0x1fffc000)|src[5]>>18) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[5]<<11&0x1ffff800)|src[6]>>21)>>1 ^ -(int32((src[5]<<11&0x1ffff800)|src[6]>>21) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[6]<<8&0x1fffff00)|src[7]>>24)>>1 ^ -(int32((src[6]<<8&0x1fffff00)|src[7]>>24) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[7]<<5&0x1fffffe0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1fffffe0)|src[8]>>27) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[8]<<2&0x1ffffffc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x1ffffffc)|src[9]>>30) & 1))
+	dst[9] = offset
+	offset -= T(int32(src[9]>>1&0x1fffffff)>>1 ^ -(int32(src[9]>>1&0x1fffffff) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[9]<<28&0x10000000)|src[10]>>4)>>1 ^ -(int32((src[9]<<28&0x10000000)|src[10]>>4) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[10]<<25&0x1e000000)|src[11]>>7)>>1 ^ -(int32((src[10]<<25&0x1e000000)|src[11]>>7) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[11]<<22&0x1fc00000)|src[12]>>10)>>1 ^ -(int32((src[11]<<22&0x1fc00000)|src[12]>>10) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[12]<<19&0x1ff80000)|src[13]>>13)>>1 ^ -(int32((src[12]<<19&0x1ff80000)|src[13]>>13) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[13]<<16&0x1fff0000)|src[14]>>16)>>1 ^ -(int32((src[13]<<16&0x1fff0000)|src[14]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[14]<<13&0x1fffe000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1fffe000)|src[15]>>19) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[15]<<10&0x1ffffc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x1ffffc00)|src[16]>>22) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[16]<<7&0x1fffff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x1fffff80)|src[17]>>25) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[17]<<4&0x1ffffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0x1ffffff0)|src[18]>>28) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[18]<<1&0x1ffffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffffe)|src[19]>>31) & 1))
+	dst[20] = offset
+	offset -= T(int32(src[19]>>2&0x1fffffff)>>1 ^ -(int32(src[19]>>2&0x1fffffff) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[19]<<27&0x18000000)|src[20]>>5)>>1 ^ -(int32((src[19]<<27&0x18000000)|src[20]>>5) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[20]<<24&0x1f000000)|src[21]>>8)>>1 ^ -(int32((src[20]<<24&0x1f000000)|src[21]>>8) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[21]<<21&0x1fe00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1fe00000)|src[22]>>11) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[22]<<18&0x1ffc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x1ffc0000)|src[23]>>14) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[23]<<15&0x1fff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x1fff8000)|src[24]>>17) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[24]<<12&0x1ffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0x1ffff000)|src[25]>>20) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[25]<<9&0x1ffffe00)|src[26]>>23)>>1 ^ -(int32((src[25]<<9&0x1ffffe00)|src[26]>>23) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[26]<<6&0x1fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x1fffffc0)|src[27]>>26) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[27]<<3&0x1ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x1ffffff8)|src[28]>>29) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[28]>>0&0x1fffffff)>>1 ^ -(int32(src[28]>>0&0x1fffffff) & 1))
+	dst[31] = offset
+}
+
+func decode30BitDeltaInto[T Integer](dst *[32]T, src *[30]Word, offset T) {
+	offset -= T(int32(src[0]>>2&0x3fffffff)>>1 ^ -(int32(src[0]>>2&0x3fffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<28&0x30000000)|src[1]>>4)>>1 ^ -(int32((src[0]<<28&0x30000000)|src[1]>>4) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<26&0x3c000000)|src[2]>>6)>>1 ^ -(int32((src[1]<<26&0x3c000000)|src[2]>>6) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[2]<<24&0x3f000000)|src[3]>>8)>>1 ^ -(int32((src[2]<<24&0x3f000000)|src[3]>>8) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[3]<<22&0x3fc00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x3fc00000)|src[4]>>10) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[4]<<20&0x3ff00000)|src[5]>>12)>>1 ^ -(int32((src[4]<<20&0x3ff00000)|src[5]>>12) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[5]<<18&0x3ffc0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x3ffc0000)|src[6]>>14) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[6]<<16&0x3fff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0x3fff0000)|src[7]>>16) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[7]<<14&0x3fffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3fffc000)|src[8]>>18) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[8]<<12&0x3ffff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x3ffff000)|src[9]>>20) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[9]<<10&0x3ffffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffffc00)|src[10]>>22) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[10]<<8&0x3fffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3fffff00)|src[11]>>24) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[11]<<6&0x3fffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3fffffc0)|src[12]>>26) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[12]<<4&0x3ffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ffffff0)|src[13]>>28) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[13]<<2&0x3ffffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3ffffffc)|src[14]>>30) & 1))
+	dst[14] = offset
+	offset -= T(int32(src[14]>>0&0x3fffffff)>>1 ^ -(int32(src[14]>>0&0x3fffffff) & 1))
+	dst[15] = offset
+	offset -= T(int32(src[15]>>2&0x3fffffff)>>1 ^ -(int32(src[15]>>2&0x3fffffff) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[15]<<28&0x30000000)|src[16]>>4)>>1 ^ -(int32((src[15]<<28&0x30000000)|src[16]>>4) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[16]<<26&0x3c000000)|src[17]>>6)>>1 ^ -(int32((src[16]<<26&0x3c000000)|src[17]>>6) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[17]<<24&0x3f000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x3f000000)|src[18]>>8) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[18]<<22&0x3fc00000)|src[19]>>10)>>1 ^ -(int32((src[18]<<22&0x3fc00000)|src[19]>>10) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[19]<<20&0x3ff00000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x3ff00000)|src[20]>>12) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[20]<<18&0x3ffc0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x3ffc0000)|src[21]>>14) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[21]<<16&0x3fff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0x3fff0000)|src[22]>>16) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[22]<<14&0x3fffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x3fffc000)|src[23]>>18) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[23]<<12&0x3ffff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3ffff000)|src[24]>>20) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[24]<<10&0x3ffffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x3ffffc00)|src[25]>>22) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[25]<<8&0x3fffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0x3fffff00)|src[26]>>24) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[26]<<6&0x3fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x3fffffc0)|src[27]>>26) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[27]<<4&0x3ffffff0)|src[28]>>28)>>1 ^ -(int32((src[27]<<4&0x3ffffff0)|src[28]>>28) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[28]<<2&0x3ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x3ffffffc)|src[29]>>30) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[29]>>0&0x3fffffff)>>1 ^ -(int32(src[29]>>0&0x3fffffff) & 1))
+	dst[31] = offset
+}
+
+func decode31BitDeltaInto[T Integer](dst *[32]T, src *[31]Word, offset T) {
+	offset -= T(int32(src[0]>>1&0x7fffffff)>>1 ^ -(int32(src[0]>>1&0x7fffffff) & 1))
+	dst[0] = offset
+	offset -= T(int32((src[0]<<30&0x40000000)|src[1]>>2)>>1 ^ -(int32((src[0]<<30&0x40000000)|src[1]>>2) & 1))
+	dst[1] = offset
+	offset -= T(int32((src[1]<<29&0x60000000)|src[2]>>3)>>1 ^ -(int32((src[1]<<29&0x60000000)|src[2]>>3) & 1))
+	dst[2] = offset
+	offset -= T(int32((src[2]<<28&0x70000000)|src[3]>>4)>>1 ^ -(int32((src[2]<<28&0x70000000)|src[3]>>4) & 1))
+	dst[3] = offset
+	offset -= T(int32((src[3]<<27&0x78000000)|src[4]>>5)>>1 ^ -(int32((src[3]<<27&0x78000000)|src[4]>>5) & 1))
+	dst[4] = offset
+	offset -= T(int32((src[4]<<26&0x7c000000)|src[5]>>6)>>1 ^ -(int32((src[4]<<26&0x7c000000)|src[5]>>6) & 1))
+	dst[5] = offset
+	offset -= T(int32((src[5]<<25&0x7e000000)|src[6]>>7)>>1 ^ -(int32((src[5]<<25&0x7e000000)|src[6]>>7) & 1))
+	dst[6] = offset
+	offset -= T(int32((src[6]<<24&0x7f000000)|src[7]>>8)>>1 ^ -(int32((src[6]<<24&0x7f000000)|src[7]>>8) & 1))
+	dst[7] = offset
+	offset -= T(int32((src[7]<<23&0x7f800000)|src[8]>>9)>>1 ^ -(int32((src[7]<<23&0x7f800000)|src[8]>>9) & 1))
+	dst[8] = offset
+	offset -= T(int32((src[8]<<22&0x7fc00000)|src[9]>>10)>>1 ^ -(int32((src[8]<<22&0x7fc00000)|src[9]>>10) & 1))
+	dst[9] = offset
+	offset -= T(int32((src[9]<<21&0x7fe00000)|src[10]>>11)>>1 ^ -(int32((src[9]<<21&0x7fe00000)|src[10]>>11) & 1))
+	dst[10] = offset
+	offset -= T(int32((src[10]<<20&0x7ff00000)|src[11]>>12)>>1 ^ -(int32((src[10]<<20&0x7ff00000)|src[11]>>12) & 1))
+	dst[11] = offset
+	offset -= T(int32((src[11]<<19&0x7ff80000)|src[12]>>13)>>1 ^ -(int32((src[11]<<19&0x7ff80000)|src[12]>>13) & 1))
+	dst[12] = offset
+	offset -= T(int32((src[12]<<18&0x7ffc0000)|src[13]>>14)>>1 ^ -(int32((src[12]<<18&0x7ffc0000)|src[13]>>14) & 1))
+	dst[13] = offset
+	offset -= T(int32((src[13]<<17&0x7ffe0000)|src[14]>>15)>>1 ^ -(int32((src[13]<<17&0x7ffe0000)|src[14]>>15) & 1))
+	dst[14] = offset
+	offset -= T(int32((src[14]<<16&0x7fff0000)|src[15]>>16)>>1 ^ -(int32((src[14]<<16&0x7fff0000)|src[15]>>16) & 1))
+	dst[15] = offset
+	offset -= T(int32((src[15]<<15&0x7fff8000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x7fff8000)|src[16]>>17) & 1))
+	dst[16] = offset
+	offset -= T(int32((src[16]<<14&0x7fffc000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x7fffc000)|src[17]>>18) & 1))
+	dst[17] = offset
+	offset -= T(int32((src[17]<<13&0x7fffe000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7fffe000)|src[18]>>19) & 1))
+	dst[18] = offset
+	offset -= T(int32((src[18]<<12&0x7ffff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0x7ffff000)|src[19]>>20) & 1))
+	dst[19] = offset
+	offset -= T(int32((src[19]<<11&0x7ffff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x7ffff800)|src[20]>>21) & 1))
+	dst[20] = offset
+	offset -= T(int32((src[20]<<10&0x7ffffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x7ffffc00)|src[21]>>22) & 1))
+	dst[21] = offset
+	offset -= T(int32((src[21]<<9&0x7ffffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffffe00)|src[22]>>23) & 1))
+	dst[22] = offset
+	offset -= T(int32((src[22]<<8&0x7fffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0x7fffff00)|src[23]>>24) & 1))
+	dst[23] = offset
+	offset -= T(int32((src[23]<<7&0x7fffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x7fffff80)|src[24]>>25) & 1))
+	dst[24] = offset
+	offset -= T(int32((src[24]<<6&0x7fffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x7fffffc0)|src[25]>>26) & 1))
+	dst[25] = offset
+	offset -= T(int32((src[25]<<5&0x7fffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7fffffe0)|src[26]>>27) & 1))
+	dst[26] = offset
+	offset -= T(int32((src[26]<<4&0x7ffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0x7ffffff0)|src[27]>>28) & 1))
+	dst[27] = offset
+	offset -= T(int32((src[27]<<3&0x7ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x7ffffff8)|src[28]>>29) & 1))
+	dst[28] = offset
+	offset -= T(int32((src[28]<<2&0x7ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x7ffffffc)|src[29]>>30) & 1))
+	dst[29] = offset
+	offset -= T(int32((src[29]<<1&0x7ffffffe)|src[30]>>31)>>1 ^ -(int32((src[29]<<1&0x7ffffffe)|src[30]>>31) & 1))
+	dst[30] = offset
+	offset -= T(int32(src[30]>>0&0x7fffffff)>>1 ^ -(int32(src[30]>>0&0x7fffffff) & 1))
+	dst[31] = offset
+}
+
+func decode1BitDeltaLast[T Integer](src *[1]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>31&0x1)>>1 ^ -(int32(src[0]>>31&0x1) & 1)
+	sum += int32(src[0]>>30&0x1)>>1 ^ -(int32(src[0]>>30&0x1) & 1)
+	sum += int32(src[0]>>29&0x1)>>1 ^ -(int32(src[0]>>29&0x1) & 1)
+	sum += int32(src[0]>>28&0x1)>>1 ^ -(int32(src[0]>>28&0x1) & 1)
+	sum += int32(src[0]>>27&0x1)>>1 ^ -(int32(src[0]>>27&0x1) & 1)
+	sum += int32(src[0]>>26&0x1)>>1 ^ -(int32(src[0]>>26&0x1) & 1)
+	sum += int32(src[0]>>25&0x1)>>1 ^ -(int32(src[0]>>25&0x1) & 1)
+	sum += int32(src[0]>>24&0x1)>>1 ^ -(int32(src[0]>>24&0x1) & 1)
+	sum += int32(src[0]>>23&0x1)>>1 ^ -(int32(src[0]>>23&0x1) & 1)
+	sum += int32(src[0]>>22&0x1)>>1 ^ -(int32(src[0]>>22&0x1) & 1)
+	sum += int32(src[0]>>21&0x1)>>1 ^ -(int32(src[0]>>21&0x1) & 1)
+	sum += int32(src[0]>>20&0x1)>>1 ^ -(int32(src[0]>>20&0x1) & 1)
+	sum += int32(src[0]>>19&0x1)>>1 ^ -(int32(src[0]>>19&0x1) & 1)
+	sum += int32(src[0]>>18&0x1)>>1 ^ -(int32(src[0]>>18&0x1) & 1)
+	sum += int32(src[0]>>17&0x1)>>1 ^ -(int32(src[0]>>17&0x1) & 1)
+	sum += int32(src[0]>>16&0x1)>>1 ^ -(int32(src[0]>>16&0x1) & 1)
+	sum += int32(src[0]>>15&0x1)>>1 ^ -(int32(src[0]>>15&0x1) & 1)
+	sum += int32(src[0]>>14&0x1)>>1 ^ -(int32(src[0]>>14&0x1) & 1)
+	sum += int32(src[0]>>13&0x1)>>1 ^ -(int32(src[0]>>13&0x1) & 1)
+	sum += int32(src[0]>>12&0x1)>>1 ^ -(int32(src[0]>>12&0x1) & 1)
+	sum += int32(src[0]>>11&0x1)>>1 ^ -(int32(src[0]>>11&0x1) & 1)
+	sum += int32(src[0]>>10&0x1)>>1 ^ -(int32(src[0]>>10&0x1) & 1)
+	sum += int32(src[0]>>9&0x1)>>1 ^ -(int32(src[0]>>9&0x1) & 1)
+	sum += int32(src[0]>>8&0x1)>>1 ^ -(int32(src[0]>>8&0x1) & 1)
+	sum += int32(src[0]>>7&0x1)>>1 ^ -(int32(src[0]>>7&0x1) & 1)
+	sum += int32(src[0]>>6&0x1)>>1 ^ -(int32(src[0]>>6&0x1) & 1)
+	sum += int32(src[0]>>5&0x1)>>1 ^ -(int32(src[0]>>5&0x1) & 1)
+	sum += int32(src[0]>>4&0x1)>>1 ^ -(int32(src[0]>>4&0x1) & 1)
+	sum += int32(src[0]>>3&0x1)>>1 ^ -(int32(src[0]>>3&0x1) & 1)
+	sum += int32(src[0]>>2&0x1)>>1 ^ -(int32(src[0]>>2&0x1) & 1)
+	sum += int32(src[0]>>1&0x1)>>1 ^ -(int32(src[0]>>1&0x1) & 1)
+	sum += int32(src[0]>>0&0x1)>>1 ^ -(int32(src[0]>>0&0x1) & 1)
+	return offset - T(sum)
+}
+
+func decode2BitDeltaLast[T Integer](src *[2]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>30&0x3)>>1 ^ -(int32(src[0]>>30&0x3) & 1)
+	sum += int32(src[0]>>28&0x3)>>1 ^ -(int32(src[0]>>28&0x3) & 1)
+	sum += int32(src[0]>>26&0x3)>>1 ^ -(int32(src[0]>>26&0x3) & 1)
+	sum += int32(src[0]>>24&0x3)>>1 ^ -(int32(src[0]>>24&0x3) & 1)
+	sum += int32(src[0]>>22&0x3)>>1 ^ -(int32(src[0]>>22&0x3) & 1)
+	sum += int32(src[0]>>20&0x3)>>1 ^ -(int32(src[0]>>20&0x3) & 1)
+	sum += int32(src[0]>>18&0x3)>>1 ^ -(int32(src[0]>>18&0x3) & 1)
+	sum += int32(src[0]>>16&0x3)>>1 ^ -(int32(src[0]>>16&0x3) & 1)
+	sum += int32(src[0]>>14&0x3)>>1 ^ -(int32(src[0]>>14&0x3) & 1)
+	sum += int32(src[0]>>12&0x3)>>1 ^ -(int32(src[0]>>12&0x3) & 1)
+	sum += int32(src[0]>>10&0x3)>>1 ^ -(int32(src[0]>>10&0x3) & 1)
+	sum += int32(src[0]>>8&0x3)>>1 ^ -(int32(src[0]>>8&0x3) & 1)
+	sum += int32(src[0]>>6&0x3)>>1 ^ -(int32(src[0]>>6&0x3) & 1)
+	sum += int32(src[0]>>4&0x3)>>1 ^ -(int32(src[0]>>4&0x3) & 1)
+	sum += int32(src[0]>>2&0x3)>>1 ^ -(int32(src[0]>>2&0x3) & 1)
+	sum += int32(src[0]>>0&0x3)>>1 ^ -(int32(src[0]>>0&0x3) & 1)
+	sum += int32(src[1]>>30&0x3)>>1 ^ -(int32(src[1]>>30&0x3) & 1)
+	sum += int32(src[1]>>28&0x3)>>1 ^ -(int32(src[1]>>28&0x3) & 1)
+	sum += int32(src[1]>>26&0x3)>>1 ^ -(int32(src[1]>>26&0x3) & 1)
+	sum += int32(src[1]>>24&0x3)>>1 ^ -(int32(src[1]>>24&0x3) & 1)
+	sum += int32(src[1]>>22&0x3)>>1 ^ -(int32(src[1]>>22&0x3) & 1)
+	sum += int32(src[1]>>20&0x3)>>1 ^ -(int32(src[1]>>20&0x3) & 1)
+	sum += int32(src[1]>>18&0x3)>>1 ^ -(int32(src[1]>>18&0x3) & 1)
+	sum += int32(src[1]>>16&0x3)>>1 ^ -(int32(src[1]>>16&0x3) & 1)
+	sum += int32(src[1]>>14&0x3)>>1 ^ -(int32(src[1]>>14&0x3) & 1)
+	sum += int32(src[1]>>12&0x3)>>1 ^ -(int32(src[1]>>12&0x3) & 1)
+	sum += int32(src[1]>>10&0x3)>>1 ^ -(int32(src[1]>>10&0x3) & 1)
+	sum += int32(src[1]>>8&0x3)>>1 ^ -(int32(src[1]>>8&0x3) & 1)
+	sum += int32(src[1]>>6&0x3)>>1 ^ -(int32(src[1]>>6&0x3) & 1)
+	sum += int32(src[1]>>4&0x3)>>1 ^ -(int32(src[1]>>4&0x3) & 1)
+	sum += int32(src[1]>>2&0x3)>>1 ^ -(int32(src[1]>>2&0x3) & 1)
+	sum += int32(src[1]>>0&0x3)>>1 ^ -(int32(src[1]>>0&0x3) & 1)
+	return offset - T(sum)
+}
+
+func decode3BitDeltaLast[T Integer](src *[3]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>29&0x7)>>1 ^ -(int32(src[0]>>29&0x7) & 1)
+	sum += int32(src[0]>>26&0x7)>>1 ^ -(int32(src[0]>>26&0x7) & 1)
+	sum += int32(src[0]>>23&0x7)>>1 ^ -(int32(src[0]>>23&0x7) & 1)
+	sum += int32(src[0]>>20&0x7)>>1 ^ -(int32(src[0]>>20&0x7) & 1)
+	sum += int32(src[0]>>17&0x7)>>1 ^ -(int32(src[0]>>17&0x7) & 1)
+	sum += int32(src[0]>>14&0x7)>>1 ^ -(int32(src[0]>>14&0x7) & 1)
+	sum += int32(src[0]>>11&0x7)>>1 ^ -(int32(src[0]>>11&0x7) & 1)
+	sum += int32(src[0]>>8&0x7)>>1 ^ -(int32(src[0]>>8&0x7) & 1)
+	sum += int32(src[0]>>5&0x7)>>1 ^ -(int32(src[0]>>5&0x7) & 1)
+	sum += int32(src[0]>>2&0x7)>>1 ^ -(int32(src[0]>>2&0x7) & 1)
+	sum += int32((src[0]<<1&0x6)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x6)|src[1]>>31) & 1)
+	sum += int32(src[1]>>28&0x7)>>1 ^ -(int32(src[1]>>28&0x7) & 1)
+	sum += int32(src[1]>>25&0x7)>>1 ^ -(int32(src[1]>>25&0x7) & 1)
+	sum += int32(src[1]>>22&0x7)>>1 ^ -(int32(src[1]>>22&0x7) & 1)
+	sum += int32(src[1]>>19&0x7)>>1 ^ -(int32(src[1]>>19&0x7) & 1)
+	sum += int32(src[1]>>16&0x7)>>1 ^ -(int32(src[1]>>16&0x7) & 1)
+	sum += int32(src[1]>>13&0x7)>>1 ^ -(int32(src[1]>>13&0x7) & 1)
+	sum += int32(src[1]>>10&0x7)>>1 ^ -(int32(src[1]>>10&0x7) & 1)
+	sum += int32(src[1]>>7&0x7)>>1 ^ -(int32(src[1]>>7&0x7) & 1)
+	sum += int32(src[1]>>4&0x7)>>1 ^ -(int32(src[1]>>4&0x7) & 1)
+	sum += int32(src[1]>>1&0x7)>>1 ^ -(int32(src[1]>>1&0x7) & 1)
+	sum += int32((src[1]<<2&0x4)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x4)|src[2]>>30) & 1)
+	sum += int32(src[2]>>27&0x7)>>1 ^ -(int32(src[2]>>27&0x7) & 1)
+	sum += int32(src[2]>>24&0x7)>>1 ^ -(int32(src[2]>>24&0x7) & 1)
+	sum += int32(src[2]>>21&0x7)>>1 ^ -(int32(src[2]>>21&0x7) & 1)
+	sum += int32(src[2]>>18&0x7)>>1 ^ -(int32(src[2]>>18&0x7) & 1)
+	sum += int32(src[2]>>15&0x7)>>1 ^ -(int32(src[2]>>15&0x7) & 1)
+	sum += int32(src[2]>>12&0x7)>>1 ^ -(int32(src[2]>>12&0x7) & 1)
+	sum += int32(src[2]>>9&0x7)>>1 ^ -(int32(src[2]>>9&0x7) & 1)
+	sum += int32(src[2]>>6&0x7)>>1 ^ -(int32(src[2]>>6&0x7) & 1)
+	sum += int32(src[2]>>3&0x7)>>1 ^ -(int32(src[2]>>3&0x7) & 1)
+	sum += int32(src[2]>>0&0x7)>>1 ^ -(int32(src[2]>>0&0x7) & 1)
+	return offset - T(sum)
+}
+
+func decode4BitDeltaLast[T Integer](src *[4]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>28&0xf)>>1 ^ -(int32(src[0]>>28&0xf) & 1)
+	sum += int32(src[0]>>24&0xf)>>1 ^ -(int32(src[0]>>24&0xf) & 1)
+	sum += int32(src[0]>>20&0xf)>>1 ^ -(int32(src[0]>>20&0xf) & 1)
+	sum += int32(src[0]>>16&0xf)>>1 ^ -(int32(src[0]>>16&0xf) & 1)
+	sum += int32(src[0]>>12&0xf)>>1 ^ -(int32(src[0]>>12&0xf) & 1)
+	sum += int32(src[0]>>8&0xf)>>1 ^ -(int32(src[0]>>8&0xf) & 1)
+	sum += int32(src[0]>>4&0xf)>>1 ^ -(int32(src[0]>>4&0xf) & 1)
+	sum += int32(src[0]>>0&0xf)>>1 ^ -(int32(src[0]>>0&0xf) & 1)
+	sum += int32(src[1]>>28&0xf)>>1 ^ -(int32(src[1]>>28&0xf) & 1)
+	sum += int32(src[1]>>24&0xf)>>1 ^ -(int32(src[1]>>24&0xf) & 1)
+	sum += int32(src[1]>>20&0xf)>>1 ^ -(int32(src[1]>>20&0xf) & 1)
+	sum += int32(src[1]>>16&0xf)>>1 ^ -(int32(src[1]>>16&0xf) & 1)
+	sum += int32(src[1]>>12&0xf)>>1 ^ -(int32(src[1]>>12&0xf) & 1)
+	sum += int32(src[1]>>8&0xf)>>1 ^ -(int32(src[1]>>8&0xf) & 1)
+	sum += int32(src[1]>>4&0xf)>>1 ^ -(int32(src[1]>>4&0xf) & 1)
+	sum += int32(src[1]>>0&0xf)>>1 ^ -(int32(src[1]>>0&0xf) & 1)
+	sum += int32(src[2]>>28&0xf)>>1 ^ -(int32(src[2]>>28&0xf) & 1)
+	sum += int32(src[2]>>24&0xf)>>1 ^ -(int32(src[2]>>24&0xf) & 1)
+	sum += int32(src[2]>>20&0xf)>>1 ^ -(int32(src[2]>>20&0xf) & 1)
+	sum += int32(src[2]>>16&0xf)>>1 ^ -(int32(src[2]>>16&0xf) & 1)
+	sum += int32(src[2]>>12&0xf)>>1 ^ -(int32(src[2]>>12&0xf) & 1)
+	sum += int32(src[2]>>8&0xf)>>1 ^ -(int32(src[2]>>8&0xf) & 1)
+	sum += int32(src[2]>>4&0xf)>>1 ^ -(int32(src[2]>>4&0xf) & 1)
+	sum += int32(src[2]>>0&0xf)>>1 ^ -(int32(src[2]>>0&0xf) & 1)
+	sum += int32(src[3]>>28&0xf)>>1 ^ -(int32(src[3]>>28&0xf) & 1)
+	sum += int32(src[3]>>24&0xf)>>1 ^ -(int32(src[3]>>24&0xf) & 1)
+	sum += int32(src[3]>>20&0xf)>>1 ^ -(int32(src[3]>>20&0xf) & 1)
+	sum += int32(src[3]>>16&0xf)>>1 ^ -(int32(src[3]>>16&0xf) & 1)
+	sum += int32(src[3]>>12&0xf)>>1 ^ -(int32(src[3]>>12&0xf) & 1)
+	sum += int32(src[3]>>8&0xf)>>1 ^ -(int32(src[3]>>8&0xf) & 1)
+	sum += int32(src[3]>>4&0xf)>>1 ^ -(int32(src[3]>>4&0xf) & 1)
+	sum += int32(src[3]>>0&0xf)>>1 ^ -(int32(src[3]>>0&0xf) & 1)
+	return offset - T(sum)
+}
+
+func decode5BitDeltaLast[T Integer](src *[5]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>27&0x1f)>>1 ^ -(int32(src[0]>>27&0x1f) & 1)
+	sum += int32(src[0]>>22&0x1f)>>1 ^ -(int32(src[0]>>22&0x1f) & 1)
+	sum += int32(src[0]>>17&0x1f)>>1 ^ -(int32(src[0]>>17&0x1f) & 1)
+	sum += int32(src[0]>>12&0x1f)>>1 ^ -(int32(src[0]>>12&0x1f) & 1)
+	sum += int32(src[0]>>7&0x1f)>>1 ^ -(int32(src[0]>>7&0x1f) & 1)
+	sum += int32(src[0]>>2&0x1f)>>1 ^ -(int32(src[0]>>2&0x1f) & 1)
+	sum += int32((src[0]<<3&0x18)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x18)|src[1]>>29) & 1)
+	sum += int32(src[1]>>24&0x1f)>>1 ^ -(int32(src[1]>>24&0x1f) & 1)
+	sum += int32(src[1]>>19&0x1f)>>1 ^ -(int32(src[1]>>19&0x1f) & 1)
+	sum += int32(src[1]>>14&0x1f)>>1 ^ -(int32(src[1]>>14&0x1f) & 1)
+	sum += int32(src[1]>>9&0x1f)>>1 ^ -(int32(src[1]>>9&0x1f) & 1)
+	sum += int32(src[1]>>4&0x1f)>>1 ^ -(int32(src[1]>>4&0x1f) & 1)
+	sum += int32((src[1]<<1&0x1e)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1e)|src[2]>>31) & 1)
+	sum += int32(src[2]>>26&0x1f)>>1 ^ -(int32(src[2]>>26&0x1f) & 1)
+	sum += int32(src[2]>>21&0x1f)>>1 ^ -(int32(src[2]>>21&0x1f) & 1)
+	sum += int32(src[2]>>16&0x1f)>>1 ^ -(int32(src[2]>>16&0x1f) & 1)
+	sum += int32(src[2]>>11&0x1f)>>1 ^ -(int32(src[2]>>11&0x1f) & 1)
+	sum += int32(src[2]>>6&0x1f)>>1 ^ -(int32(src[2]>>6&0x1f) & 1)
+	sum += int32(src[2]>>1&0x1f)>>1 ^ -(int32(src[2]>>1&0x1f) & 1)
+	sum += int32((src[2]<<4&0x10)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x10)|src[3]>>28) & 1)
+	sum += int32(src[3]>>23&0x1f)>>1 ^ -(int32(src[3]>>23&0x1f) & 1)
+	sum += int32(src[3]>>18&0x1f)>>1 ^ -(int32(src[3]>>18&0x1f) & 1)
+	sum += int32(src[3]>>13&0x1f)>>1 ^ -(int32(src[3]>>13&0x1f) & 1)
+	sum += int32(src[3]>>8&0x1f)>>1 ^ -(int32(src[3]>>8&0x1f) & 1)
+	sum += int32(src[3]>>3&0x1f)>>1 ^ -(int32(src[3]>>3&0x1f) & 1)
+	sum += int32((src[3]<<2&0x1c)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1c)|src[4]>>30) & 1)
+	sum += int32(src[4]>>25&0x1f)>>1 ^ -(int32(src[4]>>25&0x1f) & 1)
+	sum += int32(src[4]>>20&0x1f)>>1 ^ -(int32(src[4]>>20&0x1f) & 1)
+	sum += int32(src[4]>>15&0x1f)>>1 ^ -(int32(src[4]>>15&0x1f) & 1)
+	sum += int32(src[4]>>10&0x1f)>>1 ^ -(int32(src[4]>>10&0x1f) & 1)
+	sum += int32(src[4]>>5&0x1f)>>1 ^ -(int32(src[4]>>5&0x1f) & 1)
+	sum += int32(src[4]>>0&0x1f)>>1 ^ -(int32(src[4]>>0&0x1f) & 1)
+	return offset - T(sum)
+}
+
+func decode6BitDeltaLast[T Integer](src *[6]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>26&0x3f)>>1 ^ -(int32(src[0]>>26&0x3f) & 1)
+	sum += int32(src[0]>>20&0x3f)>>1 ^ -(int32(src[0]>>20&0x3f) & 1)
+	sum += int32(src[0]>>14&0x3f)>>1 ^ -(int32(src[0]>>14&0x3f) & 1)
+	sum += int32(src[0]>>8&0x3f)>>1 ^ -(int32(src[0]>>8&0x3f) & 1)
+	sum += int32(src[0]>>2&0x3f)>>1 ^ -(int32(src[0]>>2&0x3f) & 1)
+	sum += int32((src[0]<<4&0x30)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x30)|src[1]>>28) & 1)
+	sum += int32(src[1]>>22&0x3f)>>1 ^ -(int32(src[1]>>22&0x3f) & 1)
+	sum += int32(src[1]>>16&0x3f)>>1 ^ -(int32(src[1]>>16&0x3f) & 1)
+	sum += int32(src[1]>>10&0x3f)>>1 ^ -(int32(src[1]>>10&0x3f) & 1)
+	sum += int32(src[1]>>4&0x3f)>>1 ^ -(int32(src[1]>>4&0x3f) & 1)
+	sum += int32((src[1]<<2&0x3c)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3c)|src[2]>>30) & 1)
+	sum += int32(src[2]>>24&0x3f)>>1 ^ -(int32(src[2]>>24&0x3f) & 1)
+	sum += int32(src[2]>>18&0x3f)>>1 ^ -(int32(src[2]>>18&0x3f) & 1)
+	sum += int32(src[2]>>12&0x3f)>>1 ^ -(int32(src[2]>>12&0x3f) & 1)
+	sum += int32(src[2]>>6&0x3f)>>1 ^ -(int32(src[2]>>6&0x3f) & 1)
+	sum += int32(src[2]>>0&0x3f)>>1 ^ -(int32(src[2]>>0&0x3f) & 1)
+	sum += int32(src[3]>>26&0x3f)>>1 ^ -(int32(src[3]>>26&0x3f) & 1)
+	sum += int32(src[3]>>20&0x3f)>>1 ^ -(int32(src[3]>>20&0x3f) & 1)
+	sum += int32(src[3]>>14&0x3f)>>1 ^ -(int32(src[3]>>14&0x3f) & 1)
+	sum += int32(src[3]>>8&0x3f)>>1 ^ -(int32(src[3]>>8&0x3f) & 1)
+	sum += int32(src[3]>>2&0x3f)>>1 ^ -(int32(src[3]>>2&0x3f) & 1)
+	sum += int32((src[3]<<4&0x30)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x30)|src[4]>>28) & 1)
+	sum += int32(src[4]>>22&0x3f)>>1 ^ -(int32(src[4]>>22&0x3f) & 1)
+	sum += int32(src[4]>>16&0x3f)>>1 ^ -(int32(src[4]>>16&0x3f) & 1)
+	sum += int32(src[4]>>10&0x3f)>>1 ^ -(int32(src[4]>>10&0x3f) & 1)
+	sum += int32(src[4]>>4&0x3f)>>1 ^ -(int32(src[4]>>4&0x3f) & 1)
+	sum += int32((src[4]<<2&0x3c)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3c)|src[5]>>30) & 1)
+	sum += int32(src[5]>>24&0x3f)>>1 ^ -(int32(src[5]>>24&0x3f) & 1)
+	sum += int32(src[5]>>18&0x3f)>>1 ^ -(int32(src[5]>>18&0x3f) & 1)
+	sum += int32(src[5]>>12&0x3f)>>1 ^ -(int32(src[5]>>12&0x3f) & 1)
+	sum += int32(src[5]>>6&0x3f)>>1 ^ -(int32(src[5]>>6&0x3f) & 1)
+	sum += int32(src[5]>>0&0x3f)>>1 ^ -(int32(src[5]>>0&0x3f) & 1)
+	return offset - T(sum)
+}
+
+func decode7BitDeltaLast[T Integer](src *[7]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>25&0x7f)>>1 ^ -(int32(src[0]>>25&0x7f) & 1)
+	sum += int32(src[0]>>18&0x7f)>>1 ^ -(int32(src[0]>>18&0x7f) & 1)
+	sum += int32(src[0]>>11&0x7f)>>1 ^ -(int32(src[0]>>11&0x7f) & 1)
+	sum += int32(src[0]>>4&0x7f)>>1 ^ -(int32(src[0]>>4&0x7f) & 1)
+	sum += int32((src[0]<<3&0x78)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x78)|src[1]>>29) & 1)
+	sum += int32(src[1]>>22&0x7f)>>1 ^ -(int32(src[1]>>22&0x7f) & 1)
+	sum += int32(src[1]>>15&0x7f)>>1 ^ -(int32(src[1]>>15&0x7f) & 1)
+	sum += int32(src[1]>>8&0x7f)>>1 ^ -(int32(src[1]>>8&0x7f) & 1)
+	sum += int32(src[1]>>1&0x7f)>>1 ^ -(int32(src[1]>>1&0x7f) & 1)
+	sum += int32((src[1]<<6&0x40)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x40)|src[2]>>26) & 1)
+	sum += int32(src[2]>>19&0x7f)>>1 ^ -(int32(src[2]>>19&0x7f) & 1)
+	sum += int32(src[2]>>12&0x7f)>>1 ^ -(int32(src[2]>>12&0x7f) & 1)
+	sum += int32(src[2]>>5&0x7f)>>1 ^ -(int32(src[2]>>5&0x7f) & 1)
+	sum += int32((src[2]<<2&0x7c)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x7c)|src[3]>>30) & 1)
+	sum += int32(src[3]>>23&0x7f)>>1 ^ -(int32(src[3]>>23&0x7f) & 1)
+	sum += int32(src[3]>>16&0x7f)>>1 ^ -(int32(src[3]>>16&0x7f) & 1)
+	sum += int32(src[3]>>9&0x7f)>>1 ^ -(int32(src[3]>>9&0x7f) & 1)
+	sum += int32(src[3]>>2&0x7f)>>1 ^ -(int32(src[3]>>2&0x7f) & 1)
+	sum += int32((src[3]<<5&0x60)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x60)|src[4]>>27) & 1)
+	sum += int32(src[4]>>20&0x7f)>>1 ^ -(int32(src[4]>>20&0x7f) & 1)
+	sum += int32(src[4]>>13&0x7f)>>1 ^ -(int32(src[4]>>13&0x7f) & 1)
+	sum += int32(src[4]>>6&0x7f)>>1 ^ -(int32(src[4]>>6&0x7f) & 1)
+	sum += int32((src[4]<<1&0x7e)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7e)|src[5]>>31) & 1)
+	sum += int32(src[5]>>24&0x7f)>>1 ^ -(int32(src[5]>>24&0x7f) & 1)
+	sum += int32(src[5]>>17&0x7f)>>1 ^ -(int32(src[5]>>17&0x7f) & 1)
+	sum += int32(src[5]>>10&0x7f)>>1 ^ -(int32(src[5]>>10&0x7f) & 1)
+	sum += int32(src[5]>>3&0x7f)>>1 ^ -(int32(src[5]>>3&0x7f) & 1)
+	sum += int32((src[5]<<4&0x70)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x70)|src[6]>>28) & 1)
+	sum += int32(src[6]>>21&0x7f)>>1 ^ -(int32(src[6]>>21&0x7f) & 1)
+	sum += int32(src[6]>>14&0x7f)>>1 ^ -(int32(src[6]>>14&0x7f) & 1)
+	sum += int32(src[6]>>7&0x7f)>>1 ^ -(int32(src[6]>>7&0x7f) & 1)
+	sum += int32(src[6]>>0&0x7f)>>1 ^ -(int32(src[6]>>0&0x7f) & 1)
+	return offset - T(sum)
+}
+
+func decode8BitDeltaLast[T Integer](src *[8]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>24&0xff)>>1 ^ -(int32(src[0]>>24&0xff) & 1)
+	sum += int32(src[0]>>16&0xff)>>1 ^ -(int32(src[0]>>16&0xff) & 1)
+	sum += int32(src[0]>>8&0xff)>>1 ^ -(int32(src[0]>>8&0xff) & 1)
+	sum += int32(src[0]>>0&0xff)>>1 ^ -(int32(src[0]>>0&0xff) & 1)
+	sum += int32(src[1]>>24&0xff)>>1 ^ -(int32(src[1]>>24&0xff) & 1)
+	sum += int32(src[1]>>16&0xff)>>1 ^ -(int32(src[1]>>16&0xff) & 1)
+	sum += int32(src[1]>>8&0xff)>>1 ^ -(int32(src[1]>>8&0xff) & 1)
+	sum += int32(src[1]>>0&0xff)>>1 ^ -(int32(src[1]>>0&0xff) & 1)
+	sum += int32(src[2]>>24&0xff)>>1 ^ -(int32(src[2]>>24&0xff) & 1)
+	sum += int32(src[2]>>16&0xff)>>1 ^ -(int32(src[2]>>16&0xff) & 1)
+	sum += int32(src[2]>>8&0xff)>>1 ^ -(int32(src[2]>>8&0xff) & 1)
+	sum += int32(src[2]>>0&0xff)>>1 ^ -(int32(src[2]>>0&0xff) & 1)
+	sum += int32(src[3]>>24&0xff)>>1 ^ -(int32(src[3]>>24&0xff) & 1)
+	sum += int32(src[3]>>16&0xff)>>1 ^ -(int32(src[3]>>16&0xff) & 1)
+	sum += int32(src[3]>>8&0xff)>>1 ^ -(int32(src[3]>>8&0xff) & 1)
+	sum += int32(src[3]>>0&0xff)>>1 ^ -(int32(src[3]>>0&0xff) & 1)
+	sum += int32(src[4]>>24&0xff)>>1 ^ -(int32(src[4]>>24&0xff) & 1)
+	sum += int32(src[4]>>16&0xff)>>1 ^ -(int32(src[4]>>16&0xff) & 1)
+	sum += int32(src[4]>>8&0xff)>>1 ^ -(int32(src[4]>>8&0xff) & 1)
+	sum += int32(src[4]>>0&0xff)>>1 ^ -(int32(src[4]>>0&0xff) & 1)
+	sum += int32(src[5]>>24&0xff)>>1 ^ -(int32(src[5]>>24&0xff) & 1)
+	sum += int32(src[5]>>16&0xff)>>1 ^ -(int32(src[5]>>16&0xff) & 1)
+	sum += int32(src[5]>>8&0xff)>>1 ^ -(int32(src[5]>>8&0xff) & 1)
+	sum += int32(src[5]>>0&0xff)>>1 ^ -(int32(src[5]>>0&0xff) & 1)
+	sum += int32(src[6]>>24&0xff)>>1 ^ -(int32(src[6]>>24&0xff) & 1)
+	sum += int32(src[6]>>16&0xff)>>1 ^ -(int32(src[6]>>16&0xff) & 1)
+	sum += int32(src[6]>>8&0xff)>>1 ^ -(int32(src[6]>>8&0xff) & 1)
+	sum += int32(src[6]>>0&0xff)>>1 ^ -(int32(src[6]>>0&0xff) & 1)
+	sum += int32(src[7]>>24&0xff)>>1 ^ -(int32(src[7]>>24&0xff) & 1)
+	sum += int32(src[7]>>16&0xff)>>1 ^ -(int32(src[7]>>16&0xff) & 1)
+	sum += int32(src[7]>>8&0xff)>>1 ^ -(int32(src[7]>>8&0xff) & 1)
+	sum += int32(src[7]>>0&0xff)>>1 ^ -(int32(src[7]>>0&0xff) & 1)
+	return offset - T(sum)
+}
+
+func decode9BitDeltaLast[T Integer](src *[9]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>23&0x1ff)>>1 ^ -(int32(src[0]>>23&0x1ff) & 1)
+	sum += int32(src[0]>>14&0x1ff)>>1 ^ -(int32(src[0]>>14&0x1ff) & 1)
+	sum += int32(src[0]>>5&0x1ff)>>1 ^ -(int32(src[0]>>5&0x1ff) & 1)
+	sum += int32((src[0]<<4&0x1f0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x1f0)|src[1]>>28) & 1)
+	sum += int32(src[1]>>19&0x1ff)>>1 ^ -(int32(src[1]>>19&0x1ff) & 1)
+	sum += int32(src[1]>>10&0x1ff)>>1 ^ -(int32(src[1]>>10&0x1ff) & 1)
+	sum += int32(src[1]>>1&0x1ff)>>1 ^ -(int32(src[1]>>1&0x1ff) & 1)
+	sum += int32((src[1]<<8&0x100)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x100)|src[2]>>24) & 1)
+	sum += int32(src[2]>>15&0x1ff)>>1 ^ -(int32(src[2]>>15&0x1ff) & 1)
+	sum += int32(src[2]>>6&0x1ff)>>1 ^ -(int32(src[2]>>6&0x1ff) & 1)
+	sum += int32((src[2]<<3&0x1f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x1f8)|src[3]>>29) & 1)
+	sum += int32(src[3]>>20&0x1ff)>>1 ^ -(int32(src[3]>>20&0x1ff) & 1)
+	sum += int32(src[3]>>11&0x1ff)>>1 ^ -(int32(src[3]>>11&0x1ff) & 1)
+	sum += int32(src[3]>>2&0x1ff)>>1 ^ -(int32(src[3]>>2&0x1ff) & 1)
+	sum += int32((src[3]<<7&0x180)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x180)|src[4]>>25) & 1)
+	sum += int32(src[4]>>16&0x1ff)>>1 ^ -(int32(src[4]>>16&0x1ff) & 1)
+	sum += int32(src[4]>>7&0x1ff)>>1 ^ -(int32(src[4]>>7&0x1ff) & 1)
+	sum += int32((src[4]<<2&0x1fc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x1fc)|src[5]>>30) & 1)
+	sum += int32(src[5]>>21&0x1ff)>>1 ^ -(int32(src[5]>>21&0x1ff) & 1)
+	sum += int32(src[5]>>12&0x1ff)>>1 ^ -(int32(src[5]>>12&0x1ff) & 1)
+	sum += int32(src[5]>>3&0x1ff)>>1 ^ -(int32(src[5]>>3&0x1ff) & 1)
+	sum += int32((src[5]<<6&0x1c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x1c0)|src[6]>>26) & 1)
+	sum += int32(src[6]>>17&0x1ff)>>1 ^ -(int32(src[6]>>17&0x1ff) & 1)
+	sum += int32(src[6]>>8&0x1ff)>>1 ^ -(int32(src[6]>>8&0x1ff) & 1)
+	sum += int32((src[6]<<1&0x1fe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fe)|src[7]>>31) & 1)
+	sum += int32(src[7]>>22&0x1ff)>>1 ^ -(int32(src[7]>>22&0x1ff) & 1)
+	sum += int32(src[7]>>13&0x1ff)>>1 ^ -(int32(src[7]>>13&0x1ff) & 1)
+	sum += int32(src[7]>>4&0x1ff)>>1 ^ -(int32(src[7]>>4&0x1ff) & 1)
+	sum += int32((src[7]<<5&0x1e0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1e0)|src[8]>>27) & 1)
+	sum += int32(src[8]>>18&0x1ff)>>1 ^ -(int32(src[8]>>18&0x1ff) & 1)
+	sum += int32(src[8]>>9&0x1ff)>>1 ^ -(int32(src[8]>>9&0x1ff) & 1)
+	sum += int32(src[8]>>0&0x1ff)>>1 ^ -(int32(src[8]>>0&0x1ff) & 1)
+	return offset - T(sum)
+}
+
+func decode10BitDeltaLast[T Integer](src *[10]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>22&0x3ff)>>1 ^ -(int32(src[0]>>22&0x3ff) & 1)
+	sum += int32(src[0]>>12&0x3ff)>>1 ^ -(int32(src[0]>>12&0x3ff) & 1)
+	sum += int32(src[0]>>2&0x3ff)>>1 ^ -(int32(src[0]>>2&0x3ff) & 1)
+	sum += int32((src[0]<<8&0x300)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0x300)|src[1]>>24) & 1)
+	sum += int32(src[1]>>14&0x3ff)>>1 ^ -(int32(src[1]>>14&0x3ff) & 1)
+	sum += int32(src[1]>>4&0x3ff)>>1 ^ -(int32(src[1]>>4&0x3ff) & 1)
+	sum += int32((src[1]<<6&0x3c0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3c0)|src[2]>>26) & 1)
+	sum += int32(src[2]>>16&0x3ff)>>1 ^ -(int32(src[2]>>16&0x3ff) & 1)
+	sum += int32(src[2]>>6&0x3ff)>>1 ^ -(int32(src[2]>>6&0x3ff) & 1)
+	sum += int32((src[2]<<4&0x3f0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x3f0)|src[3]>>28) & 1)
+	sum += int32(src[3]>>18&0x3ff)>>1 ^ -(int32(src[3]>>18&0x3ff) & 1)
+	sum += int32(src[3]>>8&0x3ff)>>1 ^ -(int32(src[3]>>8&0x3ff) & 1)
+	sum += int32((src[3]<<2&0x3fc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fc)|src[4]>>30) & 1)
+	sum += int32(src[4]>>20&0x3ff)>>1 ^ -(int32(src[4]>>20&0x3ff) & 1)
+	sum += int32(src[4]>>10&0x3ff)>>1 ^ -(int32(src[4]>>10&0x3ff) & 1)
+	sum += int32(src[4]>>0&0x3ff)>>1 ^ -(int32(src[4]>>0&0x3ff) & 1)
+	sum += int32(src[5]>>22&0x3ff)>>1 ^ -(int32(src[5]>>22&0x3ff) & 1)
+	sum += int32(src[5]>>12&0x3ff)>>1 ^ -(int32(src[5]>>12&0x3ff) & 1)
+	sum += int32(src[5]>>2&0x3ff)>>1 ^ -(int32(src[5]>>2&0x3ff) & 1)
+	sum += int32((src[5]<<8&0x300)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x300)|src[6]>>24) & 1)
+	sum += int32(src[6]>>14&0x3ff)>>1 ^ -(int32(src[6]>>14&0x3ff) & 1)
+	sum += int32(src[6]>>4&0x3ff)>>1 ^ -(int32(src[6]>>4&0x3ff) & 1)
+	sum += int32((src[6]<<6&0x3c0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x3c0)|src[7]>>26) & 1)
+	sum += int32(src[7]>>16&0x3ff)>>1 ^ -(int32(src[7]>>16&0x3ff) & 1)
+	sum += int32(src[7]>>6&0x3ff)>>1 ^ -(int32(src[7]>>6&0x3ff) & 1)
+	sum += int32((src[7]<<4&0x3f0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3f0)|src[8]>>28) & 1)
+	sum += int32(src[8]>>18&0x3ff)>>1 ^ -(int32(src[8]>>18&0x3ff) & 1)
+	sum += int32(src[8]>>8&0x3ff)>>1 ^ -(int32(src[8]>>8&0x3ff) & 1)
+	sum += int32((src[8]<<2&0x3fc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x3fc)|src[9]>>30) & 1)
+	sum += int32(src[9]>>20&0x3ff)>>1 ^ -(int32(src[9]>>20&0x3ff) & 1)
+	sum += int32(src[9]>>10&0x3ff)>>1 ^ -(int32(src[9]>>10&0x3ff) & 1)
+	sum += int32(src[9]>>0&0x3ff)>>1 ^ -(int32(src[9]>>0&0x3ff) & 1)
+	return offset - T(sum)
+}
+
+func decode11BitDeltaLast[T Integer](src *[11]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>21&0x7ff)>>1 ^ -(int32(src[0]>>21&0x7ff) & 1)
+	sum += int32(src[0]>>10&0x7ff)>>1 ^ -(int32(src[0]>>10&0x7ff) & 1)
+	sum += int32((src[0]<<1&0x7fe)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x7fe)|src[1]>>31) & 1)
+	sum += int32(src[1]>>20&0x7ff)>>1 ^ -(int32(src[1]>>20&0x7ff) & 1)
+	sum += int32(src[1]>>9&0x7ff)>>1 ^ -(int32(src[1]>>9&0x7ff) & 1)
+	sum += int32((src[1]<<2&0x7fc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x7fc)|src[2]>>30) & 1)
+	sum += int32(src[2]>>19&0x7ff)>>1 ^ -(int32(src[2]>>19&0x7ff) & 1)
+	sum += int32(src[2]>>8&0x7ff)>>1 ^ -(int32(src[2]>>8&0x7ff) & 1)
+	sum += int32((src[2]<<3&0x7f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x7f8)|src[3]>>29) & 1)
+	sum += int32(src[3]>>18&0x7ff)>>1 ^ -(int32(src[3]>>18&0x7ff) & 1)
+	sum += int32(src[3]>>7&0x7ff)>>1 ^ -(int32(src[3]>>7&0x7ff) & 1)
+	sum += int32((src[3]<<4&0x7f0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x7f0)|src[4]>>28) & 1)
+	sum += int32(src[4]>>17&0x7ff)>>1 ^ -(int32(src[4]>>17&0x7ff) & 1)
+	sum += int32(src[4]>>6&0x7ff)>>1 ^ -(int32(src[4]>>6&0x7ff) & 1)
+	sum += int32((src[4]<<5&0x7e0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7e0)|src[5]>>27) & 1)
+	sum += int32(src[5]>>16&0x7ff)>>1 ^ -(int32(src[5]>>16&0x7ff) & 1)
+	sum += int32(src[5]>>5&0x7ff)>>1 ^ -(int32(src[5]>>5&0x7ff) & 1)
+	sum += int32((src[5]<<6&0x7c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x7c0)|src[6]>>26) & 1)
+	sum += int32(src[6]>>15&0x7ff)>>1 ^ -(int32(src[6]>>15&0x7ff) & 1)
+	sum += int32(src[6]>>4&0x7ff)>>1 ^ -(int32(src[6]>>4&0x7ff) & 1)
+	sum += int32((src[6]<<7&0x780)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x780)|src[7]>>25) & 1)
+	sum += int32(src[7]>>14&0x7ff)>>1 ^ -(int32(src[7]>>14&0x7ff) & 1)
+	sum += int32(src[7]>>3&0x7ff)>>1 ^ -(int32(src[7]>>3&0x7ff) & 1)
+	sum += int32((src[7]<<8&0x700)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x700)|src[8]>>24) & 1)
+	sum += int32(src[8]>>13&0x7ff)>>1 ^ -(int32(src[8]>>13&0x7ff) & 1)
+	sum += int32(src[8]>>2&0x7ff)>>1 ^ -(int32(src[8]>>2&0x7ff) & 1)
+	sum += int32((src[8]<<9&0x600)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x600)|src[9]>>23) & 1)
+	sum += int32(src[9]>>12&0x7ff)>>1 ^ -(int32(src[9]>>12&0x7ff) & 1)
+	sum += int32(src[9]>>1&0x7ff)>>1 ^ -(int32(src[9]>>1&0x7ff) & 1)
+	sum += int32((src[9]<<10&0x400)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x400)|src[10]>>22) & 1)
+	sum += int32(src[10]>>11&0x7ff)>>1 ^ -(int32(src[10]>>11&0x7ff) & 1)
+	sum += int32(src[10]>>0&0x7ff)>>1 ^ -(int32(src[10]>>0&0x7ff) & 1)
+	return offset - T(sum)
+}
+
+func decode12BitDeltaLast[T Integer](src *[12]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>20&0xfff)>>1 ^ -(int32(src[0]>>20&0xfff) & 1)
+	sum += int32(src[0]>>8&0xfff)>>1 ^ -(int32(src[0]>>8&0xfff) & 1)
+	sum += int32((src[0]<<4&0xff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0xff0)|src[1]>>28) & 1)
+	sum += int32(src[1]>>16&0xfff)>>1 ^ -(int32(src[1]>>16&0xfff) & 1)
+	sum += int32(src[1]>>4&0xfff)>>1 ^ -(int32(src[1]>>4&0xfff) & 1)
+	sum += int32((src[1]<<8&0xf00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xf00)|src[2]>>24) & 1)
+	sum += int32(src[2]>>12&0xfff)>>1 ^ -(int32(src[2]>>12&0xfff) & 1)
+	sum += int32(src[2]>>0&0xfff)>>1 ^ -(int32(src[2]>>0&0xfff) & 1)
+	sum += int32(src[3]>>20&0xfff)>>1 ^ -(int32(src[3]>>20&0xfff) & 1)
+	sum += int32(src[3]>>8&0xfff)>>1 ^ -(int32(src[3]>>8&0xfff) & 1)
+	sum += int32((src[3]<<4&0xff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0xff0)|src[4]>>28) & 1)
+	sum += int32(src[4]>>16&0xfff)>>1 ^ -(int32(src[4]>>16&0xfff) & 1)
+	sum += int32(src[4]>>4&0xfff)>>1 ^ -(int32(src[4]>>4&0xfff) & 1)
+	sum += int32((src[4]<<8&0xf00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xf00)|src[5]>>24) & 1)
+	sum += int32(src[5]>>12&0xfff)>>1 ^ -(int32(src[5]>>12&0xfff) & 1)
+	sum += int32(src[5]>>0&0xfff)>>1 ^ -(int32(src[5]>>0&0xfff) & 1)
+	sum += int32(src[6]>>20&0xfff)>>1 ^ -(int32(src[6]>>20&0xfff) & 1)
+	sum += int32(src[6]>>8&0xfff)>>1 ^ -(int32(src[6]>>8&0xfff) & 1)
+	sum += int32((src[6]<<4&0xff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0xff0)|src[7]>>28) & 1)
+	sum += int32(src[7]>>16&0xfff)>>1 ^ -(int32(src[7]>>16&0xfff) & 1)
+	sum += int32(src[7]>>4&0xfff)>>1 ^ -(int32(src[7]>>4&0xfff) & 1)
+	sum += int32((src[7]<<8&0xf00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xf00)|src[8]>>24) & 1)
+	sum += int32(src[8]>>12&0xfff)>>1 ^ -(int32(src[8]>>12&0xfff) & 1)
+	sum += int32(src[8]>>0&0xfff)>>1 ^ -(int32(src[8]>>0&0xfff) & 1)
+	sum += int32(src[9]>>20&0xfff)>>1 ^ -(int32(src[9]>>20&0xfff) & 1)
+	sum += int32(src[9]>>8&0xfff)>>1 ^ -(int32(src[9]>>8&0xfff) & 1)
+	sum += int32((src[9]<<4&0xff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0xff0)|src[10]>>28) & 1)
+	sum += int32(src[10]>>16&0xfff)>>1 ^ -(int32(src[10]>>16&0xfff) & 1)
+	sum += int32(src[10]>>4&0xfff)>>1 ^ -(int32(src[10]>>4&0xfff) & 1)
+	sum += int32((src[10]<<8&0xf00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xf00)|src[11]>>24) & 1)
+	sum += int32(src[11]>>12&0xfff)>>1 ^ -(int32(src[11]>>12&0xfff) & 1)
+	sum += int32(src[11]>>0&0xfff)>>1 ^ -(int32(src[11]>>0&0xfff) & 1)
+	return offset - T(sum)
+}
+
+func decode13BitDeltaLast[T Integer](src *[13]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>19&0x1fff)>>1 ^ -(int32(src[0]>>19&0x1fff) & 1)
+	sum += int32(src[0]>>6&0x1fff)>>1 ^ -(int32(src[0]>>6&0x1fff) & 1)
+	sum += int32((src[0]<<7&0x1f80)|src[1]>>25)>>1 ^ -(int32((src[0]<<7&0x1f80)|src[1]>>25) & 1)
+	sum += int32(src[1]>>12&0x1fff)>>1 ^ -(int32(src[1]>>12&0x1fff) & 1)
+	sum += int32((src[1]<<1&0x1ffe)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1ffe)|src[2]>>31) & 1)
+	sum += int32(src[2]>>18&0x1fff)>>1 ^ -(int32(src[2]>>18&0x1fff) & 1)
+	sum += int32(src[2]>>5&0x1fff)>>1 ^ -(int32(src[2]>>5&0x1fff) & 1)
+	sum += int32((src[2]<<8&0x1f00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x1f00)|src[3]>>24) & 1)
+	sum += int32(src[3]>>11&0x1fff)>>1 ^ -(int32(src[3]>>11&0x1fff) & 1)
+	sum += int32((src[3]<<2&0x1ffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1ffc)|src[4]>>30) & 1)
+	sum += int32(src[4]>>17&0x1fff)>>1 ^ -(int32(src[4]>>17&0x1fff) & 1)
+	sum += int32(src[4]>>4&0x1fff)>>1 ^ -(int32(src[4]>>4&0x1fff) & 1)
+	sum += int32((src[4]<<9&0x1e00)|src[5]>>23)>>1 ^ -(int32((src[4]<<9&0x1e00)|src[5]>>23) & 1)
+	sum += int32(src[5]>>10&0x1fff)>>1 ^ -(int32(src[5]>>10&0x1fff) & 1)
+	sum += int32((src[5]<<3&0x1ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x1ff8)|src[6]>>29) & 1)
+	sum += int32(src[6]>>16&0x1fff)>>1 ^ -(int32(src[6]>>16&0x1fff) & 1)
+	sum += int32(src[6]>>3&0x1fff)>>1 ^ -(int32(src[6]>>3&0x1fff) & 1)
+	sum += int32((src[6]<<10&0x1c00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x1c00)|src[7]>>22) & 1)
+	sum += int32(src[7]>>9&0x1fff)>>1 ^ -(int32(src[7]>>9&0x1fff) & 1)
+	sum += int32((src[7]<<4&0x1ff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x1ff0)|src[8]>>28) & 1)
+	sum += int32(src[8]>>15&0x1fff)>>1 ^ -(int32(src[8]>>15&0x1fff) & 1)
+	sum += int32(src[8]>>2&0x1fff)>>1 ^ -(int32(src[8]>>2&0x1fff) & 1)
+	sum += int32((src[8]<<11&0x1800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x1800)|src[9]>>21) & 1)
+	sum += int32(src[9]>>8&0x1fff)>>1 ^ -(int32(src[9]>>8&0x1fff) & 1)
+	sum += int32((src[9]<<5&0x1fe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1fe0)|src[10]>>27) & 1)
+	sum += int32(src[10]>>14&0x1fff)>>1 ^ -(int32(src[10]>>14&0x1fff) & 1)
+	sum += int32(src[10]>>1&0x1fff)>>1 ^ -(int32(src[10]>>1&0x1fff) & 1)
+	sum += int32((src[10]<<12&0x1000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x1000)|src[11]>>20) & 1)
+	sum += int32(src[11]>>7&0x1fff)>>1 ^ -(int32(src[11]>>7&0x1fff) & 1)
+	sum += int32((src[11]<<6&0x1fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x1fc0)|src[12]>>26) & 1)
+	sum += int32(src[12]>>13&0x1fff)>>1 ^ -(int32(src[12]>>13&0x1fff) & 1)
+	sum += int32(src[12]>>0&0x1fff)>>1 ^ -(int32(src[12]>>0&0x1fff) & 1)
+	return offset - T(sum)
+}
+
+func decode14BitDeltaLast[T Integer](src *[14]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>18&0x3fff)>>1 ^ -(int32(src[0]>>18&0x3fff) & 1)
+	sum += int32(src[0]>>4&0x3fff)>>1 ^ -(int32(src[0]>>4&0x3fff) & 1)
+	sum += int32((src[0]<<10&0x3c00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x3c00)|src[1]>>22) & 1)
+	sum += int32(src[1]>>8&0x3fff)>>1 ^ -(int32(src[1]>>8&0x3fff) & 1)
+	sum += int32((src[1]<<6&0x3fc0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3fc0)|src[2]>>26) & 1)
+	sum += int32(src[2]>>12&0x3fff)>>1 ^ -(int32(src[2]>>12&0x3fff) & 1)
+	sum += int32((src[2]<<2&0x3ffc)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x3ffc)|src[3]>>30) & 1)
+	sum += int32(src[3]>>16&0x3fff)>>1 ^ -(int32(src[3]>>16&0x3fff) & 1)
+	sum += int32(src[3]>>2&0x3fff)>>1 ^ -(int32(src[3]>>2&0x3fff) & 1)
+	sum += int32((src[3]<<12&0x3000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0x3000)|src[4]>>20) & 1)
+	sum += int32(src[4]>>6&0x3fff)>>1 ^ -(int32(src[4]>>6&0x3fff) & 1)
+	sum += int32((src[4]<<8&0x3f00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x3f00)|src[5]>>24) & 1)
+	sum += int32(src[5]>>10&0x3fff)>>1 ^ -(int32(src[5]>>10&0x3fff) & 1)
+	sum += int32((src[5]<<4&0x3ff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x3ff0)|src[6]>>28) & 1)
+	sum += int32(src[6]>>14&0x3fff)>>1 ^ -(int32(src[6]>>14&0x3fff) & 1)
+	sum += int32(src[6]>>0&0x3fff)>>1 ^ -(int32(src[6]>>0&0x3fff) & 1)
+	sum += int32(src[7]>>18&0x3fff)>>1 ^ -(int32(src[7]>>18&0x3fff) & 1)
+	sum += int32(src[7]>>4&0x3fff)>>1 ^ -(int32(src[7]>>4&0x3fff) & 1)
+	sum += int32((src[7]<<10&0x3c00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x3c00)|src[8]>>22) & 1)
+	sum += int32(src[8]>>8&0x3fff)>>1 ^ -(int32(src[8]>>8&0x3fff) & 1)
+	sum += int32((src[8]<<6&0x3fc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x3fc0)|src[9]>>26) & 1)
+	sum += int32(src[9]>>12&0x3fff)>>1 ^ -(int32(src[9]>>12&0x3fff) & 1)
+	sum += int32((src[9]<<2&0x3ffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x3ffc)|src[10]>>30) & 1)
+	sum += int32(src[10]>>16&0x3fff)>>1 ^ -(int32(src[10]>>16&0x3fff) & 1)
+	sum += int32(src[10]>>2&0x3fff)>>1 ^ -(int32(src[10]>>2&0x3fff) & 1)
+	sum += int32((src[10]<<12&0x3000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3000)|src[11]>>20) & 1)
+	sum += int32(src[11]>>6&0x3fff)>>1 ^ -(int32(src[11]>>6&0x3fff) & 1)
+	sum += int32((src[11]<<8&0x3f00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0x3f00)|src[12]>>24) & 1)
+	sum += int32(src[12]>>10&0x3fff)>>1 ^ -(int32(src[12]>>10&0x3fff) & 1)
+	sum += int32((src[12]<<4&0x3ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ff0)|src[13]>>28) & 1)
+	sum += int32(src[13]>>14&0x3fff)>>1 ^ -(int32(src[13]>>14&0x3fff) & 1)
+	sum += int32(src[13]>>0&0x3fff)>>1 ^ -(int32(src[13]>>0&0x3fff) & 1)
+	return offset - T(sum)
+}
+
+func decode15BitDeltaLast[T Integer](src *[15]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>17&0x7fff)>>1 ^ -(int32(src[0]>>17&0x7fff) & 1)
+	sum += int32(src[0]>>2&0x7fff)>>1 ^ -(int32(src[0]>>2&0x7fff) & 1)
+	sum += int32((src[0]<<13&0x6000)|src[1]>>19)>>1 ^ -(int32((src[0]<<13&0x6000)|src[1]>>19) & 1)
+	sum += int32(src[1]>>4&0x7fff)>>1 ^ -(int32(src[1]>>4&0x7fff) & 1)
+	sum += int32((src[1]<<11&0x7800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x7800)|src[2]>>21) & 1)
+	sum += int32(src[2]>>6&0x7fff)>>1 ^ -(int32(src[2]>>6&0x7fff) & 1)
+	sum += int32((src[2]<<9&0x7e00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x7e00)|src[3]>>23) & 1)
+	sum += int32(src[3]>>8&0x7fff)>>1 ^ -(int32(src[3]>>8&0x7fff) & 1)
+	sum += int32((src[3]<<7&0x7f80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7f80)|src[4]>>25) & 1)
+	sum += int32(src[4]>>10&0x7fff)>>1 ^ -(int32(src[4]>>10&0x7fff) & 1)
+	sum += int32((src[4]<<5&0x7fe0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7fe0)|src[5]>>27) & 1)
+	sum += int32(src[5]>>12&0x7fff)>>1 ^ -(int32(src[5]>>12&0x7fff) & 1)
+	sum += int32((src[5]<<3&0x7ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x7ff8)|src[6]>>29) & 1)
+	sum += int32(src[6]>>14&0x7fff)>>1 ^ -(int32(src[6]>>14&0x7fff) & 1)
+	sum += int32((src[6]<<1&0x7ffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x7ffe)|src[7]>>31) & 1)
+	sum += int32(src[7]>>16&0x7fff)>>1 ^ -(int32(src[7]>>16&0x7fff) & 1)
+	sum += int32(src[7]>>1&0x7fff)>>1 ^ -(int32(src[7]>>1&0x7fff) & 1)
+	sum += int32((src[7]<<14&0x4000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x4000)|src[8]>>18) & 1)
+	sum += int32(src[8]>>3&0x7fff)>>1 ^ -(int32(src[8]>>3&0x7fff) & 1)
+	sum += int32((src[8]<<12&0x7000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x7000)|src[9]>>20) & 1)
+	sum += int32(src[9]>>5&0x7fff)>>1 ^ -(int32(src[9]>>5&0x7fff) & 1)
+	sum += int32((src[9]<<10&0x7c00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x7c00)|src[10]>>22) & 1)
+	sum += int32(src[10]>>7&0x7fff)>>1 ^ -(int32(src[10]>>7&0x7fff) & 1)
+	sum += int32((src[10]<<8&0x7f00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x7f00)|src[11]>>24) & 1)
+	sum += int32(src[11]>>9&0x7fff)>>1 ^ -(int32(src[11]>>9&0x7fff) & 1)
+	sum += int32((src[11]<<6&0x7fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x7fc0)|src[12]>>26) & 1)
+	sum += int32(src[12]>>11&0x7fff)>>1 ^ -(int32(src[12]>>11&0x7fff) & 1)
+	sum += int32((src[12]<<4&0x7ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x7ff0)|src[13]>>28) & 1)
+	sum += int32(src[13]>>13&0x7fff)>>1 ^ -(int32(src[13]>>13&0x7fff) & 1)
+	sum += int32((src[13]<<2&0x7ffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x7ffc)|src[14]>>30) & 1)
+	sum += int32(src[14]>>15&0x7fff)>>1 ^ -(int32(src[14]>>15&0x7fff) & 1)
+	sum += int32(src[14]>>0&0x7fff)>>1 ^ -(int32(src[14]>>0&0x7fff) & 1)
+	return offset - T(sum)
+}
+
+func decode16BitDeltaLast[T Integer](src *[16]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>16&0xffff)>>1 ^ -(int32(src[0]>>16&0xffff) & 1)
+	sum += int32(src[0]>>0&0xffff)>>1 ^ -(int32(src[0]>>0&0xffff) & 1)
+	sum += int32(src[1]>>16&0xffff)>>1 ^ -(int32(src[1]>>16&0xffff) & 1)
+	sum += int32(src[1]>>0&0xffff)>>1 ^ -(int32(src[1]>>0&0xffff) & 1)
+	sum += int32(src[2]>>16&0xffff)>>1 ^ -(int32(src[2]>>16&0xffff) & 1)
+	sum += int32(src[2]>>0&0xffff)>>1 ^ -(int32(src[2]>>0&0xffff) & 1)
+	sum += int32(src[3]>>16&0xffff)>>1 ^ -(int32(src[3]>>16&0xffff) & 1)
+	sum += int32(src[3]>>0&0xffff)>>1 ^ -(int32(src[3]>>0&0xffff) & 1)
+	sum += int32(src[4]>>16&0xffff)>>1 ^ -(int32(src[4]>>16&0xffff) & 1)
+	sum += int32(src[4]>>0&0xffff)>>1 ^ -(int32(src[4]>>0&0xffff) & 1)
+	sum += int32(src[5]>>16&0xffff)>>1 ^ -(int32(src[5]>>16&0xffff) & 1)
+	sum += int32(src[5]>>0&0xffff)>>1 ^ -(int32(src[5]>>0&0xffff) & 1)
+	sum += int32(src[6]>>16&0xffff)>>1 ^ -(int32(src[6]>>16&0xffff) & 1)
+	sum += int32(src[6]>>0&0xffff)>>1 ^ -(int32(src[6]>>0&0xffff) & 1)
+	sum += int32(src[7]>>16&0xffff)>>1 ^ -(int32(src[7]>>16&0xffff) & 1)
+	sum += int32(src[7]>>0&0xffff)>>1 ^ -(int32(src[7]>>0&0xffff) & 1)
+	sum += int32(src[8]>>16&0xffff)>>1 ^ -(int32(src[8]>>16&0xffff) & 1)
+	sum += int32(src[8]>>0&0xffff)>>1 ^ -(int32(src[8]>>0&0xffff) & 1)
+	sum += int32(src[9]>>16&0xffff)>>1 ^ -(int32(src[9]>>16&0xffff) & 1)
+	sum += int32(src[9]>>0&0xffff)>>1 ^ -(int32(src[9]>>0&0xffff) & 1)
+	sum += int32(src[10]>>16&0xffff)>>1 ^ -(int32(src[10]>>16&0xffff) & 1)
+	sum += int32(src[10]>>0&0xffff)>>1 ^ -(int32(src[10]>>0&0xffff) & 1)
+	sum += int32(src[11]>>16&0xffff)>>1 ^ -(int32(src[11]>>16&0xffff) & 1)
+	sum += int32(src[11]>>0&0xffff)>>1 ^ -(int32(src[11]>>0&0xffff) & 1)
+	sum += int32(src[12]>>16&0xffff)>>1 ^ -(int32(src[12]>>16&0xffff) & 1)
+	sum += int32(src[12]>>0&0xffff)>>1 ^ -(int32(src[12]>>0&0xffff) & 1)
+	sum += int32(src[13]>>16&0xffff)>>1 ^ -(int32(src[13]>>16&0xffff) & 1)
+	sum += int32(src[13]>>0&0xffff)>>1 ^ -(int32(src[13]>>0&0xffff) & 1)
+	sum += int32(src[14]>>16&0xffff)>>1 ^ -(int32(src[14]>>16&0xffff) & 1)
+	sum += int32(src[14]>>0&0xffff)>>1 ^ -(int32(src[14]>>0&0xffff) & 1)
+	sum += int32(src[15]>>16&0xffff)>>1 ^ -(int32(src[15]>>16&0xffff) & 1)
+	sum += int32(src[15]>>0&0xffff)>>1 ^ -(int32(src[15]>>0&0xffff) & 1)
+	return offset - T(sum)
+}
+
+func decode17BitDeltaLast[T Integer](src *[17]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>15&0x1ffff)>>1 ^ -(int32(src[0]>>15&0x1ffff) & 1)
+	sum += int32((src[0]<<2&0x1fffc)|src[1]>>30)>>1 ^ -(int32((src[0]<<2&0x1fffc)|src[1]>>30) & 1)
+	sum += int32(src[1]>>13&0x1ffff)>>1 ^ -(int32(src[1]>>13&0x1ffff) & 1)
+	sum += int32((src[1]<<4&0x1fff0)|src[2]>>28)>>1 ^ -(int32((src[1]<<4&0x1fff0)|src[2]>>28) & 1)
+	sum += int32(src[2]>>11&0x1ffff)>>1 ^ -(int32(src[2]>>11&0x1ffff) & 1)
+	sum += int32((src[2]<<6&0x1ffc0)|src[3]>>26)>>1 ^ -(int32((src[2]<<6&0x1ffc0)|src[3]>>26) & 1)
+	sum += int32(src[3]>>9&0x1ffff)>>1 ^ -(int32(src[3]>>9&0x1ffff) & 1)
+	sum += int32((src[3]<<8&0x1ff00)|src[4]>>24)>>1 ^ -(int32((src[3]<<8&0x1ff00)|src[4]>>24) & 1)
+	sum += int32(src[4]>>7&0x1ffff)>>1 ^ -(int32(src[4]>>7&0x1ffff) & 1)
+	sum += int32((src[4]<<10&0x1fc00)|src[5]>>22)>>1 ^ -(int32((src[4]<<10&0x1fc00)|src[5]>>22) & 1)
+	sum += int32(src[5]>>5&0x1ffff)>>1 ^ -(int32(src[5]>>5&0x1ffff) & 1)
+	sum += int32((src[5]<<12&0x1f000)|src[6]>>20)>>1 ^ -(int32((src[5]<<12&0x1f000)|src[6]>>20) & 1)
+	sum += int32(src[6]>>3&0x1ffff)>>1 ^ -(int32(src[6]>>3&0x1ffff) & 1)
+	sum += int32((src[6]<<14&0x1c000)|src[7]>>18)>>1 ^ -(int32((src[6]<<14&0x1c000)|src[7]>>18) & 1)
+	sum += int32(src[7]>>1&0x1ffff)>>1 ^ -(int32(src[7]>>1&0x1ffff) & 1)
+	sum += int32((src[7]<<16&0x10000)|src[8]>>16)>>1 ^ -(int32((src[7]<<16&0x10000)|src[8]>>16) & 1)
+	sum += int32((src[8]<<1&0x1fffe)|src[9]>>31)>>1 ^ -(int32((src[8]<<1&0x1fffe)|src[9]>>31) & 1)
+	sum += int32(src[9]>>14&0x1ffff)>>1 ^ -(int32(src[9]>>14&0x1ffff) & 1)
+	sum += int32((src[9]<<3&0x1fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x1fff8)|src[10]>>29) & 1)
+	sum += int32(src[10]>>12&0x1ffff)>>1 ^ -(int32(src[10]>>12&0x1ffff) & 1)
+	sum += int32((src[10]<<5&0x1ffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1ffe0)|src[11]>>27) & 1)
+	sum += int32(src[11]>>10&0x1ffff)>>1 ^ -(int32(src[11]>>10&0x1ffff) & 1)
+	sum += int32((src[11]<<7&0x1ff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x1ff80)|src[12]>>25) & 1)
+	sum += int32(src[12]>>8&0x1ffff)>>1 ^ -(int32(src[12]>>8&0x1ffff) & 1)
+	sum += int32((src[12]<<9&0x1fe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fe00)|src[13]>>23) & 1)
+	sum += int32(src[13]>>6&0x1ffff)>>1 ^ -(int32(src[13]>>6&0x1ffff) & 1)
+	sum += int32((src[13]<<11&0x1f800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x1f800)|src[14]>>21) & 1)
+	sum += int32(src[14]>>4&0x1ffff)>>1 ^ -(int32(src[14]>>4&0x1ffff) & 1)
+	sum += int32((src[14]<<13&0x1e000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1e000)|src[15]>>19) & 1)
+	sum += int32(src[15]>>2&0x1ffff)>>1 ^ -(int32(src[15]>>2&0x1ffff) & 1)
+	sum += int32((src[15]<<15&0x18000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x18000)|src[16]>>17) & 1)
+	sum += int32(src[16]>>0&0x1ffff)>>1 ^ -(int32(src[16]>>0&0x1ffff) & 1)
+	return offset - T(sum)
+}
+
+func decode18BitDeltaLast[T Integer](src *[18]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>14&0x3ffff)>>1 ^ -(int32(src[0]>>14&0x3ffff) & 1)
+	sum += int32((src[0]<<4&0x3fff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x3fff0)|src[1]>>28) & 1)
+	sum += int32(src[1]>>10&0x3ffff)>>1 ^ -(int32(src[1]>>10&0x3ffff) & 1)
+	sum += int32((src[1]<<8&0x3ff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x3ff00)|src[2]>>24) & 1)
+	sum += int32(src[2]>>6&0x3ffff)>>1 ^ -(int32(src[2]>>6&0x3ffff) & 1)
+	sum += int32((src[2]<<12&0x3f000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x3f000)|src[3]>>20) & 1)
+	sum += int32(src[3]>>2&0x3ffff)>>1 ^ -(int32(src[3]>>2&0x3ffff) & 1)
+	sum += int32((src[3]<<16&0x30000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0x30000)|src[4]>>16) & 1)
+	sum += int32((src[4]<<2&0x3fffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3fffc)|src[5]>>30) & 1)
+	sum += int32(src[5]>>12&0x3ffff)>>1 ^ -(int32(src[5]>>12&0x3ffff) & 1)
+	sum += int32((src[5]<<6&0x3ffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3ffc0)|src[6]>>26) & 1)
+	sum += int32(src[6]>>8&0x3ffff)>>1 ^ -(int32(src[6]>>8&0x3ffff) & 1)
+	sum += int32((src[6]<<10&0x3fc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fc00)|src[7]>>22) & 1)
+	sum += int32(src[7]>>4&0x3ffff)>>1 ^ -(int32(src[7]>>4&0x3ffff) & 1)
+	sum += int32((src[7]<<14&0x3c000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3c000)|src[8]>>18) & 1)
+	sum += int32(src[8]>>0&0x3ffff)>>1 ^ -(int32(src[8]>>0&0x3ffff) & 1)
+	sum += int32(src[9]>>14&0x3ffff)>>1 ^ -(int32(src[9]>>14&0x3ffff) & 1)
+	sum += int32((src[9]<<4&0x3fff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x3fff0)|src[10]>>28) & 1)
+	sum += int32(src[10]>>10&0x3ffff)>>1 ^ -(int32(src[10]>>10&0x3ffff) & 1)
+	sum += int32((src[10]<<8&0x3ff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3ff00)|src[11]>>24) & 1)
+	sum += int32(src[11]>>6&0x3ffff)>>1 ^ -(int32(src[11]>>6&0x3ffff) & 1)
+	sum += int32((src[11]<<12&0x3f000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3f000)|src[12]>>20) & 1)
+	sum += int32(src[12]>>2&0x3ffff)>>1 ^ -(int32(src[12]>>2&0x3ffff) & 1)
+	sum += int32((src[12]<<16&0x30000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x30000)|src[13]>>16) & 1)
+	sum += int32((src[13]<<2&0x3fffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3fffc)|src[14]>>30) & 1)
+	sum += int32(src[14]>>12&0x3ffff)>>1 ^ -(int32(src[14]>>12&0x3ffff) & 1)
+	sum += int32((src[14]<<6&0x3ffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x3ffc0)|src[15]>>26) & 1)
+	sum += int32(src[15]>>8&0x3ffff)>>1 ^ -(int32(src[15]>>8&0x3ffff) & 1)
+	sum += int32((src[15]<<10&0x3fc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x3fc00)|src[16]>>22) & 1)
+	sum += int32(src[16]>>4&0x3ffff)>>1 ^ -(int32(src[16]>>4&0x3ffff) & 1)
+	sum += int32((src[16]<<14&0x3c000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x3c000)|src[17]>>18) & 1)
+	sum += int32(src[17]>>0&0x3ffff)>>1 ^ -(int32(src[17]>>0&0x3ffff) & 1)
+	return offset - T(sum)
+}
+
+func decode19BitDeltaLast[T Integer](src *[19]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>13&0x7ffff)>>1 ^ -(int32(src[0]>>13&0x7ffff) & 1)
+	sum += int32((src[0]<<6&0x7ffc0)|src[1]>>26)>>1 ^ -(int32((src[0]<<6&0x7ffc0)|src[1]>>26) & 1)
+	sum += int32(src[1]>>7&0x7ffff)>>1 ^ -(int32(src[1]>>7&0x7ffff) & 1)
+	sum += int32((src[1]<<12&0x7f000)|src[2]>>20)>>1 ^ -(int32((src[1]<<12&0x7f000)|src[2]>>20) & 1)
+	sum += int32(src[2]>>1&0x7ffff)>>1 ^ -(int32(src[2]>>1&0x7ffff) & 1)
+	sum += int32((src[2]<<18&0x40000)|src[3]>>14)>>1 ^ -(int32((src[2]<<18&0x40000)|src[3]>>14) & 1)
+	sum += int32((src[3]<<5&0x7ffe0)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x7ffe0)|src[4]>>27) & 1)
+	sum += int32(src[4]>>8&0x7ffff)>>1 ^ -(int32(src[4]>>8&0x7ffff) & 1)
+	sum += int32((src[4]<<11&0x7f800)|src[5]>>21)>>1 ^ -(int32((src[4]<<11&0x7f800)|src[5]>>21) & 1)
+	sum += int32(src[5]>>2&0x7ffff)>>1 ^ -(int32(src[5]>>2&0x7ffff) & 1)
+	sum += int32((src[5]<<17&0x60000)|src[6]>>15)>>1 ^ -(int32((src[5]<<17&0x60000)|src[6]>>15) & 1)
+	sum += int32((src[6]<<4&0x7fff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0x7fff0)|src[7]>>28) & 1)
+	sum += int32(src[7]>>9&0x7ffff)>>1 ^ -(int32(src[7]>>9&0x7ffff) & 1)
+	sum += int32((src[7]<<10&0x7fc00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x7fc00)|src[8]>>22) & 1)
+	sum += int32(src[8]>>3&0x7ffff)>>1 ^ -(int32(src[8]>>3&0x7ffff) & 1)
+	sum += int32((src[8]<<16&0x70000)|src[9]>>16)>>1 ^ -(int32((src[8]<<16&0x70000)|src[9]>>16) & 1)
+	sum += int32((src[9]<<3&0x7fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x7fff8)|src[10]>>29) & 1)
+	sum += int32(src[10]>>10&0x7ffff)>>1 ^ -(int32(src[10]>>10&0x7ffff) & 1)
+	sum += int32((src[10]<<9&0x7fe00)|src[11]>>23)>>1 ^ -(int32((src[10]<<9&0x7fe00)|src[11]>>23) & 1)
+	sum += int32(src[11]>>4&0x7ffff)>>1 ^ -(int32(src[11]>>4&0x7ffff) & 1)
+	sum += int32((src[11]<<15&0x78000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x78000)|src[12]>>17) & 1)
+	sum += int32((src[12]<<2&0x7fffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x7fffc)|src[13]>>30) & 1)
+	sum += int32(src[13]>>11&0x7ffff)>>1 ^ -(int32(src[13]>>11&0x7ffff) & 1)
+	sum += int32((src[13]<<8&0x7ff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0x7ff00)|src[14]>>24) & 1)
+	sum += int32(src[14]>>5&0x7ffff)>>1 ^ -(int32(src[14]>>5&0x7ffff) & 1)
+	sum += int32((src[14]<<14&0x7c000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x7c000)|src[15]>>18) & 1)
+	sum += int32((src[15]<<1&0x7fffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffe)|src[16]>>31) & 1)
+	sum += int32(src[16]>>12&0x7ffff)>>1 ^ -(int32(src[16]>>12&0x7ffff) & 1)
+	sum += int32((src[16]<<7&0x7ff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x7ff80)|src[17]>>25) & 1)
+	sum += int32(src[17]>>6&0x7ffff)>>1 ^ -(int32(src[17]>>6&0x7ffff) & 1)
+	sum += int32((src[17]<<13&0x7e000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7e000)|src[18]>>19) & 1)
+	sum += int32(src[18]>>0&0x7ffff)>>1 ^ -(int32(src[18]>>0&0x7ffff) & 1)
+	return offset - T(sum)
+}
+
+func decode20BitDeltaLast[T Integer](src *[20]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>12&0xfffff)>>1 ^ -(int32(src[0]>>12&0xfffff) & 1)
+	sum += int32((src[0]<<8&0xfff00)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0xfff00)|src[1]>>24) & 1)
+	sum += int32(src[1]>>4&0xfffff)>>1 ^ -(int32(src[1]>>4&0xfffff) & 1)
+	sum += int32((src[1]<<16&0xf0000)|src[2]>>16)>>1 ^ -(int32((src[1]<<16&0xf0000)|src[2]>>16) & 1)
+	sum += int32((src[2]<<4&0xffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0xffff0)|src[3]>>28) & 1)
+	sum += int32(src[3]>>8&0xfffff)>>1 ^ -(int32(src[3]>>8&0xfffff) & 1)
+	sum += int32((src[3]<<12&0xff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xff000)|src[4]>>20) & 1)
+	sum += int32(src[4]>>0&0xfffff)>>1 ^ -(int32(src[4]>>0&0xfffff) & 1)
+	sum += int32(src[5]>>12&0xfffff)>>1 ^ -(int32(src[5]>>12&0xfffff) & 1)
+	sum += int32((src[5]<<8&0xfff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0xfff00)|src[6]>>24) & 1)
+	sum += int32(src[6]>>4&0xfffff)>>1 ^ -(int32(src[6]>>4&0xfffff) & 1)
+	sum += int32((src[6]<<16&0xf0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xf0000)|src[7]>>16) & 1)
+	sum += int32((src[7]<<4&0xffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0xffff0)|src[8]>>28) & 1)
+	sum += int32(src[8]>>8&0xfffff)>>1 ^ -(int32(src[8]>>8&0xfffff) & 1)
+	sum += int32((src[8]<<12&0xff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0xff000)|src[9]>>20) & 1)
+	sum += int32(src[9]>>0&0xfffff)>>1 ^ -(int32(src[9]>>0&0xfffff) & 1)
+	sum += int32(src[10]>>12&0xfffff)>>1 ^ -(int32(src[10]>>12&0xfffff) & 1)
+	sum += int32((src[10]<<8&0xfff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xfff00)|src[11]>>24) & 1)
+	sum += int32(src[11]>>4&0xfffff)>>1 ^ -(int32(src[11]>>4&0xfffff) & 1)
+	sum += int32((src[11]<<16&0xf0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0xf0000)|src[12]>>16) & 1)
+	sum += int32((src[12]<<4&0xffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffff0)|src[13]>>28) & 1)
+	sum += int32(src[13]>>8&0xfffff)>>1 ^ -(int32(src[13]>>8&0xfffff) & 1)
+	sum += int32((src[13]<<12&0xff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0xff000)|src[14]>>20) & 1)
+	sum += int32(src[14]>>0&0xfffff)>>1 ^ -(int32(src[14]>>0&0xfffff) & 1)
+	sum += int32(src[15]>>12&0xfffff)>>1 ^ -(int32(src[15]>>12&0xfffff) & 1)
+	sum += int32((src[15]<<8&0xfff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0xfff00)|src[16]>>24) & 1)
+	sum += int32(src[16]>>4&0xfffff)>>1 ^ -(int32(src[16]>>4&0xfffff) & 1)
+	sum += int32((src[16]<<16&0xf0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xf0000)|src[17]>>16) & 1)
+	sum += int32((src[17]<<4&0xffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0xffff0)|src[18]>>28) & 1)
+	sum += int32(src[18]>>8&0xfffff)>>1 ^ -(int32(src[18]>>8&0xfffff) & 1)
+	sum += int32((src[18]<<12&0xff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0xff000)|src[19]>>20) & 1)
+	sum += int32(src[19]>>0&0xfffff)>>1 ^ -(int32(src[19]>>0&0xfffff) & 1)
+	return offset - T(sum)
+}
+
+func decode21BitDeltaLast[T Integer](src *[21]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>11&0x1fffff)>>1 ^ -(int32(src[0]>>11&0x1fffff) & 1)
+	sum += int32((src[0]<<10&0x1ffc00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x1ffc00)|src[1]>>22) & 1)
+	sum += int32(src[1]>>1&0x1fffff)>>1 ^ -(int32(src[1]>>1&0x1fffff) & 1)
+	sum += int32((src[1]<<20&0x100000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0x100000)|src[2]>>12) & 1)
+	sum += int32((src[2]<<9&0x1ffe00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x1ffe00)|src[3]>>23) & 1)
+	sum += int32(src[3]>>2&0x1fffff)>>1 ^ -(int32(src[3]>>2&0x1fffff) & 1)
+	sum += int32((src[3]<<19&0x180000)|src[4]>>13)>>1 ^ -(int32((src[3]<<19&0x180000)|src[4]>>13) & 1)
+	sum += int32((src[4]<<8&0x1fff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x1fff00)|src[5]>>24) & 1)
+	sum += int32(src[5]>>3&0x1fffff)>>1 ^ -(int32(src[5]>>3&0x1fffff) & 1)
+	sum += int32((src[5]<<18&0x1c0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x1c0000)|src[6]>>14) & 1)
+	sum += int32((src[6]<<7&0x1fff80)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x1fff80)|src[7]>>25) & 1)
+	sum += int32(src[7]>>4&0x1fffff)>>1 ^ -(int32(src[7]>>4&0x1fffff) & 1)
+	sum += int32((src[7]<<17&0x1e0000)|src[8]>>15)>>1 ^ -(int32((src[7]<<17&0x1e0000)|src[8]>>15) & 1)
+	sum += int32((src[8]<<6&0x1fffc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x1fffc0)|src[9]>>26) & 1)
+	sum += int32(src[9]>>5&0x1fffff)>>1 ^ -(int32(src[9]>>5&0x1fffff) & 1)
+	sum += int32((src[9]<<16&0x1f0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0x1f0000)|src[10]>>16) & 1)
+	sum += int32((src[10]<<5&0x1fffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1fffe0)|src[11]>>27) & 1)
+	sum += int32(src[11]>>6&0x1fffff)>>1 ^ -(int32(src[11]>>6&0x1fffff) & 1)
+	sum += int32((src[11]<<15&0x1f8000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x1f8000)|src[12]>>17) & 1)
+	sum += int32((src[12]<<4&0x1ffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x1ffff0)|src[13]>>28) & 1)
+	sum += int32(src[13]>>7&0x1fffff)>>1 ^ -(int32(src[13]>>7&0x1fffff) & 1)
+	sum += int32((src[13]<<14&0x1fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x1fc000)|src[14]>>18) & 1)
+	sum += int32((src[14]<<3&0x1ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x1ffff8)|src[15]>>29) & 1)
+	sum += int32(src[15]>>8&0x1fffff)>>1 ^ -(int32(src[15]>>8&0x1fffff) & 1)
+	sum += int32((src[15]<<13&0x1fe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1fe000)|src[16]>>19) & 1)
+	sum += int32((src[16]<<2&0x1ffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x1ffffc)|src[17]>>30) & 1)
+	sum += int32(src[17]>>9&0x1fffff)>>1 ^ -(int32(src[17]>>9&0x1fffff) & 1)
+	sum += int32((src[17]<<12&0x1ff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0x1ff000)|src[18]>>20) & 1)
+	sum += int32((src[18]<<1&0x1ffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffe)|src[19]>>31) & 1)
+	sum += int32(src[19]>>10&0x1fffff)>>1 ^ -(int32(src[19]>>10&0x1fffff) & 1)
+	sum += int32((src[19]<<11&0x1ff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x1ff800)|src[20]>>21) & 1)
+	sum += int32(src[20]>>0&0x1fffff)>>1 ^ -(int32(src[20]>>0&0x1fffff) & 1)
+	return offset - T(sum)
+}
+
+func decode22BitDeltaLast[T Integer](src *[22]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>10&0x3fffff)>>1 ^ -(int32(src[0]>>10&0x3fffff) & 1)
+	sum += int32((src[0]<<12&0x3ff000)|src[1]>>20)>>1 ^ -(int32((src[0]<<12&0x3ff000)|src[1]>>20) & 1)
+	sum += int32((src[1]<<2&0x3ffffc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3ffffc)|src[2]>>30) & 1)
+	sum += int32(src[2]>>8&0x3fffff)>>1 ^ -(int32(src[2]>>8&0x3fffff) & 1)
+	sum += int32((src[2]<<14&0x3fc000)|src[3]>>18)>>1 ^ -(int32((src[2]<<14&0x3fc000)|src[3]>>18) & 1)
+	sum += int32((src[3]<<4&0x3ffff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x3ffff0)|src[4]>>28) & 1)
+	sum += int32(src[4]>>6&0x3fffff)>>1 ^ -(int32(src[4]>>6&0x3fffff) & 1)
+	sum += int32((src[4]<<16&0x3f0000)|src[5]>>16)>>1 ^ -(int32((src[4]<<16&0x3f0000)|src[5]>>16) & 1)
+	sum += int32((src[5]<<6&0x3fffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3fffc0)|src[6]>>26) & 1)
+	sum += int32(src[6]>>4&0x3fffff)>>1 ^ -(int32(src[6]>>4&0x3fffff) & 1)
+	sum += int32((src[6]<<18&0x3c0000)|src[7]>>14)>>1 ^ -(int32((src[6]<<18&0x3c0000)|src[7]>>14) & 1)
+	sum += int32((src[7]<<8&0x3fff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x3fff00)|src[8]>>24) & 1)
+	sum += int32(src[8]>>2&0x3fffff)>>1 ^ -(int32(src[8]>>2&0x3fffff) & 1)
+	sum += int32((src[8]<<20&0x300000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0x300000)|src[9]>>12) & 1)
+	sum += int32((src[9]<<10&0x3ffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffc00)|src[10]>>22) & 1)
+	sum += int32(src[10]>>0&0x3fffff)>>1 ^ -(int32(src[10]>>0&0x3fffff) & 1)
+	sum += int32(src[11]>>10&0x3fffff)>>1 ^ -(int32(src[11]>>10&0x3fffff) & 1)
+	sum += int32((src[11]<<12&0x3ff000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3ff000)|src[12]>>20) & 1)
+	sum += int32((src[12]<<2&0x3ffffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x3ffffc)|src[13]>>30) & 1)
+	sum += int32(src[13]>>8&0x3fffff)>>1 ^ -(int32(src[13]>>8&0x3fffff) & 1)
+	sum += int32((src[13]<<14&0x3fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x3fc000)|src[14]>>18) & 1)
+	sum += int32((src[14]<<4&0x3ffff0)|src[15]>>28)>>1 ^ -(int32((src[14]<<4&0x3ffff0)|src[15]>>28) & 1)
+	sum += int32(src[15]>>6&0x3fffff)>>1 ^ -(int32(src[15]>>6&0x3fffff) & 1)
+	sum += int32((src[15]<<16&0x3f0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0x3f0000)|src[16]>>16) & 1)
+	sum += int32((src[16]<<6&0x3fffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x3fffc0)|src[17]>>26) & 1)
+	sum += int32(src[17]>>4&0x3fffff)>>1 ^ -(int32(src[17]>>4&0x3fffff) & 1)
+	sum += int32((src[17]<<18&0x3c0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x3c0000)|src[18]>>14) & 1)
+	sum += int32((src[18]<<8&0x3fff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0x3fff00)|src[19]>>24) & 1)
+	sum += int32(src[19]>>2&0x3fffff)>>1 ^ -(int32(src[19]>>2&0x3fffff) & 1)
+	sum += int32((src[19]<<20&0x300000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x300000)|src[20]>>12) & 1)
+	sum += int32((src[20]<<10&0x3ffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x3ffc00)|src[21]>>22) & 1)
+	sum += int32(src[21]>>0&0x3fffff)>>1 ^ -(int32(src[21]>>0&0x3fffff) & 1)
+	return offset - T(sum)
+}
+
+func decode23BitDeltaLast[T Integer](src *[23]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>9&0x7fffff)>>1 ^ -(int32(src[0]>>9&0x7fffff) & 1)
+	sum += int32((src[0]<<14&0x7fc000)|src[1]>>18)>>1 ^ -(int32((src[0]<<14&0x7fc000)|src[1]>>18) & 1)
+	sum += int32((src[1]<<5&0x7fffe0)|src[2]>>27)>>1 ^ -(int32((src[1]<<5&0x7fffe0)|src[2]>>27) & 1)
+	sum += int32(src[2]>>4&0x7fffff)>>1 ^ -(int32(src[2]>>4&0x7fffff) & 1)
+	sum += int32((src[2]<<19&0x780000)|src[3]>>13)>>1 ^ -(int32((src[2]<<19&0x780000)|src[3]>>13) & 1)
+	sum += int32((src[3]<<10&0x7ffc00)|src[4]>>22)>>1 ^ -(int32((src[3]<<10&0x7ffc00)|src[4]>>22) & 1)
+	sum += int32((src[4]<<1&0x7ffffe)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7ffffe)|src[5]>>31) & 1)
+	sum += int32(src[5]>>8&0x7fffff)>>1 ^ -(int32(src[5]>>8&0x7fffff) & 1)
+	sum += int32((src[5]<<15&0x7f8000)|src[6]>>17)>>1 ^ -(int32((src[5]<<15&0x7f8000)|src[6]>>17) & 1)
+	sum += int32((src[6]<<6&0x7fffc0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x7fffc0)|src[7]>>26) & 1)
+	sum += int32(src[7]>>3&0x7fffff)>>1 ^ -(int32(src[7]>>3&0x7fffff) & 1)
+	sum += int32((src[7]<<20&0x700000)|src[8]>>12)>>1 ^ -(int32((src[7]<<20&0x700000)|src[8]>>12) & 1)
+	sum += int32((src[8]<<11&0x7ff800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x7ff800)|src[9]>>21) & 1)
+	sum += int32((src[9]<<2&0x7ffffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x7ffffc)|src[10]>>30) & 1)
+	sum += int32(src[10]>>7&0x7fffff)>>1 ^ -(int32(src[10]>>7&0x7fffff) & 1)
+	sum += int32((src[10]<<16&0x7f0000)|src[11]>>16)>>1 ^ -(int32((src[10]<<16&0x7f0000)|src[11]>>16) & 1)
+	sum += int32((src[11]<<7&0x7fff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x7fff80)|src[12]>>25) & 1)
+	sum += int32(src[12]>>2&0x7fffff)>>1 ^ -(int32(src[12]>>2&0x7fffff) & 1)
+	sum += int32((src[12]<<21&0x600000)|src[13]>>11)>>1 ^ -(int32((src[12]<<21&0x600000)|src[13]>>11) & 1)
+	sum += int32((src[13]<<12&0x7ff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0x7ff000)|src[14]>>20) & 1)
+	sum += int32((src[14]<<3&0x7ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x7ffff8)|src[15]>>29) & 1)
+	sum += int32(src[15]>>6&0x7fffff)>>1 ^ -(int32(src[15]>>6&0x7fffff) & 1)
+	sum += int32((src[15]<<17&0x7e0000)|src[16]>>15)>>1 ^ -(int32((src[15]<<17&0x7e0000)|src[16]>>15) & 1)
+	sum += int32((src[16]<<8&0x7fff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0x7fff00)|src[17]>>24) & 1)
+	sum += int32(src[17]>>1&0x7fffff)>>1 ^ -(int32(src[17]>>1&0x7fffff) & 1)
+	sum += int32((src[17]<<22&0x400000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x400000)|src[18]>>10) & 1)
+	sum += int32((src[18]<<13&0x7fe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7fe000)|src[19]>>19) & 1)
+	sum += int32((src[19]<<4&0x7ffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0x7ffff0)|src[20]>>28) & 1)
+	sum += int32(src[20]>>5&0x7fffff)>>1 ^ -(int32(src[20]>>5&0x7fffff) & 1)
+	sum += int32((src[20]<<18&0x7c0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x7c0000)|src[21]>>14) & 1)
+	sum += int32((src[21]<<9&0x7ffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffe00)|src[22]>>23) & 1)
+	sum += int32(src[22]>>0&0x7fffff)>>1 ^ -(int32(src[22]>>0&0x7fffff) & 1)
+	return offset - T(sum)
+}
+
+func decode24BitDeltaLast[T Integer](src *[24]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>8&0xffffff)>>1 ^ -(int32(src[0]>>8&0xffffff) & 1)
+	sum += int32((src[0]<<16&0xff0000)|src[1]>>16)>>1 ^ -(int32((src[0]<<16&0xff0000)|src[1]>>16) & 1)
+	sum += int32((src[1]<<8&0xffff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xffff00)|src[2]>>24) & 1)
+	sum += int32(src[2]>>0&0xffffff)>>1 ^ -(int32(src[2]>>0&0xffffff) & 1)
+	sum += int32(src[3]>>8&0xffffff)>>1 ^ -(int32(src[3]>>8&0xffffff) & 1)
+	sum += int32((src[3]<<16&0xff0000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0xff0000)|src[4]>>16) & 1)
+	sum += int32((src[4]<<8&0xffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xffff00)|src[5]>>24) & 1)
+	sum += int32(src[5]>>0&0xffffff)>>1 ^ -(int32(src[5]>>0&0xffffff) & 1)
+	sum += int32(src[6]>>8&0xffffff)>>1 ^ -(int32(src[6]>>8&0xffffff) & 1)
+	sum += int32((src[6]<<16&0xff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xff0000)|src[7]>>16) & 1)
+	sum += int32((src[7]<<8&0xffff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xffff00)|src[8]>>24) & 1)
+	sum += int32(src[8]>>0&0xffffff)>>1 ^ -(int32(src[8]>>0&0xffffff) & 1)
+	sum += int32(src[9]>>8&0xffffff)>>1 ^ -(int32(src[9]>>8&0xffffff) & 1)
+	sum += int32((src[9]<<16&0xff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xff0000)|src[10]>>16) & 1)
+	sum += int32((src[10]<<8&0xffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xffff00)|src[11]>>24) & 1)
+	sum += int32(src[11]>>0&0xffffff)>>1 ^ -(int32(src[11]>>0&0xffffff) & 1)
+	sum += int32(src[12]>>8&0xffffff)>>1 ^ -(int32(src[12]>>8&0xffffff) & 1)
+	sum += int32((src[12]<<16&0xff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0xff0000)|src[13]>>16) & 1)
+	sum += int32((src[13]<<8&0xffff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0xffff00)|src[14]>>24) & 1)
+	sum += int32(src[14]>>0&0xffffff)>>1 ^ -(int32(src[14]>>0&0xffffff) & 1)
+	sum += int32(src[15]>>8&0xffffff)>>1 ^ -(int32(src[15]>>8&0xffffff) & 1)
+	sum += int32((src[15]<<16&0xff0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0xff0000)|src[16]>>16) & 1)
+	sum += int32((src[16]<<8&0xffff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0xffff00)|src[17]>>24) & 1)
+	sum += int32(src[17]>>0&0xffffff)>>1 ^ -(int32(src[17]>>0&0xffffff) & 1)
+	sum += int32(src[18]>>8&0xffffff)>>1 ^ -(int32(src[18]>>8&0xffffff) & 1)
+	sum += int32((src[18]<<16&0xff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0xff0000)|src[19]>>16) & 1)
+	sum += int32((src[19]<<8&0xffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0xffff00)|src[20]>>24) & 1)
+	sum += int32(src[20]>>0&0xffffff)>>1 ^ -(int32(src[20]>>0&0xffffff) & 1)
+	sum += int32(src[21]>>8&0xffffff)>>1 ^ -(int32(src[21]>>8&0xffffff) & 1)
+	sum += int32((src[21]<<16&0xff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0xff0000)|src[22]>>16) & 1)
+	sum += int32((src[22]<<8&0xffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0xffff00)|src[23]>>24) & 1)
+	sum += int32(src[23]>>0&0xffffff)>>1 ^ -(int32(src[23]>>0&0xffffff) & 1)
+	return offset - T(sum)
+}
+
+func decode25BitDeltaLast[T Integer](src *[25]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>7&0x1ffffff)>>1 ^ -(int32(src[0]>>7&0x1ffffff) & 1)
+	sum += int32((src[0]<<18&0x1fc0000)|src[1]>>14)>>1 ^ -(int32((src[0]<<18&0x1fc0000)|src[1]>>14) & 1)
+	sum += int32((src[1]<<11&0x1fff800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x1fff800)|src[2]>>21) & 1)
+	sum += int32((src[2]<<4&0x1fffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x1fffff0)|src[3]>>28) & 1)
+	sum += int32(src[3]>>3&0x1ffffff)>>1 ^ -(int32(src[3]>>3&0x1ffffff) & 1)
+	sum += int32((src[3]<<22&0x1c00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x1c00000)|src[4]>>10) & 1)
+	sum += int32((src[4]<<15&0x1ff8000)|src[5]>>17)>>1 ^ -(int32((src[4]<<15&0x1ff8000)|src[5]>>17) & 1)
+	sum += int32((src[5]<<8&0x1ffff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x1ffff00)|src[6]>>24) & 1)
+	sum += int32((src[6]<<1&0x1fffffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fffffe)|src[7]>>31) & 1)
+	sum += int32(src[7]>>6&0x1ffffff)>>1 ^ -(int32(src[7]>>6&0x1ffffff) & 1)
+	sum += int32((src[7]<<19&0x1f80000)|src[8]>>13)>>1 ^ -(int32((src[7]<<19&0x1f80000)|src[8]>>13) & 1)
+	sum += int32((src[8]<<12&0x1fff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x1fff000)|src[9]>>20) & 1)
+	sum += int32((src[9]<<5&0x1ffffe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1ffffe0)|src[10]>>27) & 1)
+	sum += int32(src[10]>>2&0x1ffffff)>>1 ^ -(int32(src[10]>>2&0x1ffffff) & 1)
+	sum += int32((src[10]<<23&0x1800000)|src[11]>>9)>>1 ^ -(int32((src[10]<<23&0x1800000)|src[11]>>9) & 1)
+	sum += int32((src[11]<<16&0x1ff0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0x1ff0000)|src[12]>>16) & 1)
+	sum += int32((src[12]<<9&0x1fffe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fffe00)|src[13]>>23) & 1)
+	sum += int32((src[13]<<2&0x1fffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x1fffffc)|src[14]>>30) & 1)
+	sum += int32(src[14]>>5&0x1ffffff)>>1 ^ -(int32(src[14]>>5&0x1ffffff) & 1)
+	sum += int32((src[14]<<20&0x1f00000)|src[15]>>12)>>1 ^ -(int32((src[14]<<20&0x1f00000)|src[15]>>12) & 1)
+	sum += int32((src[15]<<13&0x1ffe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1ffe000)|src[16]>>19) & 1)
+	sum += int32((src[16]<<6&0x1ffffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x1ffffc0)|src[17]>>26) & 1)
+	sum += int32(src[17]>>1&0x1ffffff)>>1 ^ -(int32(src[17]>>1&0x1ffffff) & 1)
+	sum += int32((src[17]<<24&0x1000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x1000000)|src[18]>>8) & 1)
+	sum += int32((src[18]<<17&0x1fe0000)|src[19]>>15)>>1 ^ -(int32((src[18]<<17&0x1fe0000)|src[19]>>15) & 1)
+	sum += int32((src[19]<<10&0x1fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x1fffc00)|src[20]>>22) & 1)
+	sum += int32((src[20]<<3&0x1fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x1fffff8)|src[21]>>29) & 1)
+	sum += int32(src[21]>>4&0x1ffffff)>>1 ^ -(int32(src[21]>>4&0x1ffffff) & 1)
+	sum += int32((src[21]<<21&0x1e00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1e00000)|src[22]>>11) & 1)
+	sum += int32((src[22]<<14&0x1ffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x1ffc000)|src[23]>>18) & 1)
+	sum += int32((src[23]<<7&0x1ffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x1ffff80)|src[24]>>25) & 1)
+	sum += int32(src[24]>>0&0x1ffffff)>>1 ^ -(int32(src[24]>>0&0x1ffffff) & 1)
+	return offset - T(sum)
+}
+
+func decode26BitDeltaLast[T Integer](src *[26]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>6&0x3ffffff)>>1 ^ -(int32(src[0]>>6&0x3ffffff) & 1)
+	sum += int32((src[0]<<20&0x3f00000)|src[1]>>12)>>1 ^ -(int32((src[0]<<20&0x3f00000)|src[1]>>12) & 1)
+	sum += int32((src[1]<<14&0x3ffc000)|src[2]>>18)>>1 ^ -(int32((src[1]<<14&0x3ffc000)|src[2]>>18) & 1)
+	sum += int32((src[2]<<8&0x3ffff00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x3ffff00)|src[3]>>24) & 1)
+	sum += int32((src[3]<<2&0x3fffffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fffffc)|src[4]>>30) & 1)
+	sum += int32(src[4]>>4&0x3ffffff)>>1 ^ -(int32(src[4]>>4&0x3ffffff) & 1)
+	sum += int32((src[4]<<22&0x3c00000)|src[5]>>10)>>1 ^ -(int32((src[4]<<22&0x3c00000)|src[5]>>10) & 1)
+	sum += int32((src[5]<<16&0x3ff0000)|src[6]>>16)>>1 ^ -(int32((src[5]<<16&0x3ff0000)|src[6]>>16) & 1)
+	sum += int32((src[6]<<10&0x3fffc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fffc00)|src[7]>>22) & 1)
+	sum += int32((src[7]<<4&0x3fffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3fffff0)|src[8]>>28) & 1)
+	sum += int32(src[8]>>2&0x3ffffff)>>1 ^ -(int32(src[8]>>2&0x3ffffff) & 1)
+	sum += int32((src[8]<<24&0x3000000)|src[9]>>8)>>1 ^ -(int32((src[8]<<24&0x3000000)|src[9]>>8) & 1)
+	sum += int32((src[9]<<18&0x3fc0000)|src[10]>>14)>>1 ^ -(int32((src[9]<<18&0x3fc0000)|src[10]>>14) & 1)
+	sum += int32((src[10]<<12&0x3fff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3fff000)|src[11]>>20) & 1)
+	sum += int32((src[11]<<6&0x3ffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3ffffc0)|src[12]>>26) & 1)
+	sum += int32(src[12]>>0&0x3ffffff)>>1 ^ -(int32(src[12]>>0&0x3ffffff) & 1)
+	sum += int32(src[13]>>6&0x3ffffff)>>1 ^ -(int32(src[13]>>6&0x3ffffff) & 1)
+	sum += int32((src[13]<<20&0x3f00000)|src[14]>>12)>>1 ^ -(int32((src[13]<<20&0x3f00000)|src[14]>>12) & 1)
+	sum += int32((src[14]<<14&0x3ffc000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x3ffc000)|src[15]>>18) & 1)
+	sum += int32((src[15]<<8&0x3ffff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0x3ffff00)|src[16]>>24) & 1)
+	sum += int32((src[16]<<2&0x3fffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x3fffffc)|src[17]>>30) & 1)
+	sum += int32(src[17]>>4&0x3ffffff)>>1 ^ -(int32(src[17]>>4&0x3ffffff) & 1)
+	sum += int32((src[17]<<22&0x3c00000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x3c00000)|src[18]>>10) & 1)
+	sum += int32((src[18]<<16&0x3ff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0x3ff0000)|src[19]>>16) & 1)
+	sum += int32((src[19]<<10&0x3fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x3fffc00)|src[20]>>22) & 1)
+	sum += int32((src[20]<<4&0x3fffff0)|src[21]>>28)>>1 ^ -(int32((src[20]<<4&0x3fffff0)|src[21]>>28) & 1)
+	sum += int32(src[21]>>2&0x3ffffff)>>1 ^ -(int32(src[21]>>2&0x3ffffff) & 1)
+	sum += int32((src[21]<<24&0x3000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0x3000000)|src[22]>>8) & 1)
+	sum += int32((src[22]<<18&0x3fc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x3fc0000)|src[23]>>14) & 1)
+	sum += int32((src[23]<<12&0x3fff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3fff000)|src[24]>>20) & 1)
+	sum += int32((src[24]<<6&0x3ffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x3ffffc0)|src[25]>>26) & 1)
+	sum += int32(src[25]>>0&0x3ffffff)>>1 ^ -(int32(src[25]>>0&0x3ffffff) & 1)
+	return offset - T(sum)
+}
+
+func decode27BitDeltaLast[T Integer](src *[27]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>5&0x7ffffff)>>1 ^ -(int32(src[0]>>5&0x7ffffff) & 1)
+	sum += int32((src[0]<<22&0x7c00000)|src[1]>>10)>>1 ^ -(int32((src[0]<<22&0x7c00000)|src[1]>>10) & 1)
+	sum += int32((src[1]<<17&0x7fe0000)|src[2]>>15)>>1 ^ -(int32((src[1]<<17&0x7fe0000)|src[2]>>15) & 1)
+	sum += int32((src[2]<<12&0x7fff000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x7fff000)|src[3]>>20) & 1)
+	sum += int32((src[3]<<7&0x7ffff80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7ffff80)|src[4]>>25) & 1)
+	sum += int32((src[4]<<2&0x7fffffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x7fffffc)|src[5]>>30) & 1)
+	sum += int32(src[5]>>3&0x7ffffff)>>1 ^ -(int32(src[5]>>3&0x7ffffff) & 1)
+	sum += int32((src[5]<<24&0x7000000)|src[6]>>8)>>1 ^ -(int32((src[5]<<24&0x7000000)|src[6]>>8) & 1)
+	sum += int32((src[6]<<19&0x7f80000)|src[7]>>13)>>1 ^ -(int32((src[6]<<19&0x7f80000)|src[7]>>13) & 1)
+	sum += int32((src[7]<<14&0x7ffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x7ffc000)|src[8]>>18) & 1)
+	sum += int32((src[8]<<9&0x7fffe00)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x7fffe00)|src[9]>>23) & 1)
+	sum += int32((src[9]<<4&0x7fffff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x7fffff0)|src[10]>>28) & 1)
+	sum += int32(src[10]>>1&0x7ffffff)>>1 ^ -(int32(src[10]>>1&0x7ffffff) & 1)
+	sum += int32((src[10]<<26&0x4000000)|src[11]>>6)>>1 ^ -(int32((src[10]<<26&0x4000000)|src[11]>>6) & 1)
+	sum += int32((src[11]<<21&0x7e00000)|src[12]>>11)>>1 ^ -(int32((src[11]<<21&0x7e00000)|src[12]>>11) & 1)
+	sum += int32((src[12]<<16&0x7ff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x7ff0000)|src[13]>>16) & 1)
+	sum += int32((src[13]<<11&0x7fff800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x7fff800)|src[14]>>21) & 1)
+	sum += int32((src[14]<<6&0x7ffffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x7ffffc0)|src[15]>>26) & 1)
+	sum += int32((src[15]<<1&0x7fffffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffffe)|src[16]>>31) & 1)
+	sum += int32(src[16]>>4&0x7ffffff)>>1 ^ -(int32(src[16]>>4&0x7ffffff) & 1)
+	sum += int32((src[16]<<23&0x7800000)|src[17]>>9)>>1 ^ -(int32((src[16]<<23&0x7800000)|src[17]>>9) & 1)
+	sum += int32((src[17]<<18&0x7fc0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x7fc0000)|src[18]>>14) & 1)
+	sum += int32((src[18]<<13&0x7ffe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7ffe000)|src[19]>>19) & 1)
+	sum += int32((src[19]<<8&0x7ffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0x7ffff00)|src[20]>>24) & 1)
+	sum += int32((src[20]<<3&0x7fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x7fffff8)|src[21]>>29) & 1)
+	sum += int32(src[21]>>2&0x7ffffff)>>1 ^ -(int32(src[21]>>2&0x7ffffff) & 1)
+	sum += int32((src[21]<<25&0x6000000)|src[22]>>7)>>1 ^ -(int32((src[21]<<25&0x6000000)|src[22]>>7) & 1)
+	sum += int32((src[22]<<20&0x7f00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0x7f00000)|src[23]>>12) & 1)
+	sum += int32((src[23]<<15&0x7ff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x7ff8000)|src[24]>>17) & 1)
+	sum += int32((src[24]<<10&0x7fffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x7fffc00)|src[25]>>22) & 1)
+	sum += int32((src[25]<<5&0x7ffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7ffffe0)|src[26]>>27) & 1)
+	sum += int32(src[26]>>0&0x7ffffff)>>1 ^ -(int32(src[26]>>0&0x7ffffff) & 1)
+	return offset - T(sum)
+}
+
+func decode28BitDeltaLast[T Integer](src *[28]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>4&0xfffffff)>>1 ^ -(int32(src[0]>>4&0xfffffff) & 1)
+	sum += int32((src[0]<<24&0xf000000)|src[1]>>8)>>1 ^ -(int32((src[0]<<24&0xf000000)|src[1]>>8) & 1)
+	sum += int32((src[1]<<20&0xff00000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0xff00000)|src[2]>>12) & 1)
+	sum += int32((src[2]<<16&0xfff0000)|src[3]>>16)>>1 ^ -(int32((src[2]<<16&0xfff0000)|src[3]>>16) & 1)
+	sum += int32((src[3]<<12&0xffff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xffff000)|src[4]>>20) & 1)
+	sum += int32((src[4]<<8&0xfffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xfffff00)|src[5]>>24) & 1)
+	sum += int32((src[5]<<4&0xffffff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0xffffff0)|src[6]>>28) & 1)
+	sum += int32(src[6]>>0&0xfffffff)>>1 ^ -(int32(src[6]>>0&0xfffffff) & 1)
+	sum += int32(src[7]>>4&0xfffffff)>>1 ^ -(int32(src[7]>>4&0xfffffff) & 1)
+	sum += int32((src[7]<<24&0xf000000)|src[8]>>8)>>1 ^ -(int32((src[7]<<24&0xf000000)|src[8]>>8) & 1)
+	sum += int32((src[8]<<20&0xff00000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0xff00000)|src[9]>>12) & 1)
+	sum += int32((src[9]<<16&0xfff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xfff0000)|src[10]>>16) & 1)
+	sum += int32((src[10]<<12&0xffff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0xffff000)|src[11]>>20) & 1)
+	sum += int32((src[11]<<8&0xfffff00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0xfffff00)|src[12]>>24) & 1)
+	sum += int32((src[12]<<4&0xffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffffff0)|src[13]>>28) & 1)
+	sum += int32(src[13]>>0&0xfffffff)>>1 ^ -(int32(src[13]>>0&0xfffffff) & 1)
+	sum += int32(src[14]>>4&0xfffffff)>>1 ^ -(int32(src[14]>>4&0xfffffff) & 1)
+	sum += int32((src[14]<<24&0xf000000)|src[15]>>8)>>1 ^ -(int32((src[14]<<24&0xf000000)|src[15]>>8) & 1)
+	sum += int32((src[15]<<20&0xff00000)|src[16]>>12)>>1 ^ -(int32((src[15]<<20&0xff00000)|src[16]>>12) & 1)
+	sum += int32((src[16]<<16&0xfff0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xfff0000)|src[17]>>16) & 1)
+	sum += int32((src[17]<<12&0xffff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0xffff000)|src[18]>>20) & 1)
+	sum += int32((src[18]<<8&0xfffff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0xfffff00)|src[19]>>24) & 1)
+	sum += int32((src[19]<<4&0xffffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0xffffff0)|src[20]>>28) & 1)
+	sum += int32(src[20]>>0&0xfffffff)>>1 ^ -(int32(src[20]>>0&0xfffffff) & 1)
+	sum += int32(src[21]>>4&0xfffffff)>>1 ^ -(int32(src[21]>>4&0xfffffff) & 1)
+	sum += int32((src[21]<<24&0xf000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0xf000000)|src[22]>>8) & 1)
+	sum += int32((src[22]<<20&0xff00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0xff00000)|src[23]>>12) & 1)
+	sum += int32((src[23]<<16&0xfff0000)|src[24]>>16)>>1 ^ -(int32((src[23]<<16&0xfff0000)|src[24]>>16) & 1)
+	sum += int32((src[24]<<12&0xffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0xffff000)|src[25]>>20) & 1)
+	sum += int32((src[25]<<8&0xfffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0xfffff00)|src[26]>>24) & 1)
+	sum += int32((src[26]<<4&0xffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0xffffff0)|src[27]>>28) & 1)
+	sum += int32(src[27]>>0&0xfffffff)>>1 ^ -(int32(src[27]>>0&0xfffffff) & 1)
+	return offset - T(sum)
+}
+
+func decode29BitDeltaLast[T Integer](src *[29]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>3&0x1fffffff)>>1 ^ -(int32(src[0]>>3&0x1fffffff) & 1)
+	sum += int32((src[0]<<26&0x1c000000)|src[1]>>6)>>1 ^ -(int32((src[0]<<26&0x1c000000)|src[1]>>6) & 1)
+	sum += int32((src[1]<<23&0x1f800000)|src[2]>>9)>>1 ^ -(int32((src[1]<<23&0x1f800000)|src[2]>>9) & 1)
+	sum += int32((src[2]<<20&0x1ff00000)|src[3]>>12)>>1 ^ -(int32((src[2]<<20&0x1ff00000)|src[3]>>12) & 1)
+	sum += int32((src[3]<<17&0x1ffe0000)|src[4]>>15)>>1 ^ -(int32((src[3]<<17&0x1ffe0000)|src[4]>>15) & 1)
+	sum += int32((src[4]<<14&0x1fffc000)|src[5]>>18)>>1 ^ -(int32((src[4]<<14&0x1fffc000)|src[5]>>18) & 1)
+	sum += int32((src[5]<<11&0x1ffff800)|src[6]>>21)>>1 ^ -(int32((src[5]<<11&0x1ffff800)|src[6]>>21) & 1)
+	sum += int32((src[6]<<8&0x1fffff00)|src[7]>>24)>>1 ^ -(int32((src[6]<<8&0x1fffff00)|src[7]>>24) & 1)
+	sum += int32((src[7]<<5&0x1fffffe0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1fffffe0)|src[8]>>27) & 1)
+	sum += int32((src[8]<<2&0x1ffffffc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x1ffffffc)|src[9]>>30) & 1)
+	sum += int32(src[9]>>1&0x1fffffff)>>1 ^ -(int32(src[9]>>1&0x1fffffff) & 1)
+	sum += int32((src[9]<<28&0x10000000)|src[10]>>4)>>1 ^ -(int32((src[9]<<28&0x10000000)|src[10]>>4) & 1)
+	sum += int32((src[10]<<25&0x1e000000)|src[11]>>7)>>1 ^ -(int32((src[10]<<25&0x1e000000)|src[11]>>7) & 1)
+	sum += int32((src[11]<<22&0x1fc00000)|src[12]>>10)>>1 ^ -(int32((src[11]<<22&0x1fc00000)|src[12]>>10) & 1)
+	sum += int32((src[12]<<19&0x1ff80000)|src[13]>>13)>>1 ^ -(int32((src[12]<<19&0x1ff80000)|src[13]>>13) & 1)
+	sum += int32((src[13]<<16&0x1fff0000)|src[14]>>16)>>1 ^ -(int32((src[13]<<16&0x1fff0000)|src[14]>>16) & 1)
+	sum += int32((src[14]<<13&0x1fffe000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1fffe000)|src[15]>>19) & 1)
+	sum += int32((src[15]<<10&0x1ffffc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x1ffffc00)|src[16]>>22) & 1)
+	sum += int32((src[16]<<7&0x1fffff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x1fffff80)|src[17]>>25) & 1)
+	sum += int32((src[17]<<4&0x1ffffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0x1ffffff0)|src[18]>>28) & 1)
+	sum += int32((src[18]<<1&0x1ffffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffffe)|src[19]>>31) & 1)
+	sum += int32(src[19]>>2&0x1fffffff)>>1 ^ -(int32(src[19]>>2&0x1fffffff) & 1)
+	sum += int32((src[19]<<27&0x18000000)|src[20]>>5)>>1 ^ -(int32((src[19]<<27&0x18000000)|src[20]>>5) & 1)
+	sum += int32((src[20]<<24&0x1f000000)|src[21]>>8)>>1 ^ -(int32((src[20]<<24&0x1f000000)|src[21]>>8) & 1)
+	sum += int32((src[21]<<21&0x1fe00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1fe00000)|src[22]>>11) & 1)
+	sum += int32((src[22]<<18&0x1ffc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x1ffc0000)|src[23]>>14) & 1)
+	sum += int32((src[23]<<15&0x1fff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x1fff8000)|src[24]>>17) & 1)
+	sum += int32((src[24]<<12&0x1ffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0x1ffff000)|src[25]>>20) & 1)
+	sum += int32((src[25]<<9&0x1ffffe00)|src[26]>>23)>>1 ^ -(int32((src[25]<<9&0x1ffffe00)|src[26]>>23) & 1)
+	sum += int32((src[26]<<6&0x1fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x1fffffc0)|src[27]>>26) & 1)
+	sum += int32((src[27]<<3&0x1ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x1ffffff8)|src[28]>>29) & 1)
+	sum += int32(src[28]>>0&0x1fffffff)>>1 ^ -(int32(src[28]>>0&0x1fffffff) & 1)
+	return offset - T(sum)
+}
+
+func decode30BitDeltaLast[T Integer](src *[30]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>2&0x3fffffff)>>1 ^ -(int32(src[0]>>2&0x3fffffff) & 1)
+	sum += int32((src[0]<<28&0x30000000)|src[1]>>4)>>1 ^ -(int32((src[0]<<28&0x30000000)|src[1]>>4) & 1)
+	sum += int32((src[1]<<26&0x3c000000)|src[2]>>6)>>1 ^ -(int32((src[1]<<26&0x3c000000)|src[2]>>6) & 1)
+	sum += int32((src[2]<<24&0x3f000000)|src[3]>>8)>>1 ^ -(int32((src[2]<<24&0x3f000000)|src[3]>>8) & 1)
+	sum += int32((src[3]<<22&0x3fc00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x3fc00000)|src[4]>>10) & 1)
+	sum += int32((src[4]<<20&0x3ff00000)|src[5]>>12)>>1 ^ -(int32((src[4]<<20&0x3ff00000)|src[5]>>12) & 1)
+	sum += int32((src[5]<<18&0x3ffc0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x3ffc0000)|src[6]>>14) & 1)
+	sum += int32((src[6]<<16&0x3fff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0x3fff0000)|src[7]>>16) & 1)
+	sum += int32((src[7]<<14&0x3fffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3fffc000)|src[8]>>18) & 1)
+	sum += int32((src[8]<<12&0x3ffff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x3ffff000)|src[9]>>20) & 1)
+	sum += int32((src[9]<<10&0x3ffffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffffc00)|src[10]>>22) & 1)
+	sum += int32((src[10]<<8&0x3fffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3fffff00)|src[11]>>24) & 1)
+	sum += int32((src[11]<<6&0x3fffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3fffffc0)|src[12]>>26) & 1)
+	sum += int32((src[12]<<4&0x3ffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ffffff0)|src[13]>>28) & 1)
+	sum += int32((src[13]<<2&0x3ffffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3ffffffc)|src[14]>>30) & 1)
+	sum += int32(src[14]>>0&0x3fffffff)>>1 ^ -(int32(src[14]>>0&0x3fffffff) & 1)
+	sum += int32(src[15]>>2&0x3fffffff)>>1 ^ -(int32(src[15]>>2&0x3fffffff) & 1)
+	sum += int32((src[15]<<28&0x30000000)|src[16]>>4)>>1 ^ -(int32((src[15]<<28&0x30000000)|src[16]>>4) & 1)
+	sum += int32((src[16]<<26&0x3c000000)|src[17]>>6)>>1 ^ -(int32((src[16]<<26&0x3c000000)|src[17]>>6) & 1)
+	sum += int32((src[17]<<24&0x3f000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x3f000000)|src[18]>>8) & 1)
+	sum += int32((src[18]<<22&0x3fc00000)|src[19]>>10)>>1 ^ -(int32((src[18]<<22&0x3fc00000)|src[19]>>10) & 1)
+	sum += int32((src[19]<<20&0x3ff00000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x3ff00000)|src[20]>>12) & 1)
+	sum += int32((src[20]<<18&0x3ffc0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x3ffc0000)|src[21]>>14) & 1)
+	sum += int32((src[21]<<16&0x3fff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0x3fff0000)|src[22]>>16) & 1)
+	sum += int32((src[22]<<14&0x3fffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x3fffc000)|src[23]>>18) & 1)
+	sum += int32((src[23]<<12&0x3ffff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3ffff000)|src[24]>>20) & 1)
+	sum += int32((src[24]<<10&0x3ffffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x3ffffc00)|src[25]>>22) & 1)
+	sum += int32((src[25]<<8&0x3fffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0x3fffff00)|src[26]>>24) & 1)
+	sum += int32((src[26]<<6&0x3fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x3fffffc0)|src[27]>>26) & 1)
+	sum += int32((src[27]<<4&0x3ffffff0)|src[28]>>28)>>1 ^ -(int32((src[27]<<4&0x3ffffff0)|src[28]>>28) & 1)
+	sum += int32((src[28]<<2&0x3ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x3ffffffc)|src[29]>>30) & 1)
+	sum += int32(src[29]>>0&0x3fffffff)>>1 ^ -(int32(src[29]>>0&0x3fffffff) & 1)
+	return offset - T(sum)
+}
+
+func decode31BitDeltaLast[T Integer](src *[31]Word, offset T) T {
+	var sum int32
+	sum += int32(src[0]>>1&0x7fffffff)>>1 ^ -(int32(src[0]>>1&0x7fffffff) & 1)
+	sum += int32((src[0]<<30&0x40000000)|src[1]>>2)>>1 ^ -(int32((src[0]<<30&0x40000000)|src[1]>>2) & 1)
+	sum += int32((src[1]<<29&0x60000000)|src[2]>>3)>>1 ^ -(int32((src[1]<<29&0x60000000)|src[2]>>3) & 1)
+	sum += int32((src[2]<<28&0x70000000)|src[3]>>4)>>1 ^ -(int32((src[2]<<28&0x70000000)|src[3]>>4) & 1)
+	sum += int32((src[3]<<27&0x78000000)|src[4]>>5)>>1 ^ -(int32((src[3]<<27&0x78000000)|src[4]>>5) & 1)
+	sum += int32((src[4]<<26&0x7c000000)|src[5]>>6)>>1 ^ -(int32((src[4]<<26&0x7c000000)|src[5]>>6) & 1)
+	sum += int32((src[5]<<25&0x7e000000)|src[6]>>7)>>1 ^ -(int32((src[5]<<25&0x7e000000)|src[6]>>7) & 1)
+	sum += int32((src[6]<<24&0x7f000000)|src[7]>>8)>>1 ^ -(int32((src[6]<<24&0x7f000000)|src[7]>>8) & 1)
+	sum += int32((src[7]<<23&0x7f800000)|src[8]>>9)>>1 ^ -(int32((src[7]<<23&0x7f800000)|src[8]>>9) & 1)
+	sum += int32((src[8]<<22&0x7fc00000)|src[9]>>10)>>1 ^ -(int32((src[8]<<22&0x7fc00000)|src[9]>>10) & 1)
+	sum += int32((src[9]<<21&0x7fe00000)|src[10]>>11)>>1 ^ -(int32((src[9]<<21&0x7fe00000)|src[10]>>11) & 1)
+	sum += int32((src[10]<<20&0x7ff00000)|src[11]>>12)>>1 ^ -(int32((src[10]<<20&0x7ff00000)|src[11]>>12) & 1)
+	sum += int32((src[11]<<19&0x7ff80000)|src[12]>>13)>>1 ^ -(int32((src[11]<<19&0x7ff80000)|src[12]>>13) & 1)
+	sum += int32((src[12]<<18&0x7ffc0000)|src[13]>>14)>>1 ^ -(int32((src[12]<<18&0x7ffc0000)|src[13]>>14) & 1)
+	sum += int32((src[13]<<17&0x7ffe0000)|src[14]>>15)>>1 ^ -(int32((src[13]<<17&0x7ffe0000)|src[14]>>15) & 1)
+	sum += int32((src[14]<<16&0x7fff0000)|src[15]>>16)>>1 ^ -(int32((src[14]<<16&0x7fff0000)|src[15]>>16) & 1)
+	sum += int32((src[15]<<15&0x7fff8000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x7fff8000)|src[16]>>17) & 1)
+	sum += int32((src[16]<<14&0x7fffc000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x7fffc000)|src[17]>>18) & 1)
+	sum += int32((src[17]<<13&0x7fffe000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7fffe000)|src[18]>>19) & 1)
+	sum += int32((src[18]<<12&0x7ffff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0x7ffff000)|src[19]>>20) & 1)
+	sum += int32((src[19]<<11&0x7ffff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x7ffff800)|src[20]>>21) & 1)
+	sum += int32((src[20]<<10&0x7ffffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x7ffffc00)|src[21]>>22) & 1)
+	sum += int32((src[21]<<9&0x7ffffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffffe00)|src[22]>>23) & 1)
+	sum += int32((src[22]<<8&0x7fffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0x7fffff00)|src[23]>>24) & 1)
+	sum += int32((src[23]<<7&0x7fffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x7fffff80)|src[24]>>25) & 1)
+	sum += int32((src[24]<<6&0x7fffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x7fffffc0)|src[25]>>26) & 1)
+	sum += int32((src[25]<<5&0x7fffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7fffffe0)|src[26]>>27) & 1)
+	sum += int32((src[26]<<4&0x7ffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0x7ffffff0)|src[27]>>28) & 1)
+	sum += int32((src[27]<<3&0x7ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x7ffffff8)|src[28]>>29) & 1)
+	sum += int32((src[28]<<2&0x7ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x7ffffffc)|src[29]>>30) & 1)
+	sum += int32((src[29]<<1&0x7ffffffe)|src[30]>>31)>>1 ^ -(int32((src[29]<<1&0x7ffffffe)|src[30]>>31) & 1)
+	sum += int32(src[30]>>0&0x7fffffff)>>1 ^ -(int32(src[30]>>0&0x7fffffff) & 1)
+	return offset - T(sum)
+}
+
+func sum1BitDelta[T Integer](src *[1]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>31&0x1)>>1 ^ -(int32(src[0]>>31&0x1) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>30&0x1)>>1 ^ -(int32(src[0]>>30&0x1) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>29&0x1)>>1 ^ -(int32(src[0]>>29&0x1) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>28&0x1)>>1 ^ -(int32(src[0]>>28&0x1) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[0]>>27&0x1)>>1 ^ -(int32(src[0]>>27&0x1) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[0]>>26&0x1)>>1 ^ -(int32(src[0]>>26&0x1) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[0]>>25&0x1)>>1 ^ -(int32(src[0]>>25&0x1) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[0]>>24&0x1)>>1 ^ -(int32(src[0]>>24&0x1) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[0]>>23&0x1)>>1 ^ -(int32(src[0]>>23&0x1) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[0]>>22&0x1)>>1 ^ -(int32(src[0]>>22&0x1) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[0]>>21&0x1)>>1 ^ -(int32(src[0]>>21&0x1) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[0]>>20&0x1)>>1 ^ -(int32(src[0]>>20&0x1) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[0]>>19&0x1)>>1 ^ -(int32(src[0]>>19&0x1) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[0]>>18&0x1)>>1 ^ -(int32(src[0]>>18&0x1) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[0]>>17&0x1)>>1 ^ -(int32(src[0]>>17&0x1) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[0]>>16&0x1)>>1 ^ -(int32(src[0]>>16&0x1) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[0]>>15&0x1)>>1 ^ -(int32(src[0]>>15&0x1) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[0]>>14&0x1)>>1 ^ -(int32(src[0]>>14&0x1) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[0]>>13&0x1)>>1 ^ -(int32(src[0]>>13&0x1) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[0]>>12&0x1)>>1 ^ -(int32(src[0]>>12&0x1) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[0]>>11&0x1)>>1 ^ -(int32(src[0]>>11&0x1) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[0]>>10&0x1)>>1 ^ -(int32(src[0]>>10&0x1) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[0]>>9&0x1)>>1 ^ -(int32(src[0]>>9&0x1) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[0]>>8&0x1)>>1 ^ -(int32(src[0]>>8&0x1) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[0]>>7&0x1)>>1 ^ -(int32(src[0]>>7&0x1) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[0]>>6&0x1)>>1 ^ -(int32(src[0]>>6&0x1) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[0]>>5&0x1)>>1 ^ -(int32(src[0]>>5&0x1) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[0]>>4&0x1)>>1 ^ -(int32(src[0]>>4&0x1) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[0]>>3&0x1)>>1 ^ -(int32(src[0]>>3&0x1) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[0]>>2&0x1)>>1 ^ -(int32(src[0]>>2&0x1) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[0]>>1&0x1)>>1 ^ -(int32(src[0]>>1&0x1) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[0]>>0&0x1)>>1 ^ -(int32(src[0]>>0&0x1) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum2BitDelta[T Integer](src *[2]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>30&0x3)>>1 ^ -(int32(src[0]>>30&0x3) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>28&0x3)>>1 ^ -(int32(src[0]>>28&0x3) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>26&0x3)>>1 ^ -(int32(src[0]>>26&0x3) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>24&0x3)>>1 ^ -(int32(src[0]>>24&0x3) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[0]>>22&0x3)>>1 ^ -(int32(src[0]>>22&0x3) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[0]>>20&0x3)>>1 ^ -(int32(src[0]>>20&0x3) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[0]>>18&0x3)>>1 ^ -(int32(src[0]>>18&0x3) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[0]>>16&0x3)>>1 ^ -(int32(src[0]>>16&0x3) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[0]>>14&0x3)>>1 ^ -(int32(src[0]>>14&0x3) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[0]>>12&0x3)>>1 ^ -(int32(src[0]>>12&0x3) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[0]>>10&0x3)>>1 ^ -(int32(src[0]>>10&0x3) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[0]>>8&0x3)>>1 ^ -(int32(src[0]>>8&0x3) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[0]>>6&0x3)>>1 ^ -(int32(src[0]>>6&0x3) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[0]>>4&0x3)>>1 ^ -(int32(src[0]>>4&0x3) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[0]>>2&0x3)>>1 ^ -(int32(src[0]>>2&0x3) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[0]>>0&0x3)>>1 ^ -(int32(src[0]>>0&0x3) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[1]>>30&0x3)>>1 ^ -(int32(src[1]>>30&0x3) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[1]>>28&0x3)>>1 ^ -(int32(src[1]>>28&0x3) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[1]>>26&0x3)>>1 ^ -(int32(src[1]>>26&0x3) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[1]>>24&0x3)>>1 ^ -(int32(src[1]>>24&0x3) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[1]>>22&0x3)>>1 ^ -(int32(src[1]>>22&0x3) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[1]>>20&0x3)>>1 ^ -(int32(src[1]>>20&0x3) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[1]>>18&0x3)>>1 ^ -(int32(src[1]>>18&0x3) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[1]>>16&0x3)>>1 ^ -(int32(src[1]>>16&0x3) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[1]>>14&0x3)>>1 ^ -(int32(src[1]>>14&0x3) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[1]>>12&0x3)>>1 ^ -(int32(src[1]>>12&0x3) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[1]>>10&0x3)>>1 ^ -(int32(src[1]>>10&0x3) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[1]>>8&0x3)>>1 ^ -(int32(src[1]>>8&0x3) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[1]>>6&0x3)>>1 ^ -(int32(src[1]>>6&0x3) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[1]>>4&0x3)>>1 ^ -(int32(src[1]>>4&0x3) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[1]>>2&0x3)>>1 ^ -(int32(src[1]>>2&0x3) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[1]>>0&0x3)>>1 ^ -(int32(src[1]>>0&0x3) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum3BitDelta[T Integer](src *[3]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>29&0x7)>>1 ^ -(int32(src[0]>>29&0x7) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>26&0x7)>>1 ^ -(int32(src[0]>>26&0x7) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>23&0x7)>>1 ^ -(int32(src[0]>>23&0x7) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>20&0x7)>>1 ^ -(int32(src[0]>>20&0x7) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[0]>>17&0x7)>>1 ^ -(int32(src[0]>>17&0x7) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[0]>>14&0x7)>>1 ^ -(int32(src[0]>>14&0x7) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[0]>>11&0x7)>>1 ^ -(int32(src[0]>>11&0x7) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[0]>>8&0x7)>>1 ^ -(int32(src[0]>>8&0x7) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[0]>>5&0x7)>>1 ^ -(int32(src[0]>>5&0x7) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[0]>>2&0x7)>>1 ^ -(int32(src[0]>>2&0x7) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[0]<<1&0x6)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x6)|src[1]>>31) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[1]>>28&0x7)>>1 ^ -(int32(src[1]>>28&0x7) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[1]>>25&0x7)>>1 ^ -(int32(src[1]>>25&0x7) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[1]>>22&0x7)>>1 ^ -(int32(src[1]>>22&0x7) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[1]>>19&0x7)>>1 ^ -(int32(src[1]>>19&0x7) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[1]>>16&0x7)>>1 ^ -(int32(src[1]>>16&0x7) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[1]>>13&0x7)>>1 ^ -(int32(src[1]>>13&0x7) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[1]>>10&0x7)>>1 ^ -(int32(src[1]>>10&0x7) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[1]>>7&0x7)>>1 ^ -(int32(src[1]>>7&0x7) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[1]>>4&0x7)>>1 ^ -(int32(src[1]>>4&0x7) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[1]>>1&0x7)>>1 ^ -(int32(src[1]>>1&0x7) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[1]<<2&0x4)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x4)|src[2]>>30) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[2]>>27&0x7)>>1 ^ -(int32(src[2]>>27&0x7) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[2]>>24&0x7)>>1 ^ -(int32(src[2]>>24&0x7) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[2]>>21&0x7)>>1 ^ -(int32(src[2]>>21&0x7) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[2]>>18&0x7)>>1 ^ -(int32(src[2]>>18&0x7) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[2]>>15&0x7)>>1 ^ -(int32(src[2]>>15&0x7) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[2]>>12&0x7)>>1 ^ -(int32(src[2]>>12&0x7) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[2]>>9&0x7)>>1 ^ -(int32(src[2]>>9&0x7) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[2]>>6&0x7)>>1 ^ -(int32(src[2]>>6&0x7) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[2]>>3&0x7)>>1 ^ -(int32(src[2]>>3&0x7) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[2]>>0&0x7)>>1 ^ -(int32(src[2]>>0&0x7) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum4BitDelta[T Integer](src *[4]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>28&0xf)>>1 ^ -(int32(src[0]>>28&0xf) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>24&0xf)>>1 ^ -(int32(src[0]>>24&0xf) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>20&0xf)>>1 ^ -(int32(src[0]>>20&0xf) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>16&0xf)>>1 ^ -(int32(src[0]>>16&0xf) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[0]>>12&0xf)>>1 ^ -(int32(src[0]>>12&0xf) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[0]>>8&0xf)>>1 ^ -(int32(src[0]>>8&0xf) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[0]>>4&0xf)>>1 ^ -(int32(src[0]>>4&0xf) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[0]>>0&0xf)>>1 ^ -(int32(src[0]>>0&0xf) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[1]>>28&0xf)>>1 ^ -(int32(src[1]>>28&0xf) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[1]>>24&0xf)>>1 ^ -(int32(src[1]>>24&0xf) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[1]>>20&0xf)>>1 ^ -(int32(src[1]>>20&0xf) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[1]>>16&0xf)>>1 ^ -(int32(src[1]>>16&0xf) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[1]>>12&0xf)>>1 ^ -(int32(src[1]>>12&0xf) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[1]>>8&0xf)>>1 ^ -(int32(src[1]>>8&0xf) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[1]>>4&0xf)>>1 ^ -(int32(src[1]>>4&0xf) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[1]>>0&0xf)>>1 ^ -(int32(src[1]>>0&0xf) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[2]>>28&0xf)>>1 ^ -(int32(src[2]>>28&0xf) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[2]>>24&0xf)>>1 ^ -(int32(src[2]>>24&0xf) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[2]>>20&0xf)>>1 ^ -(int32(src[2]>>20&0xf) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[2]>>16&0xf)>>1 ^ -(int32(src[2]>>16&0xf) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[2]>>12&0xf)>>1 ^ -(int32(src[2]>>12&0xf) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[2]>>8&0xf)>>1 ^ -(int32(src[2]>>8&0xf) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[2]>>4&0xf)>>1 ^ -(int32(src[2]>>4&0xf) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[2]>>0&0xf)>>1 ^ -(int32(src[2]>>0&0xf) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[3]>>28&0xf)>>1 ^ -(int32(src[3]>>28&0xf) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[3]>>24&0xf)>>1 ^ -(int32(src[3]>>24&0xf) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[3]>>20&0xf)>>1 ^ -(int32(src[3]>>20&0xf) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[3]>>16&0xf)>>1 ^ -(int32(src[3]>>16&0xf) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[3]>>12&0xf)>>1 ^ -(int32(src[3]>>12&0xf) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[3]>>8&0xf)>>1 ^ -(int32(src[3]>>8&0xf) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[3]>>4&0xf)>>1 ^ -(int32(src[3]>>4&0xf) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[3]>>0&0xf)>>1 ^ -(int32(src[3]>>0&0xf) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum5BitDelta[T Integer](src *[5]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>27&0x1f)>>1 ^ -(int32(src[0]>>27&0x1f) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>22&0x1f)>>1 ^ -(int32(src[0]>>22&0x1f) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>17&0x1f)>>1 ^ -(int32(src[0]>>17&0x1f) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>12&0x1f)>>1 ^ -(int32(src[0]>>12&0x1f) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[0]>>7&0x1f)>>1 ^ -(int32(src[0]>>7&0x1f) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[0]>>2&0x1f)>>1 ^ -(int32(src[0]>>2&0x1f) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[0]<<3&0x18)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x18)|src[1]>>29) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[1]>>24&0x1f)>>1 ^ -(int32(src[1]>>24&0x1f) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[1]>>19&0x1f)>>1 ^ -(int32(src[1]>>19&0x1f) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[1]>>14&0x1f)>>1 ^ -(int32(src[1]>>14&0x1f) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[1]>>9&0x1f)>>1 ^ -(int32(src[1]>>9&0x1f) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[1]>>4&0x1f)>>1 ^ -(int32(src[1]>>4&0x1f) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[1]<<1&0x1e)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1e)|src[2]>>31) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[2]>>26&0x1f)>>1 ^ -(int32(src[2]>>26&0x1f) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[2]>>21&0x1f)>>1 ^ -(int32(src[2]>>21&0x1f) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[2]>>16&0x1f)>>1 ^ -(int32(src[2]>>16&0x1f) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[2]>>11&0x1f)>>1 ^ -(int32(src[2]>>11&0x1f) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[2]>>6&0x1f)>>1 ^ -(int32(src[2]>>6&0x1f) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[2]>>1&0x1f)>>1 ^ -(int32(src[2]>>1&0x1f) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[2]<<4&0x10)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x10)|src[3]>>28) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[3]>>23&0x1f)>>1 ^ -(int32(src[3]>>23&0x1f) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[3]>>18&0x1f)>>1 ^ -(int32(src[3]>>18&0x1f) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[3]>>13&0x1f)>>1 ^ -(int32(src[3]>>13&0x1f) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[3]>>8&0x1f)>>1 ^ -(int32(src[3]>>8&0x1f) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[3]>>3&0x1f)>>1 ^ -(int32(src[3]>>3&0x1f) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[3]<<2&0x1c)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1c)|src[4]>>30) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[4]>>25&0x1f)>>1 ^ -(int32(src[4]>>25&0x1f) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[4]>>20&0x1f)>>1 ^ -(int32(src[4]>>20&0x1f) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[4]>>15&0x1f)>>1 ^ -(int32(src[4]>>15&0x1f) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[4]>>10&0x1f)>>1 ^ -(int32(src[4]>>10&0x1f) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[4]>>5&0x1f)>>1 ^ -(int32(src[4]>>5&0x1f) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[4]>>0&0x1f)>>1 ^ -(int32(src[4]>>0&0x1f) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum6BitDelta[T Integer](src *[6]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>26&0x3f)>>1 ^ -(int32(src[0]>>26&0x3f) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>20&0x3f)>>1 ^ -(int32(src[0]>>20&0x3f) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>14&0x3f)>>1 ^ -(int32(src[0]>>14&0x3f) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>8&0x3f)>>1 ^ -(int32(src[0]>>8&0x3f) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[0]>>2&0x3f)>>1 ^ -(int32(src[0]>>2&0x3f) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[0]<<4&0x30)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x30)|src[1]>>28) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[1]>>22&0x3f)>>1 ^ -(int32(src[1]>>22&0x3f) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[1]>>16&0x3f)>>1 ^ -(int32(src[1]>>16&0x3f) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[1]>>10&0x3f)>>1 ^ -(int32(src[1]>>10&0x3f) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[1]>>4&0x3f)>>1 ^ -(int32(src[1]>>4&0x3f) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[1]<<2&0x3c)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3c)|src[2]>>30) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[2]>>24&0x3f)>>1 ^ -(int32(src[2]>>24&0x3f) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[2]>>18&0x3f)>>1 ^ -(int32(src[2]>>18&0x3f) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[2]>>12&0x3f)>>1 ^ -(int32(src[2]>>12&0x3f) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[2]>>6&0x3f)>>1 ^ -(int32(src[2]>>6&0x3f) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[2]>>0&0x3f)>>1 ^ -(int32(src[2]>>0&0x3f) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[3]>>26&0x3f)>>1 ^ -(int32(src[3]>>26&0x3f) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[3]>>20&0x3f)>>1 ^ -(int32(src[3]>>20&0x3f) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[3]>>14&0x3f)>>1 ^ -(int32(src[3]>>14&0x3f) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[3]>>8&0x3f)>>1 ^ -(int32(src[3]>>8&0x3f) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[3]>>2&0x3f)>>1 ^ -(int32(src[3]>>2&0x3f) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[3]<<4&0x30)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x30)|src[4]>>28) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[4]>>22&0x3f)>>1 ^ -(int32(src[4]>>22&0x3f) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[4]>>16&0x3f)>>1 ^ -(int32(src[4]>>16&0x3f) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[4]>>10&0x3f)>>1 ^ -(int32(src[4]>>10&0x3f) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[4]>>4&0x3f)>>1 ^ -(int32(src[4]>>4&0x3f) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[4]<<2&0x3c)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3c)|src[5]>>30) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[5]>>24&0x3f)>>1 ^ -(int32(src[5]>>24&0x3f) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[5]>>18&0x3f)>>1 ^ -(int32(src[5]>>18&0x3f) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[5]>>12&0x3f)>>1 ^ -(int32(src[5]>>12&0x3f) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[5]>>6&0x3f)>>1 ^ -(int32(src[5]>>6&0x3f) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[5]>>0&0x3f)>>1 ^ -(int32(src[5]>>0&0x3f) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum7BitDelta[T Integer](src *[7]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>25&0x7f)>>1 ^ -(int32(src[0]>>25&0x7f) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>18&0x7f)>>1 ^ -(int32(src[0]>>18&0x7f) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>11&0x7f)>>1 ^ -(int32(src[0]>>11&0x7f) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>4&0x7f)>>1 ^ -(int32(src[0]>>4&0x7f) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[0]<<3&0x78)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x78)|src[1]>>29) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[1]>>22&0x7f)>>1 ^ -(int32(src[1]>>22&0x7f) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[1]>>15&0x7f)>>1 ^ -(int32(src[1]>>15&0x7f) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[1]>>8&0x7f)>>1 ^ -(int32(src[1]>>8&0x7f) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[1]>>1&0x7f)>>1 ^ -(int32(src[1]>>1&0x7f) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[1]<<6&0x40)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x40)|src[2]>>26) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[2]>>19&0x7f)>>1 ^ -(int32(src[2]>>19&0x7f) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[2]>>12&0x7f)>>1 ^ -(int32(src[2]>>12&0x7f) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[2]>>5&0x7f)>>1 ^ -(int32(src[2]>>5&0x7f) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[2]<<2&0x7c)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x7c)|src[3]>>30) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[3]>>23&0x7f)>>1 ^ -(int32(src[3]>>23&0x7f) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[3]>>16&0x7f)>>1 ^ -(int32(src[3]>>16&0x7f) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[3]>>9&0x7f)>>1 ^ -(int32(src[3]>>9&0x7f) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[3]>>2&0x7f)>>1 ^ -(int32(src[3]>>2&0x7f) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[3]<<5&0x60)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x60)|src[4]>>27) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[4]>>20&0x7f)>>1 ^ -(int32(src[4]>>20&0x7f) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[4]>>13&0x7f)>>1 ^ -(int32(src[4]>>13&0x7f) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[4]>>6&0x7f)>>1 ^ -(int32(src[4]>>6&0x7f) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[4]<<1&0x7e)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7e)|src[5]>>31) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[5]>>24&0x7f)>>1 ^ -(int32(src[5]>>24&0x7f) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[5]>>17&0x7f)>>1 ^ -(int32(src[5]>>17&0x7f) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[5]>>10&0x7f)>>1 ^ -(int32(src[5]>>10&0x7f) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[5]>>3&0x7f)>>1 ^ -(int32(src[5]>>3&0x7f) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[5]<<4&0x70)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x70)|src[6]>>28) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[6]>>21&0x7f)>>1 ^ -(int32(src[6]>>21&0x7f) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[6]>>14&0x7f)>>1 ^ -(int32(src[6]>>14&0x7f) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[6]>>7&0x7f)>>1 ^ -(int32(src[6]>>7&0x7f) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[6]>>0&0x7f)>>1 ^ -(int32(src[6]>>0&0x7f) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum8BitDelta[T Integer](src *[8]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>24&0xff)>>1 ^ -(int32(src[0]>>24&0xff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>16&0xff)>>1 ^ -(int32(src[0]>>16&0xff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>8&0xff)>>1 ^ -(int32(src[0]>>8&0xff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[0]>>0&0xff)>>1 ^ -(int32(src[0]>>0&0xff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[1]>>24&0xff)>>1 ^ -(int32(src[1]>>24&0xff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[1]>>16&0xff)>>1 ^ -(int32(src[1]>>16&0xff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[1]>>8&0xff)>>1 ^ -(int32(src[1]>>8&0xff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[1]>>0&0xff)>>1 ^ -(int32(src[1]>>0&0xff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[2]>>24&0xff)>>1 ^ -(int32(src[2]>>24&0xff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[2]>>16&0xff)>>1 ^ -(int32(src[2]>>16&0xff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[2]>>8&0xff)>>1 ^ -(int32(src[2]>>8&0xff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[2]>>0&0xff)>>1 ^ -(int32(src[2]>>0&0xff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[3]>>24&0xff)>>1 ^ -(int32(src[3]>>24&0xff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[3]>>16&0xff)>>1 ^ -(int32(src[3]>>16&0xff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[3]>>8&0xff)>>1 ^ -(int32(src[3]>>8&0xff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[3]>>0&0xff)>>1 ^ -(int32(src[3]>>0&0xff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[4]>>24&0xff)>>1 ^ -(int32(src[4]>>24&0xff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[4]>>16&0xff)>>1 ^ -(int32(src[4]>>16&0xff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[4]>>8&0xff)>>1 ^ -(int32(src[4]>>8&0xff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[4]>>0&0xff)>>1 ^ -(int32(src[4]>>0&0xff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[5]>>24&0xff)>>1 ^ -(int32(src[5]>>24&0xff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[5]>>16&0xff)>>1 ^ -(int32(src[5]>>16&0xff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[5]>>8&0xff)>>1 ^ -(int32(src[5]>>8&0xff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[5]>>0&0xff)>>1 ^ -(int32(src[5]>>0&0xff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[6]>>24&0xff)>>1 ^ -(int32(src[6]>>24&0xff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[6]>>16&0xff)>>1 ^ -(int32(src[6]>>16&0xff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[6]>>8&0xff)>>1 ^ -(int32(src[6]>>8&0xff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[6]>>0&0xff)>>1 ^ -(int32(src[6]>>0&0xff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[7]>>24&0xff)>>1 ^ -(int32(src[7]>>24&0xff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[7]>>16&0xff)>>1 ^ -(int32(src[7]>>16&0xff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[7]>>8&0xff)>>1 ^ -(int32(src[7]>>8&0xff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[7]>>0&0xff)>>1 ^ -(int32(src[7]>>0&0xff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum9BitDelta[T Integer](src *[9]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>23&0x1ff)>>1 ^ -(int32(src[0]>>23&0x1ff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>14&0x1ff)>>1 ^ -(int32(src[0]>>14&0x1ff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>5&0x1ff)>>1 ^ -(int32(src[0]>>5&0x1ff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[0]<<4&0x1f0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x1f0)|src[1]>>28) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[1]>>19&0x1ff)>>1 ^ -(int32(src[1]>>19&0x1ff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[1]>>10&0x1ff)>>1 ^ -(int32(src[1]>>10&0x1ff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[1]>>1&0x1ff)>>1 ^ -(int32(src[1]>>1&0x1ff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[1]<<8&0x100)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x100)|src[2]>>24) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[2]>>15&0x1ff)>>1 ^ -(int32(src[2]>>15&0x1ff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[2]>>6&0x1ff)>>1 ^ -(int32(src[2]>>6&0x1ff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[2]<<3&0x1f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x1f8)|src[3]>>29) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[3]>>20&0x1ff)>>1 ^ -(int32(src[3]>>20&0x1ff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[3]>>11&0x1ff)>>1 ^ -(int32(src[3]>>11&0x1ff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[3]>>2&0x1ff)>>1 ^ -(int32(src[3]>>2&0x1ff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[3]<<7&0x180)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x180)|src[4]>>25) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[4]>>16&0x1ff)>>1 ^ -(int32(src[4]>>16&0x1ff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[4]>>7&0x1ff)>>1 ^ -(int32(src[4]>>7&0x1ff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[4]<<2&0x1fc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x1fc)|src[5]>>30) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[5]>>21&0x1ff)>>1 ^ -(int32(src[5]>>21&0x1ff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[5]>>12&0x1ff)>>1 ^ -(int32(src[5]>>12&0x1ff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[5]>>3&0x1ff)>>1 ^ -(int32(src[5]>>3&0x1ff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[5]<<6&0x1c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x1c0)|src[6]>>26) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[6]>>17&0x1ff)>>1 ^ -(int32(src[6]>>17&0x1ff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[6]>>8&0x1ff)>>1 ^ -(int32(src[6]>>8&0x1ff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[6]<<1&0x1fe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fe)|src[7]>>31) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[7]>>22&0x1ff)>>1 ^ -(int32(src[7]>>22&0x1ff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[7]>>13&0x1ff)>>1 ^ -(int32(src[7]>>13&0x1ff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[7]>>4&0x1ff)>>1 ^ -(int32(src[7]>>4&0x1ff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[7]<<5&0x1e0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1e0)|src[8]>>27) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[8]>>18&0x1ff)>>1 ^ -(int32(src[8]>>18&0x1ff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[8]>>9&0x1ff)>>1 ^ -(int32(src[8]>>9&0x1ff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[8]>>0&0x1ff)>>1 ^ -(int32(src[8]>>0&0x1ff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum10BitDelta[T Integer](src *[10]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>22&0x3ff)>>1 ^ -(int32(src[0]>>22&0x3ff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>12&0x3ff)>>1 ^ -(int32(src[0]>>12&0x3ff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[0]>>2&0x3ff)>>1 ^ -(int32(src[0]>>2&0x3ff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[0]<<8&0x300)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0x300)|src[1]>>24) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[1]>>14&0x3ff)>>1 ^ -(int32(src[1]>>14&0x3ff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[1]>>4&0x3ff)>>1 ^ -(int32(src[1]>>4&0x3ff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[1]<<6&0x3c0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3c0)|src[2]>>26) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[2]>>16&0x3ff)>>1 ^ -(int32(src[2]>>16&0x3ff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[2]>>6&0x3ff)>>1 ^ -(int32(src[2]>>6&0x3ff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[2]<<4&0x3f0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x3f0)|src[3]>>28) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[3]>>18&0x3ff)>>1 ^ -(int32(src[3]>>18&0x3ff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[3]>>8&0x3ff)>>1 ^ -(int32(src[3]>>8&0x3ff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[3]<<2&0x3fc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fc)|src[4]>>30) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[4]>>20&0x3ff)>>1 ^ -(int32(src[4]>>20&0x3ff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[4]>>10&0x3ff)>>1 ^ -(int32(src[4]>>10&0x3ff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[4]>>0&0x3ff)>>1 ^ -(int32(src[4]>>0&0x3ff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[5]>>22&0x3ff)>>1 ^ -(int32(src[5]>>22&0x3ff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[5]>>12&0x3ff)>>1 ^ -(int32(src[5]>>12&0x3ff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[5]>>2&0x3ff)>>1 ^ -(int32(src[5]>>2&0x3ff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[5]<<8&0x300)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x300)|src[6]>>24) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[6]>>14&0x3ff)>>1 ^ -(int32(src[6]>>14&0x3ff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[6]>>4&0x3ff)>>1 ^ -(int32(src[6]>>4&0x3ff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[6]<<6&0x3c0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x3c0)|src[7]>>26) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[7]>>16&0x3ff)>>1 ^ -(int32(src[7]>>16&0x3ff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[7]>>6&0x3ff)>>1 ^ -(int32(src[7]>>6&0x3ff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[7]<<4&0x3f0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3f0)|src[8]>>28) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[8]>>18&0x3ff)>>1 ^ -(int32(src[8]>>18&0x3ff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[8]>>8&0x3ff)>>1 ^ -(int32(src[8]>>8&0x3ff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[8]<<2&0x3fc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x3fc)|src[9]>>30) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[9]>>20&0x3ff)>>1 ^ -(int32(src[9]>>20&0x3ff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[9]>>10&0x3ff)>>1 ^ -(int32(src[9]>>10&0x3ff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[9]>>0&0x3ff)>>1 ^ -(int32(src[9]>>0&0x3ff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum11BitDelta[T Integer](src *[11]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>21&0x7ff)>>1 ^ -(int32(src[0]>>21&0x7ff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>10&0x7ff)>>1 ^ -(int32(src[0]>>10&0x7ff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[0]<<1&0x7fe)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x7fe)|src[1]>>31) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[1]>>20&0x7ff)>>1 ^ -(int32(src[1]>>20&0x7ff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[1]>>9&0x7ff)>>1 ^ -(int32(src[1]>>9&0x7ff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[1]<<2&0x7fc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x7fc)|src[2]>>30) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[2]>>19&0x7ff)>>1 ^ -(int32(src[2]>>19&0x7ff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[2]>>8&0x7ff)>>1 ^ -(int32(src[2]>>8&0x7ff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[2]<<3&0x7f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x7f8)|src[3]>>29) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[3]>>18&0x7ff)>>1 ^ -(int32(src[3]>>18&0x7ff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[3]>>7&0x7ff)>>1 ^ -(int32(src[3]>>7&0x7ff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[3]<<4&0x7f0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x7f0)|src[4]>>28) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[4]>>17&0x7ff)>>1 ^ -(int32(src[4]>>17&0x7ff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[4]>>6&0x7ff)>>1 ^ -(int32(src[4]>>6&0x7ff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[4]<<5&0x7e0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7e0)|src[5]>>27) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[5]>>16&0x7ff)>>1 ^ -(int32(src[5]>>16&0x7ff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[5]>>5&0x7ff)>>1 ^ -(int32(src[5]>>5&0x7ff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[5]<<6&0x7c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x7c0)|src[6]>>26) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[6]>>15&0x7ff)>>1 ^ -(int32(src[6]>>15&0x7ff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[6]>>4&0x7ff)>>1 ^ -(int32(src[6]>>4&0x7ff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[6]<<7&0x780)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x780)|src[7]>>25) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[7]>>14&0x7ff)>>1 ^ -(int32(src[7]>>14&0x7ff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[7]>>3&0x7ff)>>1 ^ -(int32(src[7]>>3&0x7ff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[7]<<8&0x700)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x700)|src[8]>>24) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[8]>>13&0x7ff)>>1 ^ -(int32(src[8]>>13&0x7ff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[8]>>2&0x7ff)>>1 ^ -(int32(src[8]>>2&0x7ff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[8]<<9&0x600)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x600)|src[9]>>23) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[9]>>12&0x7ff)>>1 ^ -(int32(src[9]>>12&0x7ff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[9]>>1&0x7ff)>>1 ^ -(int32(src[9]>>1&0x7ff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[9]<<10&0x400)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x400)|src[10]>>22) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[10]>>11&0x7ff)>>1 ^ -(int32(src[10]>>11&0x7ff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[10]>>0&0x7ff)>>1 ^ -(int32(src[10]>>0&0x7ff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum12BitDelta[T Integer](src *[12]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>20&0xfff)>>1 ^ -(int32(src[0]>>20&0xfff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>8&0xfff)>>1 ^ -(int32(src[0]>>8&0xfff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[0]<<4&0xff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0xff0)|src[1]>>28) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[1]>>16&0xfff)>>1 ^ -(int32(src[1]>>16&0xfff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[1]>>4&0xfff)>>1 ^ -(int32(src[1]>>4&0xfff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[1]<<8&0xf00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xf00)|src[2]>>24) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[2]>>12&0xfff)>>1 ^ -(int32(src[2]>>12&0xfff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[2]>>0&0xfff)>>1 ^ -(int32(src[2]>>0&0xfff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[3]>>20&0xfff)>>1 ^ -(int32(src[3]>>20&0xfff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[3]>>8&0xfff)>>1 ^ -(int32(src[3]>>8&0xfff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[3]<<4&0xff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0xff0)|src[4]>>28) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[4]>>16&0xfff)>>1 ^ -(int32(src[4]>>16&0xfff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[4]>>4&0xfff)>>1 ^ -(int32(src[4]>>4&0xfff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[4]<<8&0xf00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xf00)|src[5]>>24) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[5]>>12&0xfff)>>1 ^ -(int32(src[5]>>12&0xfff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[5]>>0&0xfff)>>1 ^ -(int32(src[5]>>0&0xfff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[6]>>20&0xfff)>>1 ^ -(int32(src[6]>>20&0xfff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[6]>>8&0xfff)>>1 ^ -(int32(src[6]>>8&0xfff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[6]<<4&0xff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0xff0)|src[7]>>28) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[7]>>16&0xfff)>>1 ^ -(int32(src[7]>>16&0xfff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[7]>>4&0xfff)>>1 ^ -(int32(src[7]>>4&0xfff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[7]<<8&0xf00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xf00)|src[8]>>24) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[8]>>12&0xfff)>>1 ^ -(int32(src[8]>>12&0xfff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[8]>>0&0xfff)>>1 ^ -(int32(src[8]>>0&0xfff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[9]>>20&0xfff)>>1 ^ -(int32(src[9]>>20&0xfff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[9]>>8&0xfff)>>1 ^ -(int32(src[9]>>8&0xfff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[9]<<4&0xff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0xff0)|src[10]>>28) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[10]>>16&0xfff)>>1 ^ -(int32(src[10]>>16&0xfff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[10]>>4&0xfff)>>1 ^ -(int32(src[10]>>4&0xfff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[10]<<8&0xf00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xf00)|src[11]>>24) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[11]>>12&0xfff)>>1 ^ -(int32(src[11]>>12&0xfff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[11]>>0&0xfff)>>1 ^ -(int32(src[11]>>0&0xfff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum13BitDelta[T Integer](src *[13]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>19&0x1fff)>>1 ^ -(int32(src[0]>>19&0x1fff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>6&0x1fff)>>1 ^ -(int32(src[0]>>6&0x1fff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[0]<<7&0x1f80)|src[1]>>25)>>1 ^ -(int32((src[0]<<7&0x1f80)|src[1]>>25) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[1]>>12&0x1fff)>>1 ^ -(int32(src[1]>>12&0x1fff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[1]<<1&0x1ffe)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1ffe)|src[2]>>31) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[2]>>18&0x1fff)>>1 ^ -(int32(src[2]>>18&0x1fff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[2]>>5&0x1fff)>>1 ^ -(int32(src[2]>>5&0x1fff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[2]<<8&0x1f00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x1f00)|src[3]>>24) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[3]>>11&0x1fff)>>1 ^ -(int32(src[3]>>11&0x1fff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[3]<<2&0x1ffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1ffc)|src[4]>>30) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[4]>>17&0x1fff)>>1 ^ -(int32(src[4]>>17&0x1fff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[4]>>4&0x1fff)>>1 ^ -(int32(src[4]>>4&0x1fff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[4]<<9&0x1e00)|src[5]>>23)>>1 ^ -(int32((src[4]<<9&0x1e00)|src[5]>>23) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[5]>>10&0x1fff)>>1 ^ -(int32(src[5]>>10&0x1fff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[5]<<3&0x1ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x1ff8)|src[6]>>29) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[6]>>16&0x1fff)>>1 ^ -(int32(src[6]>>16&0x1fff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[6]>>3&0x1fff)>>1 ^ -(int32(src[6]>>3&0x1fff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[6]<<10&0x1c00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x1c00)|src[7]>>22) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[7]>>9&0x1fff)>>1 ^ -(int32(src[7]>>9&0x1fff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[7]<<4&0x1ff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x1ff0)|src[8]>>28) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[8]>>15&0x1fff)>>1 ^ -(int32(src[8]>>15&0x1fff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[8]>>2&0x1fff)>>1 ^ -(int32(src[8]>>2&0x1fff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[8]<<11&0x1800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x1800)|src[9]>>21) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[9]>>8&0x1fff)>>1 ^ -(int32(src[9]>>8&0x1fff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[9]<<5&0x1fe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1fe0)|src[10]>>27) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[10]>>14&0x1fff)>>1 ^ -(int32(src[10]>>14&0x1fff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[10]>>1&0x1fff)>>1 ^ -(int32(src[10]>>1&0x1fff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[10]<<12&0x1000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x1000)|src[11]>>20) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[11]>>7&0x1fff)>>1 ^ -(int32(src[11]>>7&0x1fff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[11]<<6&0x1fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x1fc0)|src[12]>>26) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[12]>>13&0x1fff)>>1 ^ -(int32(src[12]>>13&0x1fff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[12]>>0&0x1fff)>>1 ^ -(int32(src[12]>>0&0x1fff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum14BitDelta[T Integer](src *[14]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>18&0x3fff)>>1 ^ -(int32(src[0]>>18&0x3fff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>4&0x3fff)>>1 ^ -(int32(src[0]>>4&0x3fff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[0]<<10&0x3c00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x3c00)|src[1]>>22) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[1]>>8&0x3fff)>>1 ^ -(int32(src[1]>>8&0x3fff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[1]<<6&0x3fc0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3fc0)|src[2]>>26) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[2]>>12&0x3fff)>>1 ^ -(int32(src[2]>>12&0x3fff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[2]<<2&0x3ffc)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x3ffc)|src[3]>>30) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[3]>>16&0x3fff)>>1 ^ -(int32(src[3]>>16&0x3fff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[3]>>2&0x3fff)>>1 ^ -(int32(src[3]>>2&0x3fff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[3]<<12&0x3000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0x3000)|src[4]>>20) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[4]>>6&0x3fff)>>1 ^ -(int32(src[4]>>6&0x3fff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[4]<<8&0x3f00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x3f00)|src[5]>>24) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[5]>>10&0x3fff)>>1 ^ -(int32(src[5]>>10&0x3fff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[5]<<4&0x3ff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x3ff0)|src[6]>>28) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[6]>>14&0x3fff)>>1 ^ -(int32(src[6]>>14&0x3fff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[6]>>0&0x3fff)>>1 ^ -(int32(src[6]>>0&0x3fff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[7]>>18&0x3fff)>>1 ^ -(int32(src[7]>>18&0x3fff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[7]>>4&0x3fff)>>1 ^ -(int32(src[7]>>4&0x3fff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[7]<<10&0x3c00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x3c00)|src[8]>>22) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[8]>>8&0x3fff)>>1 ^ -(int32(src[8]>>8&0x3fff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[8]<<6&0x3fc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x3fc0)|src[9]>>26) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[9]>>12&0x3fff)>>1 ^ -(int32(src[9]>>12&0x3fff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[9]<<2&0x3ffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x3ffc)|src[10]>>30) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[10]>>16&0x3fff)>>1 ^ -(int32(src[10]>>16&0x3fff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[10]>>2&0x3fff)>>1 ^ -(int32(src[10]>>2&0x3fff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[10]<<12&0x3000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3000)|src[11]>>20) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[11]>>6&0x3fff)>>1 ^ -(int32(src[11]>>6&0x3fff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[11]<<8&0x3f00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0x3f00)|src[12]>>24) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[12]>>10&0x3fff)>>1 ^ -(int32(src[12]>>10&0x3fff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[12]<<4&0x3ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ff0)|src[13]>>28) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[13]>>14&0x3fff)>>1 ^ -(int32(src[13]>>14&0x3fff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[13]>>0&0x3fff)>>1 ^ -(int32(src[13]>>0&0x3fff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum15BitDelta[T Integer](src *[15]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>17&0x7fff)>>1 ^ -(int32(src[0]>>17&0x7fff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>2&0x7fff)>>1 ^ -(int32(src[0]>>2&0x7fff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[0]<<13&0x6000)|src[1]>>19)>>1 ^ -(int32((src[0]<<13&0x6000)|src[1]>>19) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[1]>>4&0x7fff)>>1 ^ -(int32(src[1]>>4&0x7fff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[1]<<11&0x7800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x7800)|src[2]>>21) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[2]>>6&0x7fff)>>1 ^ -(int32(src[2]>>6&0x7fff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[2]<<9&0x7e00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x7e00)|src[3]>>23) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[3]>>8&0x7fff)>>1 ^ -(int32(src[3]>>8&0x7fff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[3]<<7&0x7f80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7f80)|src[4]>>25) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[4]>>10&0x7fff)>>1 ^ -(int32(src[4]>>10&0x7fff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[4]<<5&0x7fe0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7fe0)|src[5]>>27) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[5]>>12&0x7fff)>>1 ^ -(int32(src[5]>>12&0x7fff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[5]<<3&0x7ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x7ff8)|src[6]>>29) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[6]>>14&0x7fff)>>1 ^ -(int32(src[6]>>14&0x7fff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[6]<<1&0x7ffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x7ffe)|src[7]>>31) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[7]>>16&0x7fff)>>1 ^ -(int32(src[7]>>16&0x7fff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[7]>>1&0x7fff)>>1 ^ -(int32(src[7]>>1&0x7fff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[7]<<14&0x4000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x4000)|src[8]>>18) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[8]>>3&0x7fff)>>1 ^ -(int32(src[8]>>3&0x7fff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[8]<<12&0x7000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x7000)|src[9]>>20) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[9]>>5&0x7fff)>>1 ^ -(int32(src[9]>>5&0x7fff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[9]<<10&0x7c00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x7c00)|src[10]>>22) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[10]>>7&0x7fff)>>1 ^ -(int32(src[10]>>7&0x7fff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[10]<<8&0x7f00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x7f00)|src[11]>>24) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[11]>>9&0x7fff)>>1 ^ -(int32(src[11]>>9&0x7fff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[11]<<6&0x7fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x7fc0)|src[12]>>26) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[12]>>11&0x7fff)>>1 ^ -(int32(src[12]>>11&0x7fff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[12]<<4&0x7ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x7ff0)|src[13]>>28) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[13]>>13&0x7fff)>>1 ^ -(int32(src[13]>>13&0x7fff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[13]<<2&0x7ffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x7ffc)|src[14]>>30) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[14]>>15&0x7fff)>>1 ^ -(int32(src[14]>>15&0x7fff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[14]>>0&0x7fff)>>1 ^ -(int32(src[14]>>0&0x7fff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum16BitDelta[T Integer](src *[16]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>16&0xffff)>>1 ^ -(int32(src[0]>>16&0xffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32(src[0]>>0&0xffff)>>1 ^ -(int32(src[0]>>0&0xffff) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[1]>>16&0xffff)>>1 ^ -(int32(src[1]>>16&0xffff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[1]>>0&0xffff)>>1 ^ -(int32(src[1]>>0&0xffff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[2]>>16&0xffff)>>1 ^ -(int32(src[2]>>16&0xffff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[2]>>0&0xffff)>>1 ^ -(int32(src[2]>>0&0xffff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[3]>>16&0xffff)>>1 ^ -(int32(src[3]>>16&0xffff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[3]>>0&0xffff)>>1 ^ -(int32(src[3]>>0&0xffff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[4]>>16&0xffff)>>1 ^ -(int32(src[4]>>16&0xffff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[4]>>0&0xffff)>>1 ^ -(int32(src[4]>>0&0xffff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[5]>>16&0xffff)>>1 ^ -(int32(src[5]>>16&0xffff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[5]>>0&0xffff)>>1 ^ -(int32(src[5]>>0&0xffff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[6]>>16&0xffff)>>1 ^ -(int32(src[6]>>16&0xffff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[6]>>0&0xffff)>>1 ^ -(int32(src[6]>>0&0xffff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[7]>>16&0xffff)>>1 ^ -(int32(src[7]>>16&0xffff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[7]>>0&0xffff)>>1 ^ -(int32(src[7]>>0&0xffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[8]>>16&0xffff)>>1 ^ -(int32(src[8]>>16&0xffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[8]>>0&0xffff)>>1 ^ -(int32(src[8]>>0&0xffff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[9]>>16&0xffff)>>1 ^ -(int32(src[9]>>16&0xffff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[9]>>0&0xffff)>>1 ^ -(int32(src[9]>>0&0xffff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[10]>>16&0xffff)>>1 ^ -(int32(src[10]>>16&0xffff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[10]>>0&0xffff)>>1 ^ -(int32(src[10]>>0&0xffff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[11]>>16&0xffff)>>1 ^ -(int32(src[11]>>16&0xffff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[11]>>0&0xffff)>>1 ^ -(int32(src[11]>>0&0xffff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[12]>>16&0xffff)>>1 ^ -(int32(src[12]>>16&0xffff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[12]>>0&0xffff)>>1 ^ -(int32(src[12]>>0&0xffff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[13]>>16&0xffff)>>1 ^ -(int32(src[13]>>16&0xffff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[13]>>0&0xffff)>>1 ^ -(int32(src[13]>>0&0xffff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[14]>>16&0xffff)>>1 ^ -(int32(src[14]>>16&0xffff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[14]>>0&0xffff)>>1 ^ -(int32(src[14]>>0&0xffff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32(src[15]>>16&0xffff)>>1 ^ -(int32(src[15]>>16&0xffff) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[15]>>0&0xffff)>>1 ^ -(int32(src[15]>>0&0xffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum17BitDelta[T Integer](src *[17]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>15&0x1ffff)>>1 ^ -(int32(src[0]>>15&0x1ffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<2&0x1fffc)|src[1]>>30)>>1 ^ -(int32((src[0]<<2&0x1fffc)|src[1]>>30) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[1]>>13&0x1ffff)>>1 ^ -(int32(src[1]>>13&0x1ffff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[1]<<4&0x1fff0)|src[2]>>28)>>1 ^ -(int32((src[1]<<4&0x1fff0)|src[2]>>28) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[2]>>11&0x1ffff)>>1 ^ -(int32(src[2]>>11&0x1ffff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[2]<<6&0x1ffc0)|src[3]>>26)>>1 ^ -(int32((src[2]<<6&0x1ffc0)|src[3]>>26) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[3]>>9&0x1ffff)>>1 ^ -(int32(src[3]>>9&0x1ffff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[3]<<8&0x1ff00)|src[4]>>24)>>1 ^ -(int32((src[3]<<8&0x1ff00)|src[4]>>24) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[4]>>7&0x1ffff)>>1 ^ -(int32(src[4]>>7&0x1ffff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[4]<<10&0x1fc00)|src[5]>>22)>>1 ^ -(int32((src[4]<<10&0x1fc00)|src[5]>>22) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[5]>>5&0x1ffff)>>1 ^ -(int32(src[5]>>5&0x1ffff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[5]<<12&0x1f000)|src[6]>>20)>>1 ^ -(int32((src[5]<<12&0x1f000)|src[6]>>20) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[6]>>3&0x1ffff)>>1 ^ -(int32(src[6]>>3&0x1ffff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[6]<<14&0x1c000)|src[7]>>18)>>1 ^ -(int32((src[6]<<14&0x1c000)|src[7]>>18) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[7]>>1&0x1ffff)>>1 ^ -(int32(src[7]>>1&0x1ffff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[7]<<16&0x10000)|src[8]>>16)>>1 ^ -(int32((src[7]<<16&0x10000)|src[8]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[8]<<1&0x1fffe)|src[9]>>31)>>1 ^ -(int32((src[8]<<1&0x1fffe)|src[9]>>31) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[9]>>14&0x1ffff)>>1 ^ -(int32(src[9]>>14&0x1ffff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[9]<<3&0x1fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x1fff8)|src[10]>>29) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[10]>>12&0x1ffff)>>1 ^ -(int32(src[10]>>12&0x1ffff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[10]<<5&0x1ffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1ffe0)|src[11]>>27) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[11]>>10&0x1ffff)>>1 ^ -(int32(src[11]>>10&0x1ffff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[11]<<7&0x1ff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x1ff80)|src[12]>>25) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[12]>>8&0x1ffff)>>1 ^ -(int32(src[12]>>8&0x1ffff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[12]<<9&0x1fe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fe00)|src[13]>>23) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[13]>>6&0x1ffff)>>1 ^ -(int32(src[13]>>6&0x1ffff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[13]<<11&0x1f800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x1f800)|src[14]>>21) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[14]>>4&0x1ffff)>>1 ^ -(int32(src[14]>>4&0x1ffff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[14]<<13&0x1e000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1e000)|src[15]>>19) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[15]>>2&0x1ffff)>>1 ^ -(int32(src[15]>>2&0x1ffff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[15]<<15&0x18000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x18000)|src[16]>>17) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[16]>>0&0x1ffff)>>1 ^ -(int32(src[16]>>0&0x1ffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum18BitDelta[T Integer](src *[18]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>14&0x3ffff)>>1 ^ -(int32(src[0]>>14&0x3ffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<4&0x3fff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x3fff0)|src[1]>>28) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[1]>>10&0x3ffff)>>1 ^ -(int32(src[1]>>10&0x3ffff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[1]<<8&0x3ff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x3ff00)|src[2]>>24) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[2]>>6&0x3ffff)>>1 ^ -(int32(src[2]>>6&0x3ffff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[2]<<12&0x3f000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x3f000)|src[3]>>20) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[3]>>2&0x3ffff)>>1 ^ -(int32(src[3]>>2&0x3ffff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[3]<<16&0x30000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0x30000)|src[4]>>16) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[4]<<2&0x3fffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3fffc)|src[5]>>30) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[5]>>12&0x3ffff)>>1 ^ -(int32(src[5]>>12&0x3ffff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[5]<<6&0x3ffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3ffc0)|src[6]>>26) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[6]>>8&0x3ffff)>>1 ^ -(int32(src[6]>>8&0x3ffff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[6]<<10&0x3fc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fc00)|src[7]>>22) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[7]>>4&0x3ffff)>>1 ^ -(int32(src[7]>>4&0x3ffff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[7]<<14&0x3c000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3c000)|src[8]>>18) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[8]>>0&0x3ffff)>>1 ^ -(int32(src[8]>>0&0x3ffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[9]>>14&0x3ffff)>>1 ^ -(int32(src[9]>>14&0x3ffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[9]<<4&0x3fff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x3fff0)|src[10]>>28) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[10]>>10&0x3ffff)>>1 ^ -(int32(src[10]>>10&0x3ffff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[10]<<8&0x3ff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3ff00)|src[11]>>24) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[11]>>6&0x3ffff)>>1 ^ -(int32(src[11]>>6&0x3ffff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[11]<<12&0x3f000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3f000)|src[12]>>20) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[12]>>2&0x3ffff)>>1 ^ -(int32(src[12]>>2&0x3ffff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[12]<<16&0x30000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x30000)|src[13]>>16) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[13]<<2&0x3fffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3fffc)|src[14]>>30) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[14]>>12&0x3ffff)>>1 ^ -(int32(src[14]>>12&0x3ffff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[14]<<6&0x3ffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x3ffc0)|src[15]>>26) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[15]>>8&0x3ffff)>>1 ^ -(int32(src[15]>>8&0x3ffff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[15]<<10&0x3fc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x3fc00)|src[16]>>22) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[16]>>4&0x3ffff)>>1 ^ -(int32(src[16]>>4&0x3ffff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[16]<<14&0x3c000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x3c000)|src[17]>>18) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[17]>>0&0x3ffff)>>1 ^ -(int32(src[17]>>0&0x3ffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum19BitDelta[T Integer](src *[19]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>13&0x7ffff)>>1 ^ -(int32(src[0]>>13&0x7ffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<6&0x7ffc0)|src[1]>>26)>>1 ^ -(int32((src[0]<<6&0x7ffc0)|src[1]>>26) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[1]>>7&0x7ffff)>>1 ^ -(int32(src[1]>>7&0x7ffff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[1]<<12&0x7f000)|src[2]>>20)>>1 ^ -(int32((src[1]<<12&0x7f000)|src[2]>>20) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[2]>>1&0x7ffff)>>1 ^ -(int32(src[2]>>1&0x7ffff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[2]<<18&0x40000)|src[3]>>14)>>1 ^ -(int32((src[2]<<18&0x40000)|src[3]>>14) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[3]<<5&0x7ffe0)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x7ffe0)|src[4]>>27) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[4]>>8&0x7ffff)>>1 ^ -(int32(src[4]>>8&0x7ffff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[4]<<11&0x7f800)|src[5]>>21)>>1 ^ -(int32((src[4]<<11&0x7f800)|src[5]>>21) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[5]>>2&0x7ffff)>>1 ^ -(int32(src[5]>>2&0x7ffff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[5]<<17&0x60000)|src[6]>>15)>>1 ^ -(int32((src[5]<<17&0x60000)|src[6]>>15) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[6]<<4&0x7fff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0x7fff0)|src[7]>>28) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[7]>>9&0x7ffff)>>1 ^ -(int32(src[7]>>9&0x7ffff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[7]<<10&0x7fc00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x7fc00)|src[8]>>22) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[8]>>3&0x7ffff)>>1 ^ -(int32(src[8]>>3&0x7ffff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[8]<<16&0x70000)|src[9]>>16)>>1 ^ -(int32((src[8]<<16&0x70000)|src[9]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[9]<<3&0x7fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x7fff8)|src[10]>>29) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[10]>>10&0x7ffff)>>1 ^ -(int32(src[10]>>10&0x7ffff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[10]<<9&0x7fe00)|src[11]>>23)>>1 ^ -(int32((src[10]<<9&0x7fe00)|src[11]>>23) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[11]>>4&0x7ffff)>>1 ^ -(int32(src[11]>>4&0x7ffff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[11]<<15&0x78000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x78000)|src[12]>>17) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[12]<<2&0x7fffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x7fffc)|src[13]>>30) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[13]>>11&0x7ffff)>>1 ^ -(int32(src[13]>>11&0x7ffff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[13]<<8&0x7ff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0x7ff00)|src[14]>>24) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[14]>>5&0x7ffff)>>1 ^ -(int32(src[14]>>5&0x7ffff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[14]<<14&0x7c000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x7c000)|src[15]>>18) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[15]<<1&0x7fffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffe)|src[16]>>31) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[16]>>12&0x7ffff)>>1 ^ -(int32(src[16]>>12&0x7ffff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[16]<<7&0x7ff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x7ff80)|src[17]>>25) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[17]>>6&0x7ffff)>>1 ^ -(int32(src[17]>>6&0x7ffff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[17]<<13&0x7e000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7e000)|src[18]>>19) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[18]>>0&0x7ffff)>>1 ^ -(int32(src[18]>>0&0x7ffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum20BitDelta[T Integer](src *[20]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>12&0xfffff)>>1 ^ -(int32(src[0]>>12&0xfffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<8&0xfff00)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0xfff00)|src[1]>>24) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[1]>>4&0xfffff)>>1 ^ -(int32(src[1]>>4&0xfffff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[1]<<16&0xf0000)|src[2]>>16)>>1 ^ -(int32((src[1]<<16&0xf0000)|src[2]>>16) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[2]<<4&0xffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0xffff0)|src[3]>>28) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[3]>>8&0xfffff)>>1 ^ -(int32(src[3]>>8&0xfffff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[3]<<12&0xff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xff000)|src[4]>>20) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[4]>>0&0xfffff)>>1 ^ -(int32(src[4]>>0&0xfffff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[5]>>12&0xfffff)>>1 ^ -(int32(src[5]>>12&0xfffff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[5]<<8&0xfff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0xfff00)|src[6]>>24) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[6]>>4&0xfffff)>>1 ^ -(int32(src[6]>>4&0xfffff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[6]<<16&0xf0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xf0000)|src[7]>>16) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[7]<<4&0xffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0xffff0)|src[8]>>28) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[8]>>8&0xfffff)>>1 ^ -(int32(src[8]>>8&0xfffff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[8]<<12&0xff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0xff000)|src[9]>>20) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[9]>>0&0xfffff)>>1 ^ -(int32(src[9]>>0&0xfffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[10]>>12&0xfffff)>>1 ^ -(int32(src[10]>>12&0xfffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[10]<<8&0xfff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xfff00)|src[11]>>24) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[11]>>4&0xfffff)>>1 ^ -(int32(src[11]>>4&0xfffff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[11]<<16&0xf0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0xf0000)|src[12]>>16) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[12]<<4&0xffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffff0)|src[13]>>28) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[13]>>8&0xfffff)>>1 ^ -(int32(src[13]>>8&0xfffff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[13]<<12&0xff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0xff000)|src[14]>>20) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[14]>>0&0xfffff)>>1 ^ -(int32(src[14]>>0&0xfffff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[15]>>12&0xfffff)>>1 ^ -(int32(src[15]>>12&0xfffff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[15]<<8&0xfff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0xfff00)|src[16]>>24) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[16]>>4&0xfffff)>>1 ^ -(int32(src[16]>>4&0xfffff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[16]<<16&0xf0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xf0000)|src[17]>>16) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[17]<<4&0xffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0xffff0)|src[18]>>28) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[18]>>8&0xfffff)>>1 ^ -(int32(src[18]>>8&0xfffff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[18]<<12&0xff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0xff000)|src[19]>>20) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[19]>>0&0xfffff)>>1 ^ -(int32(src[19]>>0&0xfffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum21BitDelta[T Integer](src *[21]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>11&0x1fffff)>>1 ^ -(int32(src[0]>>11&0x1fffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<10&0x1ffc00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x1ffc00)|src[1]>>22) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32(src[1]>>1&0x1fffff)>>1 ^ -(int32(src[1]>>1&0x1fffff) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[1]<<20&0x100000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0x100000)|src[2]>>12) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[2]<<9&0x1ffe00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x1ffe00)|src[3]>>23) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[3]>>2&0x1fffff)>>1 ^ -(int32(src[3]>>2&0x1fffff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[3]<<19&0x180000)|src[4]>>13)>>1 ^ -(int32((src[3]<<19&0x180000)|src[4]>>13) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[4]<<8&0x1fff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x1fff00)|src[5]>>24) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[5]>>3&0x1fffff)>>1 ^ -(int32(src[5]>>3&0x1fffff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[5]<<18&0x1c0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x1c0000)|src[6]>>14) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[6]<<7&0x1fff80)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x1fff80)|src[7]>>25) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[7]>>4&0x1fffff)>>1 ^ -(int32(src[7]>>4&0x1fffff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[7]<<17&0x1e0000)|src[8]>>15)>>1 ^ -(int32((src[7]<<17&0x1e0000)|src[8]>>15) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[8]<<6&0x1fffc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x1fffc0)|src[9]>>26) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[9]>>5&0x1fffff)>>1 ^ -(int32(src[9]>>5&0x1fffff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[9]<<16&0x1f0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0x1f0000)|src[10]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[10]<<5&0x1fffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1fffe0)|src[11]>>27) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[11]>>6&0x1fffff)>>1 ^ -(int32(src[11]>>6&0x1fffff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[11]<<15&0x1f8000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x1f8000)|src[12]>>17) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[12]<<4&0x1ffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x1ffff0)|src[13]>>28) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[13]>>7&0x1fffff)>>1 ^ -(int32(src[13]>>7&0x1fffff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[13]<<14&0x1fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x1fc000)|src[14]>>18) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[14]<<3&0x1ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x1ffff8)|src[15]>>29) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[15]>>8&0x1fffff)>>1 ^ -(int32(src[15]>>8&0x1fffff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[15]<<13&0x1fe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1fe000)|src[16]>>19) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[16]<<2&0x1ffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x1ffffc)|src[17]>>30) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[17]>>9&0x1fffff)>>1 ^ -(int32(src[17]>>9&0x1fffff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[17]<<12&0x1ff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0x1ff000)|src[18]>>20) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[18]<<1&0x1ffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffe)|src[19]>>31) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32(src[19]>>10&0x1fffff)>>1 ^ -(int32(src[19]>>10&0x1fffff) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[19]<<11&0x1ff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x1ff800)|src[20]>>21) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[20]>>0&0x1fffff)>>1 ^ -(int32(src[20]>>0&0x1fffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum22BitDelta[T Integer](src *[22]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>10&0x3fffff)>>1 ^ -(int32(src[0]>>10&0x3fffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<12&0x3ff000)|src[1]>>20)>>1 ^ -(int32((src[0]<<12&0x3ff000)|src[1]>>20) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<2&0x3ffffc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3ffffc)|src[2]>>30) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[2]>>8&0x3fffff)>>1 ^ -(int32(src[2]>>8&0x3fffff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[2]<<14&0x3fc000)|src[3]>>18)>>1 ^ -(int32((src[2]<<14&0x3fc000)|src[3]>>18) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[3]<<4&0x3ffff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x3ffff0)|src[4]>>28) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[4]>>6&0x3fffff)>>1 ^ -(int32(src[4]>>6&0x3fffff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[4]<<16&0x3f0000)|src[5]>>16)>>1 ^ -(int32((src[4]<<16&0x3f0000)|src[5]>>16) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[5]<<6&0x3fffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3fffc0)|src[6]>>26) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[6]>>4&0x3fffff)>>1 ^ -(int32(src[6]>>4&0x3fffff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[6]<<18&0x3c0000)|src[7]>>14)>>1 ^ -(int32((src[6]<<18&0x3c0000)|src[7]>>14) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[7]<<8&0x3fff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x3fff00)|src[8]>>24) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[8]>>2&0x3fffff)>>1 ^ -(int32(src[8]>>2&0x3fffff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[8]<<20&0x300000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0x300000)|src[9]>>12) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[9]<<10&0x3ffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffc00)|src[10]>>22) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[10]>>0&0x3fffff)>>1 ^ -(int32(src[10]>>0&0x3fffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[11]>>10&0x3fffff)>>1 ^ -(int32(src[11]>>10&0x3fffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[11]<<12&0x3ff000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3ff000)|src[12]>>20) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[12]<<2&0x3ffffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x3ffffc)|src[13]>>30) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[13]>>8&0x3fffff)>>1 ^ -(int32(src[13]>>8&0x3fffff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[13]<<14&0x3fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x3fc000)|src[14]>>18) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[14]<<4&0x3ffff0)|src[15]>>28)>>1 ^ -(int32((src[14]<<4&0x3ffff0)|src[15]>>28) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[15]>>6&0x3fffff)>>1 ^ -(int32(src[15]>>6&0x3fffff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[15]<<16&0x3f0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0x3f0000)|src[16]>>16) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[16]<<6&0x3fffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x3fffc0)|src[17]>>26) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[17]>>4&0x3fffff)>>1 ^ -(int32(src[17]>>4&0x3fffff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[17]<<18&0x3c0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x3c0000)|src[18]>>14) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[18]<<8&0x3fff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0x3fff00)|src[19]>>24) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[19]>>2&0x3fffff)>>1 ^ -(int32(src[19]>>2&0x3fffff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[19]<<20&0x300000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x300000)|src[20]>>12) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[20]<<10&0x3ffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x3ffc00)|src[21]>>22) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[21]>>0&0x3fffff)>>1 ^ -(int32(src[21]>>0&0x3fffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum23BitDelta[T Integer](src *[23]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>9&0x7fffff)>>1 ^ -(int32(src[0]>>9&0x7fffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<14&0x7fc000)|src[1]>>18)>>1 ^ -(int32((src[0]<<14&0x7fc000)|src[1]>>18) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<5&0x7fffe0)|src[2]>>27)>>1 ^ -(int32((src[1]<<5&0x7fffe0)|src[2]>>27) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[2]>>4&0x7fffff)>>1 ^ -(int32(src[2]>>4&0x7fffff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[2]<<19&0x780000)|src[3]>>13)>>1 ^ -(int32((src[2]<<19&0x780000)|src[3]>>13) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[3]<<10&0x7ffc00)|src[4]>>22)>>1 ^ -(int32((src[3]<<10&0x7ffc00)|src[4]>>22) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[4]<<1&0x7ffffe)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7ffffe)|src[5]>>31) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[5]>>8&0x7fffff)>>1 ^ -(int32(src[5]>>8&0x7fffff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[5]<<15&0x7f8000)|src[6]>>17)>>1 ^ -(int32((src[5]<<15&0x7f8000)|src[6]>>17) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[6]<<6&0x7fffc0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x7fffc0)|src[7]>>26) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[7]>>3&0x7fffff)>>1 ^ -(int32(src[7]>>3&0x7fffff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[7]<<20&0x700000)|src[8]>>12)>>1 ^ -(int32((src[7]<<20&0x700000)|src[8]>>12) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[8]<<11&0x7ff800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x7ff800)|src[9]>>21) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[9]<<2&0x7ffffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x7ffffc)|src[10]>>30) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32(src[10]>>7&0x7fffff)>>1 ^ -(int32(src[10]>>7&0x7fffff) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[10]<<16&0x7f0000)|src[11]>>16)>>1 ^ -(int32((src[10]<<16&0x7f0000)|src[11]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[11]<<7&0x7fff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x7fff80)|src[12]>>25) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32(src[12]>>2&0x7fffff)>>1 ^ -(int32(src[12]>>2&0x7fffff) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[12]<<21&0x600000)|src[13]>>11)>>1 ^ -(int32((src[12]<<21&0x600000)|src[13]>>11) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[13]<<12&0x7ff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0x7ff000)|src[14]>>20) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[14]<<3&0x7ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x7ffff8)|src[15]>>29) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[15]>>6&0x7fffff)>>1 ^ -(int32(src[15]>>6&0x7fffff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[15]<<17&0x7e0000)|src[16]>>15)>>1 ^ -(int32((src[15]<<17&0x7e0000)|src[16]>>15) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[16]<<8&0x7fff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0x7fff00)|src[17]>>24) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[17]>>1&0x7fffff)>>1 ^ -(int32(src[17]>>1&0x7fffff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[17]<<22&0x400000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x400000)|src[18]>>10) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[18]<<13&0x7fe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7fe000)|src[19]>>19) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[19]<<4&0x7ffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0x7ffff0)|src[20]>>28) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[20]>>5&0x7fffff)>>1 ^ -(int32(src[20]>>5&0x7fffff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[20]<<18&0x7c0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x7c0000)|src[21]>>14) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[21]<<9&0x7ffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffe00)|src[22]>>23) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[22]>>0&0x7fffff)>>1 ^ -(int32(src[22]>>0&0x7fffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum24BitDelta[T Integer](src *[24]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>8&0xffffff)>>1 ^ -(int32(src[0]>>8&0xffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<16&0xff0000)|src[1]>>16)>>1 ^ -(int32((src[0]<<16&0xff0000)|src[1]>>16) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<8&0xffff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xffff00)|src[2]>>24) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32(src[2]>>0&0xffffff)>>1 ^ -(int32(src[2]>>0&0xffffff) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[3]>>8&0xffffff)>>1 ^ -(int32(src[3]>>8&0xffffff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[3]<<16&0xff0000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0xff0000)|src[4]>>16) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[4]<<8&0xffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xffff00)|src[5]>>24) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[5]>>0&0xffffff)>>1 ^ -(int32(src[5]>>0&0xffffff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[6]>>8&0xffffff)>>1 ^ -(int32(src[6]>>8&0xffffff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[6]<<16&0xff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xff0000)|src[7]>>16) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[7]<<8&0xffff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xffff00)|src[8]>>24) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32(src[8]>>0&0xffffff)>>1 ^ -(int32(src[8]>>0&0xffffff) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[9]>>8&0xffffff)>>1 ^ -(int32(src[9]>>8&0xffffff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[9]<<16&0xff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xff0000)|src[10]>>16) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[10]<<8&0xffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xffff00)|src[11]>>24) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[11]>>0&0xffffff)>>1 ^ -(int32(src[11]>>0&0xffffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[12]>>8&0xffffff)>>1 ^ -(int32(src[12]>>8&0xffffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[12]<<16&0xff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0xff0000)|src[13]>>16) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[13]<<8&0xffff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0xffff00)|src[14]>>24) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[14]>>0&0xffffff)>>1 ^ -(int32(src[14]>>0&0xffffff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32(src[15]>>8&0xffffff)>>1 ^ -(int32(src[15]>>8&0xffffff) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[15]<<16&0xff0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0xff0000)|src[16]>>16) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[16]<<8&0xffff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0xffff00)|src[17]>>24) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[17]>>0&0xffffff)>>1 ^ -(int32(src[17]>>0&0xffffff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[18]>>8&0xffffff)>>1 ^ -(int32(src[18]>>8&0xffffff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[18]<<16&0xff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0xff0000)|src[19]>>16) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[19]<<8&0xffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0xffff00)|src[20]>>24) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[20]>>0&0xffffff)>>1 ^ -(int32(src[20]>>0&0xffffff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32(src[21]>>8&0xffffff)>>1 ^ -(int32(src[21]>>8&0xffffff) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[21]<<16&0xff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0xff0000)|src[22]>>16) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[22]<<8&0xffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0xffff00)|src[23]>>24) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[23]>>0&0xffffff)>>1 ^ -(int32(src[23]>>0&0xffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum25BitDelta[T Integer](src *[25]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>7&0x1ffffff)>>1 ^ -(int32(src[0]>>7&0x1ffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<18&0x1fc0000)|src[1]>>14)>>1 ^ -(int32((src[0]<<18&0x1fc0000)|src[1]>>14) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<11&0x1fff800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x1fff800)|src[2]>>21) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[2]<<4&0x1fffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x1fffff0)|src[3]>>28) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32(src[3]>>3&0x1ffffff)>>1 ^ -(int32(src[3]>>3&0x1ffffff) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[3]<<22&0x1c00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x1c00000)|src[4]>>10) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[4]<<15&0x1ff8000)|src[5]>>17)>>1 ^ -(int32((src[4]<<15&0x1ff8000)|src[5]>>17) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[5]<<8&0x1ffff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x1ffff00)|src[6]>>24) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[6]<<1&0x1fffffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fffffe)|src[7]>>31) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32(src[7]>>6&0x1ffffff)>>1 ^ -(int32(src[7]>>6&0x1ffffff) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[7]<<19&0x1f80000)|src[8]>>13)>>1 ^ -(int32((src[7]<<19&0x1f80000)|src[8]>>13) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[8]<<12&0x1fff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x1fff000)|src[9]>>20) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[9]<<5&0x1ffffe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1ffffe0)|src[10]>>27) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32(src[10]>>2&0x1ffffff)>>1 ^ -(int32(src[10]>>2&0x1ffffff) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[10]<<23&0x1800000)|src[11]>>9)>>1 ^ -(int32((src[10]<<23&0x1800000)|src[11]>>9) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[11]<<16&0x1ff0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0x1ff0000)|src[12]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[12]<<9&0x1fffe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fffe00)|src[13]>>23) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[13]<<2&0x1fffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x1fffffc)|src[14]>>30) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32(src[14]>>5&0x1ffffff)>>1 ^ -(int32(src[14]>>5&0x1ffffff) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[14]<<20&0x1f00000)|src[15]>>12)>>1 ^ -(int32((src[14]<<20&0x1f00000)|src[15]>>12) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[15]<<13&0x1ffe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1ffe000)|src[16]>>19) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[16]<<6&0x1ffffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x1ffffc0)|src[17]>>26) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32(src[17]>>1&0x1ffffff)>>1 ^ -(int32(src[17]>>1&0x1ffffff) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[17]<<24&0x1000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x1000000)|src[18]>>8) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[18]<<17&0x1fe0000)|src[19]>>15)>>1 ^ -(int32((src[18]<<17&0x1fe0000)|src[19]>>15) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[19]<<10&0x1fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x1fffc00)|src[20]>>22) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[20]<<3&0x1fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x1fffff8)|src[21]>>29) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32(src[21]>>4&0x1ffffff)>>1 ^ -(int32(src[21]>>4&0x1ffffff) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[21]<<21&0x1e00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1e00000)|src[22]>>11) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[22]<<14&0x1ffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x1ffc000)|src[23]>>18) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[23]<<7&0x1ffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x1ffff80)|src[24]>>25) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[24]>>0&0x1ffffff)>>1 ^ -(int32(src[24]>>0&0x1ffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum26BitDelta[T Integer](src *[26]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>6&0x3ffffff)>>1 ^ -(int32(src[0]>>6&0x3ffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<20&0x3f00000)|src[1]>>12)>>1 ^ -(int32((src[0]<<20&0x3f00000)|src[1]>>12) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<14&0x3ffc000)|src[2]>>18)>>1 ^ -(int32((src[1]<<14&0x3ffc000)|src[2]>>18) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[2]<<8&0x3ffff00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x3ffff00)|src[3]>>24) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[3]<<2&0x3fffffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fffffc)|src[4]>>30) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32(src[4]>>4&0x3ffffff)>>1 ^ -(int32(src[4]>>4&0x3ffffff) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[4]<<22&0x3c00000)|src[5]>>10)>>1 ^ -(int32((src[4]<<22&0x3c00000)|src[5]>>10) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[5]<<16&0x3ff0000)|src[6]>>16)>>1 ^ -(int32((src[5]<<16&0x3ff0000)|src[6]>>16) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[6]<<10&0x3fffc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fffc00)|src[7]>>22) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[7]<<4&0x3fffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3fffff0)|src[8]>>28) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[8]>>2&0x3ffffff)>>1 ^ -(int32(src[8]>>2&0x3ffffff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[8]<<24&0x3000000)|src[9]>>8)>>1 ^ -(int32((src[8]<<24&0x3000000)|src[9]>>8) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[9]<<18&0x3fc0000)|src[10]>>14)>>1 ^ -(int32((src[9]<<18&0x3fc0000)|src[10]>>14) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[10]<<12&0x3fff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3fff000)|src[11]>>20) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[11]<<6&0x3ffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3ffffc0)|src[12]>>26) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[12]>>0&0x3ffffff)>>1 ^ -(int32(src[12]>>0&0x3ffffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[13]>>6&0x3ffffff)>>1 ^ -(int32(src[13]>>6&0x3ffffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[13]<<20&0x3f00000)|src[14]>>12)>>1 ^ -(int32((src[13]<<20&0x3f00000)|src[14]>>12) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[14]<<14&0x3ffc000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x3ffc000)|src[15]>>18) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[15]<<8&0x3ffff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0x3ffff00)|src[16]>>24) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[16]<<2&0x3fffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x3fffffc)|src[17]>>30) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[17]>>4&0x3ffffff)>>1 ^ -(int32(src[17]>>4&0x3ffffff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[17]<<22&0x3c00000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x3c00000)|src[18]>>10) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[18]<<16&0x3ff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0x3ff0000)|src[19]>>16) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[19]<<10&0x3fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x3fffc00)|src[20]>>22) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[20]<<4&0x3fffff0)|src[21]>>28)>>1 ^ -(int32((src[20]<<4&0x3fffff0)|src[21]>>28) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32(src[21]>>2&0x3ffffff)>>1 ^ -(int32(src[21]>>2&0x3ffffff) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[21]<<24&0x3000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0x3000000)|src[22]>>8) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[22]<<18&0x3fc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x3fc0000)|src[23]>>14) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[23]<<12&0x3fff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3fff000)|src[24]>>20) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[24]<<6&0x3ffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x3ffffc0)|src[25]>>26) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[25]>>0&0x3ffffff)>>1 ^ -(int32(src[25]>>0&0x3ffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum27BitDelta[T Integer](src *[27]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>5&0x7ffffff)>>1 ^ -(int32(src[0]>>5&0x7ffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<22&0x7c00000)|src[1]>>10)>>1 ^ -(int32((src[0]<<22&0x7c00000)|src[1]>>10) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<17&0x7fe0000)|src[2]>>15)>>1 ^ -(int32((src[1]<<17&0x7fe0000)|src[2]>>15) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[2]<<12&0x7fff000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x7fff000)|src[3]>>20) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[3]<<7&0x7ffff80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7ffff80)|src[4]>>25) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[4]<<2&0x7fffffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x7fffffc)|src[5]>>30) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32(src[5]>>3&0x7ffffff)>>1 ^ -(int32(src[5]>>3&0x7ffffff) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[5]<<24&0x7000000)|src[6]>>8)>>1 ^ -(int32((src[5]<<24&0x7000000)|src[6]>>8) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[6]<<19&0x7f80000)|src[7]>>13)>>1 ^ -(int32((src[6]<<19&0x7f80000)|src[7]>>13) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[7]<<14&0x7ffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x7ffc000)|src[8]>>18) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[8]<<9&0x7fffe00)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x7fffe00)|src[9]>>23) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[9]<<4&0x7fffff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x7fffff0)|src[10]>>28) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32(src[10]>>1&0x7ffffff)>>1 ^ -(int32(src[10]>>1&0x7ffffff) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[10]<<26&0x4000000)|src[11]>>6)>>1 ^ -(int32((src[10]<<26&0x4000000)|src[11]>>6) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[11]<<21&0x7e00000)|src[12]>>11)>>1 ^ -(int32((src[11]<<21&0x7e00000)|src[12]>>11) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[12]<<16&0x7ff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x7ff0000)|src[13]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[13]<<11&0x7fff800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x7fff800)|src[14]>>21) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[14]<<6&0x7ffffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x7ffffc0)|src[15]>>26) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[15]<<1&0x7fffffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffffe)|src[16]>>31) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32(src[16]>>4&0x7ffffff)>>1 ^ -(int32(src[16]>>4&0x7ffffff) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[16]<<23&0x7800000)|src[17]>>9)>>1 ^ -(int32((src[16]<<23&0x7800000)|src[17]>>9) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[17]<<18&0x7fc0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x7fc0000)|src[18]>>14) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[18]<<13&0x7ffe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7ffe000)|src[19]>>19) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[19]<<8&0x7ffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0x7ffff00)|src[20]>>24) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[20]<<3&0x7fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x7fffff8)|src[21]>>29) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32(src[21]>>2&0x7ffffff)>>1 ^ -(int32(src[21]>>2&0x7ffffff) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[21]<<25&0x6000000)|src[22]>>7)>>1 ^ -(int32((src[21]<<25&0x6000000)|src[22]>>7) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[22]<<20&0x7f00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0x7f00000)|src[23]>>12) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[23]<<15&0x7ff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x7ff8000)|src[24]>>17) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[24]<<10&0x7fffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x7fffc00)|src[25]>>22) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[25]<<5&0x7ffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7ffffe0)|src[26]>>27) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[26]>>0&0x7ffffff)>>1 ^ -(int32(src[26]>>0&0x7ffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum28BitDelta[T Integer](src *[28]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>4&0xfffffff)>>1 ^ -(int32(src[0]>>4&0xfffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<24&0xf000000)|src[1]>>8)>>1 ^ -(int32((src[0]<<24&0xf000000)|src[1]>>8) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<20&0xff00000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0xff00000)|src[2]>>12) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[2]<<16&0xfff0000)|src[3]>>16)>>1 ^ -(int32((src[2]<<16&0xfff0000)|src[3]>>16) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[3]<<12&0xffff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xffff000)|src[4]>>20) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[4]<<8&0xfffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xfffff00)|src[5]>>24) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[5]<<4&0xffffff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0xffffff0)|src[6]>>28) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32(src[6]>>0&0xfffffff)>>1 ^ -(int32(src[6]>>0&0xfffffff) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32(src[7]>>4&0xfffffff)>>1 ^ -(int32(src[7]>>4&0xfffffff) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[7]<<24&0xf000000)|src[8]>>8)>>1 ^ -(int32((src[7]<<24&0xf000000)|src[8]>>8) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[8]<<20&0xff00000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0xff00000)|src[9]>>12) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[9]<<16&0xfff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xfff0000)|src[10]>>16) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[10]<<12&0xffff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0xffff000)|src[11]>>20) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[11]<<8&0xfffff00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0xfffff00)|src[12]>>24) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[12]<<4&0xffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffffff0)|src[13]>>28) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[13]>>0&0xfffffff)>>1 ^ -(int32(src[13]>>0&0xfffffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[14]>>4&0xfffffff)>>1 ^ -(int32(src[14]>>4&0xfffffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[14]<<24&0xf000000)|src[15]>>8)>>1 ^ -(int32((src[14]<<24&0xf000000)|src[15]>>8) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[15]<<20&0xff00000)|src[16]>>12)>>1 ^ -(int32((src[15]<<20&0xff00000)|src[16]>>12) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[16]<<16&0xfff0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xfff0000)|src[17]>>16) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[17]<<12&0xffff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0xffff000)|src[18]>>20) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[18]<<8&0xfffff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0xfffff00)|src[19]>>24) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[19]<<4&0xffffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0xffffff0)|src[20]>>28) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32(src[20]>>0&0xfffffff)>>1 ^ -(int32(src[20]>>0&0xfffffff) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32(src[21]>>4&0xfffffff)>>1 ^ -(int32(src[21]>>4&0xfffffff) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[21]<<24&0xf000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0xf000000)|src[22]>>8) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[22]<<20&0xff00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0xff00000)|src[23]>>12) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[23]<<16&0xfff0000)|src[24]>>16)>>1 ^ -(int32((src[23]<<16&0xfff0000)|src[24]>>16) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[24]<<12&0xffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0xffff000)|src[25]>>20) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[25]<<8&0xfffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0xfffff00)|src[26]>>24) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[26]<<4&0xffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0xffffff0)|src[27]>>28) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[27]>>0&0xfffffff)>>1 ^ -(int32(src[27]>>0&0xfffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum29BitDelta[T Integer](src *[29]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>3&0x1fffffff)>>1 ^ -(int32(src[0]>>3&0x1fffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<26&0x1c000000)|src[1]>>6)>>1 ^ -(int32((src[0]<<26&0x1c000000)|src[1]>>6) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<23&0x1f800000)|src[2]>>9)>>1 ^ -(int32((src[1]<<23&0x1f800000)|src[2]>>9) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[2]<<20&0x1ff00000)|src[3]>>12)>>1 ^ -(int32((src[2]<<20&0x1ff00000)|src[3]>>12) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[3]<<17&0x1ffe0000)|src[4]>>15)>>1 ^ -(int32((src[3]<<17&0x1ffe0000)|src[4]>>15) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[4]<<14&0x1fffc000)|src[5]>>18)>>1 ^ -(int32((src[4]<<14&0x1fffc000)|src[5]>>18) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[5]<<11&0x1ffff800)|src[6]>>21)>>1 ^ -(int32((src[5]<<11&0x1ffff800)|src[6]>>21) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[6]<<8&0x1fffff00)|src[7]>>24)>>1 ^ -(int32((src[6]<<8&0x1fffff00)|src[7]>>24) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[7]<<5&0x1fffffe0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1fffffe0)|src[8]>>27) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[8]<<2&0x1ffffffc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x1ffffffc)|src[9]>>30) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32(src[9]>>1&0x1fffffff)>>1 ^ -(int32(src[9]>>1&0x1fffffff) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[9]<<28&0x10000000)|src[10]>>4)>>1 ^ -(int32((src[9]<<28&0x10000000)|src[10]>>4) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[10]<<25&0x1e000000)|src[11]>>7)>>1 ^ -(int32((src[10]<<25&0x1e000000)|src[11]>>7) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[11]<<22&0x1fc00000)|src[12]>>10)>>1 ^ -(int32((src[11]<<22&0x1fc00000)|src[12]>>10) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[12]<<19&0x1ff80000)|src[13]>>13)>>1 ^ -(int32((src[12]<<19&0x1ff80000)|src[13]>>13) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[13]<<16&0x1fff0000)|src[14]>>16)>>1 ^ -(int32((src[13]<<16&0x1fff0000)|src[14]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[14]<<13&0x1fffe000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1fffe000)|src[15]>>19) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[15]<<10&0x1ffffc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x1ffffc00)|src[16]>>22) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[16]<<7&0x1fffff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x1fffff80)|src[17]>>25) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[17]<<4&0x1ffffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0x1ffffff0)|src[18]>>28) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[18]<<1&0x1ffffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffffe)|src[19]>>31) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32(src[19]>>2&0x1fffffff)>>1 ^ -(int32(src[19]>>2&0x1fffffff) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[19]<<27&0x18000000)|src[20]>>5)>>1 ^ -(int32((src[19]<<27&0x18000000)|src[20]>>5) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[20]<<24&0x1f000000)|src[21]>>8)>>1 ^ -(int32((src[20]<<24&0x1f000000)|src[21]>>8) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[21]<<21&0x1fe00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1fe00000)|src[22]>>11) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[22]<<18&0x1ffc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x1ffc0000)|src[23]>>14) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[23]<<15&0x1fff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x1fff8000)|src[24]>>17) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[24]<<12&0x1ffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0x1ffff000)|src[25]>>20) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[25]<<9&0x1ffffe00)|src[26]>>23)>>1 ^ -(int32((src[25]<<9&0x1ffffe00)|src[26]>>23) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[26]<<6&0x1fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x1fffffc0)|src[27]>>26) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[27]<<3&0x1ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x1ffffff8)|src[28]>>29) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[28]>>0&0x1fffffff)>>1 ^ -(int32(src[28]>>0&0x1fffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum30BitDelta[T Integer](src *[30]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>2&0x3fffffff)>>1 ^ -(int32(src[0]>>2&0x3fffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<28&0x30000000)|src[1]>>4)>>1 ^ -(int32((src[0]<<28&0x30000000)|src[1]>>4) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<26&0x3c000000)|src[2]>>6)>>1 ^ -(int32((src[1]<<26&0x3c000000)|src[2]>>6) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[2]<<24&0x3f000000)|src[3]>>8)>>1 ^ -(int32((src[2]<<24&0x3f000000)|src[3]>>8) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[3]<<22&0x3fc00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x3fc00000)|src[4]>>10) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[4]<<20&0x3ff00000)|src[5]>>12)>>1 ^ -(int32((src[4]<<20&0x3ff00000)|src[5]>>12) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[5]<<18&0x3ffc0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x3ffc0000)|src[6]>>14) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[6]<<16&0x3fff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0x3fff0000)|src[7]>>16) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[7]<<14&0x3fffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3fffc000)|src[8]>>18) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[8]<<12&0x3ffff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x3ffff000)|src[9]>>20) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[9]<<10&0x3ffffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffffc00)|src[10]>>22) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[10]<<8&0x3fffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3fffff00)|src[11]>>24) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[11]<<6&0x3fffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3fffffc0)|src[12]>>26) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[12]<<4&0x3ffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ffffff0)|src[13]>>28) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[13]<<2&0x3ffffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3ffffffc)|src[14]>>30) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32(src[14]>>0&0x3fffffff)>>1 ^ -(int32(src[14]>>0&0x3fffffff) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32(src[15]>>2&0x3fffffff)>>1 ^ -(int32(src[15]>>2&0x3fffffff) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[15]<<28&0x30000000)|src[16]>>4)>>1 ^ -(int32((src[15]<<28&0x30000000)|src[16]>>4) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[16]<<26&0x3c000000)|src[17]>>6)>>1 ^ -(int32((src[16]<<26&0x3c000000)|src[17]>>6) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[17]<<24&0x3f000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x3f000000)|src[18]>>8) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[18]<<22&0x3fc00000)|src[19]>>10)>>1 ^ -(int32((src[18]<<22&0x3fc00000)|src[19]>>10) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[19]<<20&0x3ff00000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x3ff00000)|src[20]>>12) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[20]<<18&0x3ffc0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x3ffc0000)|src[21]>>14) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[21]<<16&0x3fff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0x3fff0000)|src[22]>>16) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[22]<<14&0x3fffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x3fffc000)|src[23]>>18) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[23]<<12&0x3ffff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3ffff000)|src[24]>>20) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[24]<<10&0x3ffffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x3ffffc00)|src[25]>>22) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[25]<<8&0x3fffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0x3fffff00)|src[26]>>24) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[26]<<6&0x3fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x3fffffc0)|src[27]>>26) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[27]<<4&0x3ffffff0)|src[28]>>28)>>1 ^ -(int32((src[27]<<4&0x3ffffff0)|src[28]>>28) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[28]<<2&0x3ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x3ffffffc)|src[29]>>30) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[29]>>0&0x3fffffff)>>1 ^ -(int32(src[29]>>0&0x3fffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func sum31BitDelta[T Integer](src *[31]Word, offset T) (sum, last T) {
+	// each delta applies to all of the following Integers
+	var weighted, total int32
+	d0 := int32(src[0]>>1&0x7fffffff)>>1 ^ -(int32(src[0]>>1&0x7fffffff) & 1)
+	weighted += 32 * d0
+	total += d0
+	d1 := int32((src[0]<<30&0x40000000)|src[1]>>2)>>1 ^ -(int32((src[0]<<30&0x40000000)|src[1]>>2) & 1)
+	weighted += 31 * d1
+	total += d1
+	d2 := int32((src[1]<<29&0x60000000)|src[2]>>3)>>1 ^ -(int32((src[1]<<29&0x60000000)|src[2]>>3) & 1)
+	weighted += 30 * d2
+	total += d2
+	d3 := int32((src[2]<<28&0x70000000)|src[3]>>4)>>1 ^ -(int32((src[2]<<28&0x70000000)|src[3]>>4) & 1)
+	weighted += 29 * d3
+	total += d3
+	d4 := int32((src[3]<<27&0x78000000)|src[4]>>5)>>1 ^ -(int32((src[3]<<27&0x78000000)|src[4]>>5) & 1)
+	weighted += 28 * d4
+	total += d4
+	d5 := int32((src[4]<<26&0x7c000000)|src[5]>>6)>>1 ^ -(int32((src[4]<<26&0x7c000000)|src[5]>>6) & 1)
+	weighted += 27 * d5
+	total += d5
+	d6 := int32((src[5]<<25&0x7e000000)|src[6]>>7)>>1 ^ -(int32((src[5]<<25&0x7e000000)|src[6]>>7) & 1)
+	weighted += 26 * d6
+	total += d6
+	d7 := int32((src[6]<<24&0x7f000000)|src[7]>>8)>>1 ^ -(int32((src[6]<<24&0x7f000000)|src[7]>>8) & 1)
+	weighted += 25 * d7
+	total += d7
+	d8 := int32((src[7]<<23&0x7f800000)|src[8]>>9)>>1 ^ -(int32((src[7]<<23&0x7f800000)|src[8]>>9) & 1)
+	weighted += 24 * d8
+	total += d8
+	d9 := int32((src[8]<<22&0x7fc00000)|src[9]>>10)>>1 ^ -(int32((src[8]<<22&0x7fc00000)|src[9]>>10) & 1)
+	weighted += 23 * d9
+	total += d9
+	d10 := int32((src[9]<<21&0x7fe00000)|src[10]>>11)>>1 ^ -(int32((src[9]<<21&0x7fe00000)|src[10]>>11) & 1)
+	weighted += 22 * d10
+	total += d10
+	d11 := int32((src[10]<<20&0x7ff00000)|src[11]>>12)>>1 ^ -(int32((src[10]<<20&0x7ff00000)|src[11]>>12) & 1)
+	weighted += 21 * d11
+	total += d11
+	d12 := int32((src[11]<<19&0x7ff80000)|src[12]>>13)>>1 ^ -(int32((src[11]<<19&0x7ff80000)|src[12]>>13) & 1)
+	weighted += 20 * d12
+	total += d12
+	d13 := int32((src[12]<<18&0x7ffc0000)|src[13]>>14)>>1 ^ -(int32((src[12]<<18&0x7ffc0000)|src[13]>>14) & 1)
+	weighted += 19 * d13
+	total += d13
+	d14 := int32((src[13]<<17&0x7ffe0000)|src[14]>>15)>>1 ^ -(int32((src[13]<<17&0x7ffe0000)|src[14]>>15) & 1)
+	weighted += 18 * d14
+	total += d14
+	d15 := int32((src[14]<<16&0x7fff0000)|src[15]>>16)>>1 ^ -(int32((src[14]<<16&0x7fff0000)|src[15]>>16) & 1)
+	weighted += 17 * d15
+	total += d15
+	d16 := int32((src[15]<<15&0x7fff8000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x7fff8000)|src[16]>>17) & 1)
+	weighted += 16 * d16
+	total += d16
+	d17 := int32((src[16]<<14&0x7fffc000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x7fffc000)|src[17]>>18) & 1)
+	weighted += 15 * d17
+	total += d17
+	d18 := int32((src[17]<<13&0x7fffe000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7fffe000)|src[18]>>19) & 1)
+	weighted += 14 * d18
+	total += d18
+	d19 := int32((src[18]<<12&0x7ffff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0x7ffff000)|src[19]>>20) & 1)
+	weighted += 13 * d19
+	total += d19
+	d20 := int32((src[19]<<11&0x7ffff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x7ffff800)|src[20]>>21) & 1)
+	weighted += 12 * d20
+	total += d20
+	d21 := int32((src[20]<<10&0x7ffffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x7ffffc00)|src[21]>>22) & 1)
+	weighted += 11 * d21
+	total += d21
+	d22 := int32((src[21]<<9&0x7ffffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffffe00)|src[22]>>23) & 1)
+	weighted += 10 * d22
+	total += d22
+	d23 := int32((src[22]<<8&0x7fffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0x7fffff00)|src[23]>>24) & 1)
+	weighted += 9 * d23
+	total += d23
+	d24 := int32((src[23]<<7&0x7fffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x7fffff80)|src[24]>>25) & 1)
+	weighted += 8 * d24
+	total += d24
+	d25 := int32((src[24]<<6&0x7fffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x7fffffc0)|src[25]>>26) & 1)
+	weighted += 7 * d25
+	total += d25
+	d26 := int32((src[25]<<5&0x7fffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7fffffe0)|src[26]>>27) & 1)
+	weighted += 6 * d26
+	total += d26
+	d27 := int32((src[26]<<4&0x7ffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0x7ffffff0)|src[27]>>28) & 1)
+	weighted += 5 * d27
+	total += d27
+	d28 := int32((src[27]<<3&0x7ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x7ffffff8)|src[28]>>29) & 1)
+	weighted += 4 * d28
+	total += d28
+	d29 := int32((src[28]<<2&0x7ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x7ffffffc)|src[29]>>30) & 1)
+	weighted += 3 * d29
+	total += d29
+	d30 := int32((src[29]<<1&0x7ffffffe)|src[30]>>31)>>1 ^ -(int32((src[29]<<1&0x7ffffffe)|src[30]>>31) & 1)
+	weighted += 2 * d30
+	total += d30
+	d31 := int32(src[30]>>0&0x7fffffff)>>1 ^ -(int32(src[30]>>0&0x7fffffff) & 1)
+	weighted += 1 * d31
+	total += d31
+	return 32*offset - T(weighted), offset - T(total)
+}
+
+func range1BitDelta[T Integer](src *[1]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>31&0x1)>>1 ^ -(int32(src[0]>>31&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>30&0x1)>>1 ^ -(int32(src[0]>>30&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>29&0x1)>>1 ^ -(int32(src[0]>>29&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>28&0x1)>>1 ^ -(int32(src[0]>>28&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[0]>>27&0x1)>>1 ^ -(int32(src[0]>>27&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[0]>>26&0x1)>>1 ^ -(int32(src[0]>>26&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[0]>>25&0x1)>>1 ^ -(int32(src[0]>>25&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[0]>>24&0x1)>>1 ^ -(int32(src[0]>>24&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[0]>>23&0x1)>>1 ^ -(int32(src[0]>>23&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[0]>>22&0x1)>>1 ^ -(int32(src[0]>>22&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[0]>>21&0x1)>>1 ^ -(int32(src[0]>>21&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[0]>>20&0x1)>>1 ^ -(int32(src[0]>>20&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[0]>>19&0x1)>>1 ^ -(int32(src[0]>>19&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[0]>>18&0x1)>>1 ^ -(int32(src[0]>>18&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[0]>>17&0x1)>>1 ^ -(int32(src[0]>>17&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[0]>>16&0x1)>>1 ^ -(int32(src[0]>>16&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[0]>>15&0x1)>>1 ^ -(int32(src[0]>>15&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[0]>>14&0x1)>>1 ^ -(int32(src[0]>>14&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[0]>>13&0x1)>>1 ^ -(int32(src[0]>>13&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[0]>>12&0x1)>>1 ^ -(int32(src[0]>>12&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[0]>>11&0x1)>>1 ^ -(int32(src[0]>>11&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[0]>>10&0x1)>>1 ^ -(int32(src[0]>>10&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[0]>>9&0x1)>>1 ^ -(int32(src[0]>>9&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[0]>>8&0x1)>>1 ^ -(int32(src[0]>>8&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[0]>>7&0x1)>>1 ^ -(int32(src[0]>>7&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[0]>>6&0x1)>>1 ^ -(int32(src[0]>>6&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[0]>>5&0x1)>>1 ^ -(int32(src[0]>>5&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[0]>>4&0x1)>>1 ^ -(int32(src[0]>>4&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[0]>>3&0x1)>>1 ^ -(int32(src[0]>>3&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[0]>>2&0x1)>>1 ^ -(int32(src[0]>>2&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[0]>>1&0x1)>>1 ^ -(int32(src[0]>>1&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[0]>>0&0x1)>>1 ^ -(int32(src[0]>>0&0x1) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range2BitDelta[T Integer](src *[2]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>30&0x3)>>1 ^ -(int32(src[0]>>30&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>28&0x3)>>1 ^ -(int32(src[0]>>28&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>26&0x3)>>1 ^ -(int32(src[0]>>26&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>24&0x3)>>1 ^ -(int32(src[0]>>24&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[0]>>22&0x3)>>1 ^ -(int32(src[0]>>22&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[0]>>20&0x3)>>1 ^ -(int32(src[0]>>20&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[0]>>18&0x3)>>1 ^ -(int32(src[0]>>18&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[0]>>16&0x3)>>1 ^ -(int32(src[0]>>16&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[0]>>14&0x3)>>1 ^ -(int32(src[0]>>14&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[0]>>12&0x3)>>1 ^ -(int32(src[0]>>12&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[0]>>10&0x3)>>1 ^ -(int32(src[0]>>10&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[0]>>8&0x3)>>1 ^ -(int32(src[0]>>8&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[0]>>6&0x3)>>1 ^ -(int32(src[0]>>6&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[0]>>4&0x3)>>1 ^ -(int32(src[0]>>4&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[0]>>2&0x3)>>1 ^ -(int32(src[0]>>2&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[0]>>0&0x3)>>1 ^ -(int32(src[0]>>0&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[1]>>30&0x3)>>1 ^ -(int32(src[1]>>30&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[1]>>28&0x3)>>1 ^ -(int32(src[1]>>28&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[1]>>26&0x3)>>1 ^ -(int32(src[1]>>26&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[1]>>24&0x3)>>1 ^ -(int32(src[1]>>24&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[1]>>22&0x3)>>1 ^ -(int32(src[1]>>22&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[1]>>20&0x3)>>1 ^ -(int32(src[1]>>20&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[1]>>18&0x3)>>1 ^ -(int32(src[1]>>18&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[1]>>16&0x3)>>1 ^ -(int32(src[1]>>16&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[1]>>14&0x3)>>1 ^ -(int32(src[1]>>14&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[1]>>12&0x3)>>1 ^ -(int32(src[1]>>12&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[1]>>10&0x3)>>1 ^ -(int32(src[1]>>10&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[1]>>8&0x3)>>1 ^ -(int32(src[1]>>8&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[1]>>6&0x3)>>1 ^ -(int32(src[1]>>6&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[1]>>4&0x3)>>1 ^ -(int32(src[1]>>4&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[1]>>2&0x3)>>1 ^ -(int32(src[1]>>2&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[1]>>0&0x3)>>1 ^ -(int32(src[1]>>0&0x3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range3BitDelta[T Integer](src *[3]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>29&0x7)>>1 ^ -(int32(src[0]>>29&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>26&0x7)>>1 ^ -(int32(src[0]>>26&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>23&0x7)>>1 ^ -(int32(src[0]>>23&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>20&0x7)>>1 ^ -(int32(src[0]>>20&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[0]>>17&0x7)>>1 ^ -(int32(src[0]>>17&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[0]>>14&0x7)>>1 ^ -(int32(src[0]>>14&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[0]>>11&0x7)>>1 ^ -(int32(src[0]>>11&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[0]>>8&0x7)>>1 ^ -(int32(src[0]>>8&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[0]>>5&0x7)>>1 ^ -(int32(src[0]>>5&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[0]>>2&0x7)>>1 ^ -(int32(src[0]>>2&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[0]<<1&0x6)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x6)|src[1]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[1]>>28&0x7)>>1 ^ -(int32(src[1]>>28&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[1]>>25&0x7)>>1 ^ -(int32(src[1]>>25&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[1]>>22&0x7)>>1 ^ -(int32(src[1]>>22&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[1]>>19&0x7)>>1 ^ -(int32(src[1]>>19&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[1]>>16&0x7)>>1 ^ -(int32(src[1]>>16&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[1]>>13&0x7)>>1 ^ -(int32(src[1]>>13&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[1]>>10&0x7)>>1 ^ -(int32(src[1]>>10&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[1]>>7&0x7)>>1 ^ -(int32(src[1]>>7&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[1]>>4&0x7)>>1 ^ -(int32(src[1]>>4&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[1]>>1&0x7)>>1 ^ -(int32(src[1]>>1&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[1]<<2&0x4)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x4)|src[2]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[2]>>27&0x7)>>1 ^ -(int32(src[2]>>27&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[2]>>24&0x7)>>1 ^ -(int32(src[2]>>24&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[2]>>21&0x7)>>1 ^ -(int32(src[2]>>21&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[2]>>18&0x7)>>1 ^ -(int32(src[2]>>18&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[2]>>15&0x7)>>1 ^ -(int32(src[2]>>15&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[2]>>12&0x7)>>1 ^ -(int32(src[2]>>12&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[2]>>9&0x7)>>1 ^ -(int32(src[2]>>9&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[2]>>6&0x7)>>1 ^ -(int32(src[2]>>6&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[2]>>3&0x7)>>1 ^ -(int32(src[2]>>3&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[2]>>0&0x7)>>1 ^ -(int32(src[2]>>0&0x7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range4BitDelta[T Integer](src *[4]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>28&0xf)>>1 ^ -(int32(src[0]>>28&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>24&0xf)>>1 ^ -(int32(src[0]>>24&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>20&0xf)>>1 ^ -(int32(src[0]>>20&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>16&0xf)>>1 ^ -(int32(src[0]>>16&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[0]>>12&0xf)>>1 ^ -(int32(src[0]>>12&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[0]>>8&0xf)>>1 ^ -(int32(src[0]>>8&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[0]>>4&0xf)>>1 ^ -(int32(src[0]>>4&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[0]>>0&0xf)>>1 ^ -(int32(src[0]>>0&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[1]>>28&0xf)>>1 ^ -(int32(src[1]>>28&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[1]>>24&0xf)>>1 ^ -(int32(src[1]>>24&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[1]>>20&0xf)>>1 ^ -(int32(src[1]>>20&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[1]>>16&0xf)>>1 ^ -(int32(src[1]>>16&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[1]>>12&0xf)>>1 ^ -(int32(src[1]>>12&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[1]>>8&0xf)>>1 ^ -(int32(src[1]>>8&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[1]>>4&0xf)>>1 ^ -(int32(src[1]>>4&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[1]>>0&0xf)>>1 ^ -(int32(src[1]>>0&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[2]>>28&0xf)>>1 ^ -(int32(src[2]>>28&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[2]>>24&0xf)>>1 ^ -(int32(src[2]>>24&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[2]>>20&0xf)>>1 ^ -(int32(src[2]>>20&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[2]>>16&0xf)>>1 ^ -(int32(src[2]>>16&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[2]>>12&0xf)>>1 ^ -(int32(src[2]>>12&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[2]>>8&0xf)>>1 ^ -(int32(src[2]>>8&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[2]>>4&0xf)>>1 ^ -(int32(src[2]>>4&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[2]>>0&0xf)>>1 ^ -(int32(src[2]>>0&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[3]>>28&0xf)>>1 ^ -(int32(src[3]>>28&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[3]>>24&0xf)>>1 ^ -(int32(src[3]>>24&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[3]>>20&0xf)>>1 ^ -(int32(src[3]>>20&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[3]>>16&0xf)>>1 ^ -(int32(src[3]>>16&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[3]>>12&0xf)>>1 ^ -(int32(src[3]>>12&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[3]>>8&0xf)>>1 ^ -(int32(src[3]>>8&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[3]>>4&0xf)>>1 ^ -(int32(src[3]>>4&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[3]>>0&0xf)>>1 ^ -(int32(src[3]>>0&0xf) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range5BitDelta[T Integer](src *[5]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>27&0x1f)>>1 ^ -(int32(src[0]>>27&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>22&0x1f)>>1 ^ -(int32(src[0]>>22&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>17&0x1f)>>1 ^ -(int32(src[0]>>17&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>12&0x1f)>>1 ^ -(int32(src[0]>>12&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[0]>>7&0x1f)>>1 ^ -(int32(src[0]>>7&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[0]>>2&0x1f)>>1 ^ -(int32(src[0]>>2&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[0]<<3&0x18)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x18)|src[1]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[1]>>24&0x1f)>>1 ^ -(int32(src[1]>>24&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[1]>>19&0x1f)>>1 ^ -(int32(src[1]>>19&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[1]>>14&0x1f)>>1 ^ -(int32(src[1]>>14&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[1]>>9&0x1f)>>1 ^ -(int32(src[1]>>9&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[1]>>4&0x1f)>>1 ^ -(int32(src[1]>>4&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[1]<<1&0x1e)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1e)|src[2]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[2]>>26&0x1f)>>1 ^ -(int32(src[2]>>26&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[2]>>21&0x1f)>>1 ^ -(int32(src[2]>>21&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[2]>>16&0x1f)>>1 ^ -(int32(src[2]>>16&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[2]>>11&0x1f)>>1 ^ -(int32(src[2]>>11&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[2]>>6&0x1f)>>1 ^ -(int32(src[2]>>6&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[2]>>1&0x1f)>>1 ^ -(int32(src[2]>>1&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[2]<<4&0x10)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x10)|src[3]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[3]>>23&0x1f)>>1 ^ -(int32(src[3]>>23&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[3]>>18&0x1f)>>1 ^ -(int32(src[3]>>18&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[3]>>13&0x1f)>>1 ^ -(int32(src[3]>>13&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[3]>>8&0x1f)>>1 ^ -(int32(src[3]>>8&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[3]>>3&0x1f)>>1 ^ -(int32(src[3]>>3&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[3]<<2&0x1c)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1c)|src[4]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[4]>>25&0x1f)>>1 ^ -(int32(src[4]>>25&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[4]>>20&0x1f)>>1 ^ -(int32(src[4]>>20&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[4]>>15&0x1f)>>1 ^ -(int32(src[4]>>15&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[4]>>10&0x1f)>>1 ^ -(int32(src[4]>>10&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[4]>>5&0x1f)>>1 ^ -(int32(src[4]>>5&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[4]>>0&0x1f)>>1 ^ -(int32(src[4]>>0&0x1f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range6BitDelta[T Integer](src *[6]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>26&0x3f)>>1 ^ -(int32(src[0]>>26&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>20&0x3f)>>1 ^ -(int32(src[0]>>20&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>14&0x3f)>>1 ^ -(int32(src[0]>>14&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>8&0x3f)>>1 ^ -(int32(src[0]>>8&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[0]>>2&0x3f)>>1 ^ -(int32(src[0]>>2&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[0]<<4&0x30)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x30)|src[1]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[1]>>22&0x3f)>>1 ^ -(int32(src[1]>>22&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[1]>>16&0x3f)>>1 ^ -(int32(src[1]>>16&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[1]>>10&0x3f)>>1 ^ -(int32(src[1]>>10&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[1]>>4&0x3f)>>1 ^ -(int32(src[1]>>4&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[1]<<2&0x3c)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3c)|src[2]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[2]>>24&0x3f)>>1 ^ -(int32(src[2]>>24&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[2]>>18&0x3f)>>1 ^ -(int32(src[2]>>18&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[2]>>12&0x3f)>>1 ^ -(int32(src[2]>>12&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[2]>>6&0x3f)>>1 ^ -(int32(src[2]>>6&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[2]>>0&0x3f)>>1 ^ -(int32(src[2]>>0&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[3]>>26&0x3f)>>1 ^ -(int32(src[3]>>26&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[3]>>20&0x3f)>>1 ^ -(int32(src[3]>>20&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[3]>>14&0x3f)>>1 ^ -(int32(src[3]>>14&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[3]>>8&0x3f)>>1 ^ -(int32(src[3]>>8&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[3]>>2&0x3f)>>1 ^ -(int32(src[3]>>2&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[3]<<4&0x30)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x30)|src[4]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[4]>>22&0x3f)>>1 ^ -(int32(src[4]>>22&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[4]>>16&0x3f)>>1 ^ -(int32(src[4]>>16&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[4]>>10&0x3f)>>1 ^ -(int32(src[4]>>10&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[4]>>4&0x3f)>>1 ^ -(int32(src[4]>>4&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[4]<<2&0x3c)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3c)|src[5]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[5]>>24&0x3f)>>1 ^ -(int32(src[5]>>24&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[5]>>18&0x3f)>>1 ^ -(int32(src[5]>>18&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[5]>>12&0x3f)>>1 ^ -(int32(src[5]>>12&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[5]>>6&0x3f)>>1 ^ -(int32(src[5]>>6&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[5]>>0&0x3f)>>1 ^ -(int32(src[5]>>0&0x3f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range7BitDelta[T Integer](src *[7]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>25&0x7f)>>1 ^ -(int32(src[0]>>25&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>18&0x7f)>>1 ^ -(int32(src[0]>>18&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>11&0x7f)>>1 ^ -(int32(src[0]>>11&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>4&0x7f)>>1 ^ -(int32(src[0]>>4&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[0]<<3&0x78)|src[1]>>29)>>1 ^ -(int32((src[0]<<3&0x78)|src[1]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[1]>>22&0x7f)>>1 ^ -(int32(src[1]>>22&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[1]>>15&0x7f)>>1 ^ -(int32(src[1]>>15&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[1]>>8&0x7f)>>1 ^ -(int32(src[1]>>8&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[1]>>1&0x7f)>>1 ^ -(int32(src[1]>>1&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[1]<<6&0x40)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x40)|src[2]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[2]>>19&0x7f)>>1 ^ -(int32(src[2]>>19&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[2]>>12&0x7f)>>1 ^ -(int32(src[2]>>12&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[2]>>5&0x7f)>>1 ^ -(int32(src[2]>>5&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[2]<<2&0x7c)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x7c)|src[3]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[3]>>23&0x7f)>>1 ^ -(int32(src[3]>>23&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[3]>>16&0x7f)>>1 ^ -(int32(src[3]>>16&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[3]>>9&0x7f)>>1 ^ -(int32(src[3]>>9&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[3]>>2&0x7f)>>1 ^ -(int32(src[3]>>2&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[3]<<5&0x60)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x60)|src[4]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[4]>>20&0x7f)>>1 ^ -(int32(src[4]>>20&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[4]>>13&0x7f)>>1 ^ -(int32(src[4]>>13&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[4]>>6&0x7f)>>1 ^ -(int32(src[4]>>6&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[4]<<1&0x7e)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7e)|src[5]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[5]>>24&0x7f)>>1 ^ -(int32(src[5]>>24&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[5]>>17&0x7f)>>1 ^ -(int32(src[5]>>17&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[5]>>10&0x7f)>>1 ^ -(int32(src[5]>>10&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[5]>>3&0x7f)>>1 ^ -(int32(src[5]>>3&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[5]<<4&0x70)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x70)|src[6]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[6]>>21&0x7f)>>1 ^ -(int32(src[6]>>21&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[6]>>14&0x7f)>>1 ^ -(int32(src[6]>>14&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[6]>>7&0x7f)>>1 ^ -(int32(src[6]>>7&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[6]>>0&0x7f)>>1 ^ -(int32(src[6]>>0&0x7f) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range8BitDelta[T Integer](src *[8]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>24&0xff)>>1 ^ -(int32(src[0]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>16&0xff)>>1 ^ -(int32(src[0]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>8&0xff)>>1 ^ -(int32(src[0]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[0]>>0&0xff)>>1 ^ -(int32(src[0]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[1]>>24&0xff)>>1 ^ -(int32(src[1]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[1]>>16&0xff)>>1 ^ -(int32(src[1]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[1]>>8&0xff)>>1 ^ -(int32(src[1]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[1]>>0&0xff)>>1 ^ -(int32(src[1]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[2]>>24&0xff)>>1 ^ -(int32(src[2]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[2]>>16&0xff)>>1 ^ -(int32(src[2]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[2]>>8&0xff)>>1 ^ -(int32(src[2]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[2]>>0&0xff)>>1 ^ -(int32(src[2]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[3]>>24&0xff)>>1 ^ -(int32(src[3]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[3]>>16&0xff)>>1 ^ -(int32(src[3]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[3]>>8&0xff)>>1 ^ -(int32(src[3]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[3]>>0&0xff)>>1 ^ -(int32(src[3]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[4]>>24&0xff)>>1 ^ -(int32(src[4]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[4]>>16&0xff)>>1 ^ -(int32(src[4]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[4]>>8&0xff)>>1 ^ -(int32(src[4]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[4]>>0&0xff)>>1 ^ -(int32(src[4]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[5]>>24&0xff)>>1 ^ -(int32(src[5]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[5]>>16&0xff)>>1 ^ -(int32(src[5]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[5]>>8&0xff)>>1 ^ -(int32(src[5]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[5]>>0&0xff)>>1 ^ -(int32(src[5]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[6]>>24&0xff)>>1 ^ -(int32(src[6]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[6]>>16&0xff)>>1 ^ -(int32(src[6]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[6]>>8&0xff)>>1 ^ -(int32(src[6]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[6]>>0&0xff)>>1 ^ -(int32(src[6]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[7]>>24&0xff)>>1 ^ -(int32(src[7]>>24&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[7]>>16&0xff)>>1 ^ -(int32(src[7]>>16&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[7]>>8&0xff)>>1 ^ -(int32(src[7]>>8&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[7]>>0&0xff)>>1 ^ -(int32(src[7]>>0&0xff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range9BitDelta[T Integer](src *[9]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>23&0x1ff)>>1 ^ -(int32(src[0]>>23&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>14&0x1ff)>>1 ^ -(int32(src[0]>>14&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>5&0x1ff)>>1 ^ -(int32(src[0]>>5&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[0]<<4&0x1f0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x1f0)|src[1]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[1]>>19&0x1ff)>>1 ^ -(int32(src[1]>>19&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[1]>>10&0x1ff)>>1 ^ -(int32(src[1]>>10&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[1]>>1&0x1ff)>>1 ^ -(int32(src[1]>>1&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[1]<<8&0x100)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x100)|src[2]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[2]>>15&0x1ff)>>1 ^ -(int32(src[2]>>15&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[2]>>6&0x1ff)>>1 ^ -(int32(src[2]>>6&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[2]<<3&0x1f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x1f8)|src[3]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[3]>>20&0x1ff)>>1 ^ -(int32(src[3]>>20&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[3]>>11&0x1ff)>>1 ^ -(int32(src[3]>>11&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[3]>>2&0x1ff)>>1 ^ -(int32(src[3]>>2&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[3]<<7&0x180)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x180)|src[4]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[4]>>16&0x1ff)>>1 ^ -(int32(src[4]>>16&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[4]>>7&0x1ff)>>1 ^ -(int32(src[4]>>7&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[4]<<2&0x1fc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x1fc)|src[5]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[5]>>21&0x1ff)>>1 ^ -(int32(src[5]>>21&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[5]>>12&0x1ff)>>1 ^ -(int32(src[5]>>12&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[5]>>3&0x1ff)>>1 ^ -(int32(src[5]>>3&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[5]<<6&0x1c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x1c0)|src[6]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[6]>>17&0x1ff)>>1 ^ -(int32(src[6]>>17&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[6]>>8&0x1ff)>>1 ^ -(int32(src[6]>>8&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[6]<<1&0x1fe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fe)|src[7]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[7]>>22&0x1ff)>>1 ^ -(int32(src[7]>>22&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[7]>>13&0x1ff)>>1 ^ -(int32(src[7]>>13&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[7]>>4&0x1ff)>>1 ^ -(int32(src[7]>>4&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[7]<<5&0x1e0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1e0)|src[8]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[8]>>18&0x1ff)>>1 ^ -(int32(src[8]>>18&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[8]>>9&0x1ff)>>1 ^ -(int32(src[8]>>9&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[8]>>0&0x1ff)>>1 ^ -(int32(src[8]>>0&0x1ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range10BitDelta[T Integer](src *[10]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>22&0x3ff)>>1 ^ -(int32(src[0]>>22&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>12&0x3ff)>>1 ^ -(int32(src[0]>>12&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[0]>>2&0x3ff)>>1 ^ -(int32(src[0]>>2&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[0]<<8&0x300)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0x300)|src[1]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[1]>>14&0x3ff)>>1 ^ -(int32(src[1]>>14&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[1]>>4&0x3ff)>>1 ^ -(int32(src[1]>>4&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[1]<<6&0x3c0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3c0)|src[2]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[2]>>16&0x3ff)>>1 ^ -(int32(src[2]>>16&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[2]>>6&0x3ff)>>1 ^ -(int32(src[2]>>6&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[2]<<4&0x3f0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x3f0)|src[3]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[3]>>18&0x3ff)>>1 ^ -(int32(src[3]>>18&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[3]>>8&0x3ff)>>1 ^ -(int32(src[3]>>8&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[3]<<2&0x3fc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fc)|src[4]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[4]>>20&0x3ff)>>1 ^ -(int32(src[4]>>20&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[4]>>10&0x3ff)>>1 ^ -(int32(src[4]>>10&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[4]>>0&0x3ff)>>1 ^ -(int32(src[4]>>0&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[5]>>22&0x3ff)>>1 ^ -(int32(src[5]>>22&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[5]>>12&0x3ff)>>1 ^ -(int32(src[5]>>12&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[5]>>2&0x3ff)>>1 ^ -(int32(src[5]>>2&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[5]<<8&0x300)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x300)|src[6]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[6]>>14&0x3ff)>>1 ^ -(int32(src[6]>>14&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[6]>>4&0x3ff)>>1 ^ -(int32(src[6]>>4&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[6]<<6&0x3c0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x3c0)|src[7]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[7]>>16&0x3ff)>>1 ^ -(int32(src[7]>>16&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[7]>>6&0x3ff)>>1 ^ -(int32(src[7]>>6&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[7]<<4&0x3f0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3f0)|src[8]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[8]>>18&0x3ff)>>1 ^ -(int32(src[8]>>18&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[8]>>8&0x3ff)>>1 ^ -(int32(src[8]>>8&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[8]<<2&0x3fc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x3fc)|src[9]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[9]>>20&0x3ff)>>1 ^ -(int32(src[9]>>20&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[9]>>10&0x3ff)>>1 ^ -(int32(src[9]>>10&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[9]>>0&0x3ff)>>1 ^ -(int32(src[9]>>0&0x3ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range11BitDelta[T Integer](src *[11]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>21&0x7ff)>>1 ^ -(int32(src[0]>>21&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>10&0x7ff)>>1 ^ -(int32(src[0]>>10&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[0]<<1&0x7fe)|src[1]>>31)>>1 ^ -(int32((src[0]<<1&0x7fe)|src[1]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[1]>>20&0x7ff)>>1 ^ -(int32(src[1]>>20&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[1]>>9&0x7ff)>>1 ^ -(int32(src[1]>>9&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[1]<<2&0x7fc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x7fc)|src[2]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[2]>>19&0x7ff)>>1 ^ -(int32(src[2]>>19&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[2]>>8&0x7ff)>>1 ^ -(int32(src[2]>>8&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[2]<<3&0x7f8)|src[3]>>29)>>1 ^ -(int32((src[2]<<3&0x7f8)|src[3]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[3]>>18&0x7ff)>>1 ^ -(int32(src[3]>>18&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[3]>>7&0x7ff)>>1 ^ -(int32(src[3]>>7&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[3]<<4&0x7f0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x7f0)|src[4]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[4]>>17&0x7ff)>>1 ^ -(int32(src[4]>>17&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[4]>>6&0x7ff)>>1 ^ -(int32(src[4]>>6&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[4]<<5&0x7e0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7e0)|src[5]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[5]>>16&0x7ff)>>1 ^ -(int32(src[5]>>16&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[5]>>5&0x7ff)>>1 ^ -(int32(src[5]>>5&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[5]<<6&0x7c0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x7c0)|src[6]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[6]>>15&0x7ff)>>1 ^ -(int32(src[6]>>15&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[6]>>4&0x7ff)>>1 ^ -(int32(src[6]>>4&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[6]<<7&0x780)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x780)|src[7]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[7]>>14&0x7ff)>>1 ^ -(int32(src[7]>>14&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[7]>>3&0x7ff)>>1 ^ -(int32(src[7]>>3&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[7]<<8&0x700)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x700)|src[8]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[8]>>13&0x7ff)>>1 ^ -(int32(src[8]>>13&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[8]>>2&0x7ff)>>1 ^ -(int32(src[8]>>2&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[8]<<9&0x600)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x600)|src[9]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[9]>>12&0x7ff)>>1 ^ -(int32(src[9]>>12&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[9]>>1&0x7ff)>>1 ^ -(int32(src[9]>>1&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[9]<<10&0x400)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x400)|src[10]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[10]>>11&0x7ff)>>1 ^ -(int32(src[10]>>11&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[10]>>0&0x7ff)>>1 ^ -(int32(src[10]>>0&0x7ff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range12BitDelta[T Integer](src *[12]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>20&0xfff)>>1 ^ -(int32(src[0]>>20&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>8&0xfff)>>1 ^ -(int32(src[0]>>8&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[0]<<4&0xff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0xff0)|src[1]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[1]>>16&0xfff)>>1 ^ -(int32(src[1]>>16&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[1]>>4&0xfff)>>1 ^ -(int32(src[1]>>4&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[1]<<8&0xf00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xf00)|src[2]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[2]>>12&0xfff)>>1 ^ -(int32(src[2]>>12&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[2]>>0&0xfff)>>1 ^ -(int32(src[2]>>0&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[3]>>20&0xfff)>>1 ^ -(int32(src[3]>>20&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[3]>>8&0xfff)>>1 ^ -(int32(src[3]>>8&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[3]<<4&0xff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0xff0)|src[4]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[4]>>16&0xfff)>>1 ^ -(int32(src[4]>>16&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[4]>>4&0xfff)>>1 ^ -(int32(src[4]>>4&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[4]<<8&0xf00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xf00)|src[5]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[5]>>12&0xfff)>>1 ^ -(int32(src[5]>>12&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[5]>>0&0xfff)>>1 ^ -(int32(src[5]>>0&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[6]>>20&0xfff)>>1 ^ -(int32(src[6]>>20&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[6]>>8&0xfff)>>1 ^ -(int32(src[6]>>8&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[6]<<4&0xff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0xff0)|src[7]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[7]>>16&0xfff)>>1 ^ -(int32(src[7]>>16&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[7]>>4&0xfff)>>1 ^ -(int32(src[7]>>4&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[7]<<8&0xf00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xf00)|src[8]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[8]>>12&0xfff)>>1 ^ -(int32(src[8]>>12&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[8]>>0&0xfff)>>1 ^ -(int32(src[8]>>0&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[9]>>20&0xfff)>>1 ^ -(int32(src[9]>>20&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[9]>>8&0xfff)>>1 ^ -(int32(src[9]>>8&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[9]<<4&0xff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0xff0)|src[10]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[10]>>16&0xfff)>>1 ^ -(int32(src[10]>>16&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[10]>>4&0xfff)>>1 ^ -(int32(src[10]>>4&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[10]<<8&0xf00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xf00)|src[11]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[11]>>12&0xfff)>>1 ^ -(int32(src[11]>>12&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[11]>>0&0xfff)>>1 ^ -(int32(src[11]>>0&0xfff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range13BitDelta[T Integer](src *[13]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>19&0x1fff)>>1 ^ -(int32(src[0]>>19&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>6&0x1fff)>>1 ^ -(int32(src[0]>>6&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[0]<<7&0x1f80)|src[1]>>25)>>1 ^ -(int32((src[0]<<7&0x1f80)|src[1]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[1]>>12&0x1fff)>>1 ^ -(int32(src[1]>>12&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[1]<<1&0x1ffe)|src[2]>>31)>>1 ^ -(int32((src[1]<<1&0x1ffe)|src[2]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[2]>>18&0x1fff)>>1 ^ -(int32(src[2]>>18&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[2]>>5&0x1fff)>>1 ^ -(int32(src[2]>>5&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[2]<<8&0x1f00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x1f00)|src[3]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[3]>>11&0x1fff)>>1 ^ -(int32(src[3]>>11&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[3]<<2&0x1ffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x1ffc)|src[4]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[4]>>17&0x1fff)>>1 ^ -(int32(src[4]>>17&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[4]>>4&0x1fff)>>1 ^ -(int32(src[4]>>4&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[4]<<9&0x1e00)|src[5]>>23)>>1 ^ -(int32((src[4]<<9&0x1e00)|src[5]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[5]>>10&0x1fff)>>1 ^ -(int32(src[5]>>10&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[5]<<3&0x1ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x1ff8)|src[6]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[6]>>16&0x1fff)>>1 ^ -(int32(src[6]>>16&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[6]>>3&0x1fff)>>1 ^ -(int32(src[6]>>3&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[6]<<10&0x1c00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x1c00)|src[7]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[7]>>9&0x1fff)>>1 ^ -(int32(src[7]>>9&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[7]<<4&0x1ff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x1ff0)|src[8]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[8]>>15&0x1fff)>>1 ^ -(int32(src[8]>>15&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[8]>>2&0x1fff)>>1 ^ -(int32(src[8]>>2&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[8]<<11&0x1800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x1800)|src[9]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[9]>>8&0x1fff)>>1 ^ -(int32(src[9]>>8&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[9]<<5&0x1fe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1fe0)|src[10]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[10]>>14&0x1fff)>>1 ^ -(int32(src[10]>>14&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[10]>>1&0x1fff)>>1 ^ -(int32(src[10]>>1&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[10]<<12&0x1000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x1000)|src[11]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[11]>>7&0x1fff)>>1 ^ -(int32(src[11]>>7&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[11]<<6&0x1fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x1fc0)|src[12]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[12]>>13&0x1fff)>>1 ^ -(int32(src[12]>>13&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[12]>>0&0x1fff)>>1 ^ -(int32(src[12]>>0&0x1fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range14BitDelta[T Integer](src *[14]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>18&0x3fff)>>1 ^ -(int32(src[0]>>18&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>4&0x3fff)>>1 ^ -(int32(src[0]>>4&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[0]<<10&0x3c00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x3c00)|src[1]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[1]>>8&0x3fff)>>1 ^ -(int32(src[1]>>8&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[1]<<6&0x3fc0)|src[2]>>26)>>1 ^ -(int32((src[1]<<6&0x3fc0)|src[2]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[2]>>12&0x3fff)>>1 ^ -(int32(src[2]>>12&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[2]<<2&0x3ffc)|src[3]>>30)>>1 ^ -(int32((src[2]<<2&0x3ffc)|src[3]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[3]>>16&0x3fff)>>1 ^ -(int32(src[3]>>16&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[3]>>2&0x3fff)>>1 ^ -(int32(src[3]>>2&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[3]<<12&0x3000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0x3000)|src[4]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[4]>>6&0x3fff)>>1 ^ -(int32(src[4]>>6&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[4]<<8&0x3f00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x3f00)|src[5]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[5]>>10&0x3fff)>>1 ^ -(int32(src[5]>>10&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[5]<<4&0x3ff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0x3ff0)|src[6]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[6]>>14&0x3fff)>>1 ^ -(int32(src[6]>>14&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[6]>>0&0x3fff)>>1 ^ -(int32(src[6]>>0&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[7]>>18&0x3fff)>>1 ^ -(int32(src[7]>>18&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[7]>>4&0x3fff)>>1 ^ -(int32(src[7]>>4&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[7]<<10&0x3c00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x3c00)|src[8]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[8]>>8&0x3fff)>>1 ^ -(int32(src[8]>>8&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[8]<<6&0x3fc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x3fc0)|src[9]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[9]>>12&0x3fff)>>1 ^ -(int32(src[9]>>12&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[9]<<2&0x3ffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x3ffc)|src[10]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[10]>>16&0x3fff)>>1 ^ -(int32(src[10]>>16&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[10]>>2&0x3fff)>>1 ^ -(int32(src[10]>>2&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[10]<<12&0x3000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3000)|src[11]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[11]>>6&0x3fff)>>1 ^ -(int32(src[11]>>6&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[11]<<8&0x3f00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0x3f00)|src[12]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[12]>>10&0x3fff)>>1 ^ -(int32(src[12]>>10&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[12]<<4&0x3ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ff0)|src[13]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[13]>>14&0x3fff)>>1 ^ -(int32(src[13]>>14&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[13]>>0&0x3fff)>>1 ^ -(int32(src[13]>>0&0x3fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range15BitDelta[T Integer](src *[15]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>17&0x7fff)>>1 ^ -(int32(src[0]>>17&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>2&0x7fff)>>1 ^ -(int32(src[0]>>2&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[0]<<13&0x6000)|src[1]>>19)>>1 ^ -(int32((src[0]<<13&0x6000)|src[1]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[1]>>4&0x7fff)>>1 ^ -(int32(src[1]>>4&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[1]<<11&0x7800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x7800)|src[2]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[2]>>6&0x7fff)>>1 ^ -(int32(src[2]>>6&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[2]<<9&0x7e00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x7e00)|src[3]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[3]>>8&0x7fff)>>1 ^ -(int32(src[3]>>8&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[3]<<7&0x7f80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7f80)|src[4]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[4]>>10&0x7fff)>>1 ^ -(int32(src[4]>>10&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[4]<<5&0x7fe0)|src[5]>>27)>>1 ^ -(int32((src[4]<<5&0x7fe0)|src[5]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[5]>>12&0x7fff)>>1 ^ -(int32(src[5]>>12&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[5]<<3&0x7ff8)|src[6]>>29)>>1 ^ -(int32((src[5]<<3&0x7ff8)|src[6]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[6]>>14&0x7fff)>>1 ^ -(int32(src[6]>>14&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[6]<<1&0x7ffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x7ffe)|src[7]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[7]>>16&0x7fff)>>1 ^ -(int32(src[7]>>16&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[7]>>1&0x7fff)>>1 ^ -(int32(src[7]>>1&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[7]<<14&0x4000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x4000)|src[8]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[8]>>3&0x7fff)>>1 ^ -(int32(src[8]>>3&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[8]<<12&0x7000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x7000)|src[9]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[9]>>5&0x7fff)>>1 ^ -(int32(src[9]>>5&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[9]<<10&0x7c00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x7c00)|src[10]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[10]>>7&0x7fff)>>1 ^ -(int32(src[10]>>7&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[10]<<8&0x7f00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x7f00)|src[11]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[11]>>9&0x7fff)>>1 ^ -(int32(src[11]>>9&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[11]<<6&0x7fc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x7fc0)|src[12]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[12]>>11&0x7fff)>>1 ^ -(int32(src[12]>>11&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[12]<<4&0x7ff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x7ff0)|src[13]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[13]>>13&0x7fff)>>1 ^ -(int32(src[13]>>13&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[13]<<2&0x7ffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x7ffc)|src[14]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[14]>>15&0x7fff)>>1 ^ -(int32(src[14]>>15&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[14]>>0&0x7fff)>>1 ^ -(int32(src[14]>>0&0x7fff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range16BitDelta[T Integer](src *[16]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>16&0xffff)>>1 ^ -(int32(src[0]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32(src[0]>>0&0xffff)>>1 ^ -(int32(src[0]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[1]>>16&0xffff)>>1 ^ -(int32(src[1]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[1]>>0&0xffff)>>1 ^ -(int32(src[1]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[2]>>16&0xffff)>>1 ^ -(int32(src[2]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[2]>>0&0xffff)>>1 ^ -(int32(src[2]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[3]>>16&0xffff)>>1 ^ -(int32(src[3]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[3]>>0&0xffff)>>1 ^ -(int32(src[3]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[4]>>16&0xffff)>>1 ^ -(int32(src[4]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[4]>>0&0xffff)>>1 ^ -(int32(src[4]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[5]>>16&0xffff)>>1 ^ -(int32(src[5]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[5]>>0&0xffff)>>1 ^ -(int32(src[5]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[6]>>16&0xffff)>>1 ^ -(int32(src[6]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[6]>>0&0xffff)>>1 ^ -(int32(src[6]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[7]>>16&0xffff)>>1 ^ -(int32(src[7]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[7]>>0&0xffff)>>1 ^ -(int32(src[7]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[8]>>16&0xffff)>>1 ^ -(int32(src[8]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[8]>>0&0xffff)>>1 ^ -(int32(src[8]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[9]>>16&0xffff)>>1 ^ -(int32(src[9]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[9]>>0&0xffff)>>1 ^ -(int32(src[9]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[10]>>16&0xffff)>>1 ^ -(int32(src[10]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[10]>>0&0xffff)>>1 ^ -(int32(src[10]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[11]>>16&0xffff)>>1 ^ -(int32(src[11]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[11]>>0&0xffff)>>1 ^ -(int32(src[11]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[12]>>16&0xffff)>>1 ^ -(int32(src[12]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[12]>>0&0xffff)>>1 ^ -(int32(src[12]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[13]>>16&0xffff)>>1 ^ -(int32(src[13]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[13]>>0&0xffff)>>1 ^ -(int32(src[13]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[14]>>16&0xffff)>>1 ^ -(int32(src[14]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[14]>>0&0xffff)>>1 ^ -(int32(src[14]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32(src[15]>>16&0xffff)>>1 ^ -(int32(src[15]>>16&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[15]>>0&0xffff)>>1 ^ -(int32(src[15]>>0&0xffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range17BitDelta[T Integer](src *[17]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>15&0x1ffff)>>1 ^ -(int32(src[0]>>15&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<2&0x1fffc)|src[1]>>30)>>1 ^ -(int32((src[0]<<2&0x1fffc)|src[1]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[1]>>13&0x1ffff)>>1 ^ -(int32(src[1]>>13&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[1]<<4&0x1fff0)|src[2]>>28)>>1 ^ -(int32((src[1]<<4&0x1fff0)|src[2]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[2]>>11&0x1ffff)>>1 ^ -(int32(src[2]>>11&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[2]<<6&0x1ffc0)|src[3]>>26)>>1 ^ -(int32((src[2]<<6&0x1ffc0)|src[3]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[3]>>9&0x1ffff)>>1 ^ -(int32(src[3]>>9&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[3]<<8&0x1ff00)|src[4]>>24)>>1 ^ -(int32((src[3]<<8&0x1ff00)|src[4]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[4]>>7&0x1ffff)>>1 ^ -(int32(src[4]>>7&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[4]<<10&0x1fc00)|src[5]>>22)>>1 ^ -(int32((src[4]<<10&0x1fc00)|src[5]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[5]>>5&0x1ffff)>>1 ^ -(int32(src[5]>>5&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[5]<<12&0x1f000)|src[6]>>20)>>1 ^ -(int32((src[5]<<12&0x1f000)|src[6]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[6]>>3&0x1ffff)>>1 ^ -(int32(src[6]>>3&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[6]<<14&0x1c000)|src[7]>>18)>>1 ^ -(int32((src[6]<<14&0x1c000)|src[7]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[7]>>1&0x1ffff)>>1 ^ -(int32(src[7]>>1&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[7]<<16&0x10000)|src[8]>>16)>>1 ^ -(int32((src[7]<<16&0x10000)|src[8]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[8]<<1&0x1fffe)|src[9]>>31)>>1 ^ -(int32((src[8]<<1&0x1fffe)|src[9]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[9]>>14&0x1ffff)>>1 ^ -(int32(src[9]>>14&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[9]<<3&0x1fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x1fff8)|src[10]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[10]>>12&0x1ffff)>>1 ^ -(int32(src[10]>>12&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[10]<<5&0x1ffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1ffe0)|src[11]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[11]>>10&0x1ffff)>>1 ^ -(int32(src[11]>>10&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[11]<<7&0x1ff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x1ff80)|src[12]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[12]>>8&0x1ffff)>>1 ^ -(int32(src[12]>>8&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[12]<<9&0x1fe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fe00)|src[13]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[13]>>6&0x1ffff)>>1 ^ -(int32(src[13]>>6&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[13]<<11&0x1f800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x1f800)|src[14]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[14]>>4&0x1ffff)>>1 ^ -(int32(src[14]>>4&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[14]<<13&0x1e000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1e000)|src[15]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[15]>>2&0x1ffff)>>1 ^ -(int32(src[15]>>2&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[15]<<15&0x18000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x18000)|src[16]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[16]>>0&0x1ffff)>>1 ^ -(int32(src[16]>>0&0x1ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range18BitDelta[T Integer](src *[18]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>14&0x3ffff)>>1 ^ -(int32(src[0]>>14&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<4&0x3fff0)|src[1]>>28)>>1 ^ -(int32((src[0]<<4&0x3fff0)|src[1]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[1]>>10&0x3ffff)>>1 ^ -(int32(src[1]>>10&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[1]<<8&0x3ff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0x3ff00)|src[2]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[2]>>6&0x3ffff)>>1 ^ -(int32(src[2]>>6&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[2]<<12&0x3f000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x3f000)|src[3]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[3]>>2&0x3ffff)>>1 ^ -(int32(src[3]>>2&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[3]<<16&0x30000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0x30000)|src[4]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[4]<<2&0x3fffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x3fffc)|src[5]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[5]>>12&0x3ffff)>>1 ^ -(int32(src[5]>>12&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[5]<<6&0x3ffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3ffc0)|src[6]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[6]>>8&0x3ffff)>>1 ^ -(int32(src[6]>>8&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[6]<<10&0x3fc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fc00)|src[7]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[7]>>4&0x3ffff)>>1 ^ -(int32(src[7]>>4&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[7]<<14&0x3c000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3c000)|src[8]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[8]>>0&0x3ffff)>>1 ^ -(int32(src[8]>>0&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[9]>>14&0x3ffff)>>1 ^ -(int32(src[9]>>14&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[9]<<4&0x3fff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x3fff0)|src[10]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[10]>>10&0x3ffff)>>1 ^ -(int32(src[10]>>10&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[10]<<8&0x3ff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3ff00)|src[11]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[11]>>6&0x3ffff)>>1 ^ -(int32(src[11]>>6&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[11]<<12&0x3f000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3f000)|src[12]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[12]>>2&0x3ffff)>>1 ^ -(int32(src[12]>>2&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[12]<<16&0x30000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x30000)|src[13]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[13]<<2&0x3fffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3fffc)|src[14]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[14]>>12&0x3ffff)>>1 ^ -(int32(src[14]>>12&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[14]<<6&0x3ffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x3ffc0)|src[15]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[15]>>8&0x3ffff)>>1 ^ -(int32(src[15]>>8&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[15]<<10&0x3fc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x3fc00)|src[16]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[16]>>4&0x3ffff)>>1 ^ -(int32(src[16]>>4&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[16]<<14&0x3c000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x3c000)|src[17]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[17]>>0&0x3ffff)>>1 ^ -(int32(src[17]>>0&0x3ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range19BitDelta[T Integer](src *[19]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>13&0x7ffff)>>1 ^ -(int32(src[0]>>13&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<6&0x7ffc0)|src[1]>>26)>>1 ^ -(int32((src[0]<<6&0x7ffc0)|src[1]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[1]>>7&0x7ffff)>>1 ^ -(int32(src[1]>>7&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[1]<<12&0x7f000)|src[2]>>20)>>1 ^ -(int32((src[1]<<12&0x7f000)|src[2]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[2]>>1&0x7ffff)>>1 ^ -(int32(src[2]>>1&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[2]<<18&0x40000)|src[3]>>14)>>1 ^ -(int32((src[2]<<18&0x40000)|src[3]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[3]<<5&0x7ffe0)|src[4]>>27)>>1 ^ -(int32((src[3]<<5&0x7ffe0)|src[4]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[4]>>8&0x7ffff)>>1 ^ -(int32(src[4]>>8&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[4]<<11&0x7f800)|src[5]>>21)>>1 ^ -(int32((src[4]<<11&0x7f800)|src[5]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[5]>>2&0x7ffff)>>1 ^ -(int32(src[5]>>2&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[5]<<17&0x60000)|src[6]>>15)>>1 ^ -(int32((src[5]<<17&0x60000)|src[6]>>15) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[6]<<4&0x7fff0)|src[7]>>28)>>1 ^ -(int32((src[6]<<4&0x7fff0)|src[7]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[7]>>9&0x7ffff)>>1 ^ -(int32(src[7]>>9&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[7]<<10&0x7fc00)|src[8]>>22)>>1 ^ -(int32((src[7]<<10&0x7fc00)|src[8]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[8]>>3&0x7ffff)>>1 ^ -(int32(src[8]>>3&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[8]<<16&0x70000)|src[9]>>16)>>1 ^ -(int32((src[8]<<16&0x70000)|src[9]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[9]<<3&0x7fff8)|src[10]>>29)>>1 ^ -(int32((src[9]<<3&0x7fff8)|src[10]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[10]>>10&0x7ffff)>>1 ^ -(int32(src[10]>>10&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[10]<<9&0x7fe00)|src[11]>>23)>>1 ^ -(int32((src[10]<<9&0x7fe00)|src[11]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[11]>>4&0x7ffff)>>1 ^ -(int32(src[11]>>4&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[11]<<15&0x78000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x78000)|src[12]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[12]<<2&0x7fffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x7fffc)|src[13]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[13]>>11&0x7ffff)>>1 ^ -(int32(src[13]>>11&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[13]<<8&0x7ff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0x7ff00)|src[14]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[14]>>5&0x7ffff)>>1 ^ -(int32(src[14]>>5&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[14]<<14&0x7c000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x7c000)|src[15]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[15]<<1&0x7fffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffe)|src[16]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[16]>>12&0x7ffff)>>1 ^ -(int32(src[16]>>12&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[16]<<7&0x7ff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x7ff80)|src[17]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[17]>>6&0x7ffff)>>1 ^ -(int32(src[17]>>6&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[17]<<13&0x7e000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7e000)|src[18]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[18]>>0&0x7ffff)>>1 ^ -(int32(src[18]>>0&0x7ffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range20BitDelta[T Integer](src *[20]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>12&0xfffff)>>1 ^ -(int32(src[0]>>12&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<8&0xfff00)|src[1]>>24)>>1 ^ -(int32((src[0]<<8&0xfff00)|src[1]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[1]>>4&0xfffff)>>1 ^ -(int32(src[1]>>4&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[1]<<16&0xf0000)|src[2]>>16)>>1 ^ -(int32((src[1]<<16&0xf0000)|src[2]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[2]<<4&0xffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0xffff0)|src[3]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[3]>>8&0xfffff)>>1 ^ -(int32(src[3]>>8&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[3]<<12&0xff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xff000)|src[4]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[4]>>0&0xfffff)>>1 ^ -(int32(src[4]>>0&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[5]>>12&0xfffff)>>1 ^ -(int32(src[5]>>12&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[5]<<8&0xfff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0xfff00)|src[6]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[6]>>4&0xfffff)>>1 ^ -(int32(src[6]>>4&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[6]<<16&0xf0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xf0000)|src[7]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[7]<<4&0xffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0xffff0)|src[8]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[8]>>8&0xfffff)>>1 ^ -(int32(src[8]>>8&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[8]<<12&0xff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0xff000)|src[9]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[9]>>0&0xfffff)>>1 ^ -(int32(src[9]>>0&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[10]>>12&0xfffff)>>1 ^ -(int32(src[10]>>12&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[10]<<8&0xfff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xfff00)|src[11]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[11]>>4&0xfffff)>>1 ^ -(int32(src[11]>>4&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[11]<<16&0xf0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0xf0000)|src[12]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[12]<<4&0xffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffff0)|src[13]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[13]>>8&0xfffff)>>1 ^ -(int32(src[13]>>8&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[13]<<12&0xff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0xff000)|src[14]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[14]>>0&0xfffff)>>1 ^ -(int32(src[14]>>0&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[15]>>12&0xfffff)>>1 ^ -(int32(src[15]>>12&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[15]<<8&0xfff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0xfff00)|src[16]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[16]>>4&0xfffff)>>1 ^ -(int32(src[16]>>4&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[16]<<16&0xf0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xf0000)|src[17]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[17]<<4&0xffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0xffff0)|src[18]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[18]>>8&0xfffff)>>1 ^ -(int32(src[18]>>8&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[18]<<12&0xff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0xff000)|src[19]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[19]>>0&0xfffff)>>1 ^ -(int32(src[19]>>0&0xfffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range21BitDelta[T Integer](src *[21]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>11&0x1fffff)>>1 ^ -(int32(src[0]>>11&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<10&0x1ffc00)|src[1]>>22)>>1 ^ -(int32((src[0]<<10&0x1ffc00)|src[1]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32(src[1]>>1&0x1fffff)>>1 ^ -(int32(src[1]>>1&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[1]<<20&0x100000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0x100000)|src[2]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[2]<<9&0x1ffe00)|src[3]>>23)>>1 ^ -(int32((src[2]<<9&0x1ffe00)|src[3]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[3]>>2&0x1fffff)>>1 ^ -(int32(src[3]>>2&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[3]<<19&0x180000)|src[4]>>13)>>1 ^ -(int32((src[3]<<19&0x180000)|src[4]>>13) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[4]<<8&0x1fff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0x1fff00)|src[5]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[5]>>3&0x1fffff)>>1 ^ -(int32(src[5]>>3&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[5]<<18&0x1c0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x1c0000)|src[6]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[6]<<7&0x1fff80)|src[7]>>25)>>1 ^ -(int32((src[6]<<7&0x1fff80)|src[7]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[7]>>4&0x1fffff)>>1 ^ -(int32(src[7]>>4&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[7]<<17&0x1e0000)|src[8]>>15)>>1 ^ -(int32((src[7]<<17&0x1e0000)|src[8]>>15) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[8]<<6&0x1fffc0)|src[9]>>26)>>1 ^ -(int32((src[8]<<6&0x1fffc0)|src[9]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[9]>>5&0x1fffff)>>1 ^ -(int32(src[9]>>5&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[9]<<16&0x1f0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0x1f0000)|src[10]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[10]<<5&0x1fffe0)|src[11]>>27)>>1 ^ -(int32((src[10]<<5&0x1fffe0)|src[11]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[11]>>6&0x1fffff)>>1 ^ -(int32(src[11]>>6&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[11]<<15&0x1f8000)|src[12]>>17)>>1 ^ -(int32((src[11]<<15&0x1f8000)|src[12]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[12]<<4&0x1ffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x1ffff0)|src[13]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[13]>>7&0x1fffff)>>1 ^ -(int32(src[13]>>7&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[13]<<14&0x1fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x1fc000)|src[14]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[14]<<3&0x1ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x1ffff8)|src[15]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[15]>>8&0x1fffff)>>1 ^ -(int32(src[15]>>8&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[15]<<13&0x1fe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1fe000)|src[16]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[16]<<2&0x1ffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x1ffffc)|src[17]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[17]>>9&0x1fffff)>>1 ^ -(int32(src[17]>>9&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[17]<<12&0x1ff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0x1ff000)|src[18]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[18]<<1&0x1ffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffe)|src[19]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32(src[19]>>10&0x1fffff)>>1 ^ -(int32(src[19]>>10&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[19]<<11&0x1ff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x1ff800)|src[20]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[20]>>0&0x1fffff)>>1 ^ -(int32(src[20]>>0&0x1fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range22BitDelta[T Integer](src *[22]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>10&0x3fffff)>>1 ^ -(int32(src[0]>>10&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<12&0x3ff000)|src[1]>>20)>>1 ^ -(int32((src[0]<<12&0x3ff000)|src[1]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<2&0x3ffffc)|src[2]>>30)>>1 ^ -(int32((src[1]<<2&0x3ffffc)|src[2]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[2]>>8&0x3fffff)>>1 ^ -(int32(src[2]>>8&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[2]<<14&0x3fc000)|src[3]>>18)>>1 ^ -(int32((src[2]<<14&0x3fc000)|src[3]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[3]<<4&0x3ffff0)|src[4]>>28)>>1 ^ -(int32((src[3]<<4&0x3ffff0)|src[4]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[4]>>6&0x3fffff)>>1 ^ -(int32(src[4]>>6&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[4]<<16&0x3f0000)|src[5]>>16)>>1 ^ -(int32((src[4]<<16&0x3f0000)|src[5]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[5]<<6&0x3fffc0)|src[6]>>26)>>1 ^ -(int32((src[5]<<6&0x3fffc0)|src[6]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[6]>>4&0x3fffff)>>1 ^ -(int32(src[6]>>4&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[6]<<18&0x3c0000)|src[7]>>14)>>1 ^ -(int32((src[6]<<18&0x3c0000)|src[7]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[7]<<8&0x3fff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0x3fff00)|src[8]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[8]>>2&0x3fffff)>>1 ^ -(int32(src[8]>>2&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[8]<<20&0x300000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0x300000)|src[9]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[9]<<10&0x3ffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffc00)|src[10]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[10]>>0&0x3fffff)>>1 ^ -(int32(src[10]>>0&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[11]>>10&0x3fffff)>>1 ^ -(int32(src[11]>>10&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[11]<<12&0x3ff000)|src[12]>>20)>>1 ^ -(int32((src[11]<<12&0x3ff000)|src[12]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[12]<<2&0x3ffffc)|src[13]>>30)>>1 ^ -(int32((src[12]<<2&0x3ffffc)|src[13]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[13]>>8&0x3fffff)>>1 ^ -(int32(src[13]>>8&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[13]<<14&0x3fc000)|src[14]>>18)>>1 ^ -(int32((src[13]<<14&0x3fc000)|src[14]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[14]<<4&0x3ffff0)|src[15]>>28)>>1 ^ -(int32((src[14]<<4&0x3ffff0)|src[15]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[15]>>6&0x3fffff)>>1 ^ -(int32(src[15]>>6&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[15]<<16&0x3f0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0x3f0000)|src[16]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[16]<<6&0x3fffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x3fffc0)|src[17]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[17]>>4&0x3fffff)>>1 ^ -(int32(src[17]>>4&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[17]<<18&0x3c0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x3c0000)|src[18]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[18]<<8&0x3fff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0x3fff00)|src[19]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[19]>>2&0x3fffff)>>1 ^ -(int32(src[19]>>2&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[19]<<20&0x300000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x300000)|src[20]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[20]<<10&0x3ffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x3ffc00)|src[21]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[21]>>0&0x3fffff)>>1 ^ -(int32(src[21]>>0&0x3fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range23BitDelta[T Integer](src *[23]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>9&0x7fffff)>>1 ^ -(int32(src[0]>>9&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<14&0x7fc000)|src[1]>>18)>>1 ^ -(int32((src[0]<<14&0x7fc000)|src[1]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<5&0x7fffe0)|src[2]>>27)>>1 ^ -(int32((src[1]<<5&0x7fffe0)|src[2]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[2]>>4&0x7fffff)>>1 ^ -(int32(src[2]>>4&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[2]<<19&0x780000)|src[3]>>13)>>1 ^ -(int32((src[2]<<19&0x780000)|src[3]>>13) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[3]<<10&0x7ffc00)|src[4]>>22)>>1 ^ -(int32((src[3]<<10&0x7ffc00)|src[4]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[4]<<1&0x7ffffe)|src[5]>>31)>>1 ^ -(int32((src[4]<<1&0x7ffffe)|src[5]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[5]>>8&0x7fffff)>>1 ^ -(int32(src[5]>>8&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[5]<<15&0x7f8000)|src[6]>>17)>>1 ^ -(int32((src[5]<<15&0x7f8000)|src[6]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[6]<<6&0x7fffc0)|src[7]>>26)>>1 ^ -(int32((src[6]<<6&0x7fffc0)|src[7]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[7]>>3&0x7fffff)>>1 ^ -(int32(src[7]>>3&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[7]<<20&0x700000)|src[8]>>12)>>1 ^ -(int32((src[7]<<20&0x700000)|src[8]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[8]<<11&0x7ff800)|src[9]>>21)>>1 ^ -(int32((src[8]<<11&0x7ff800)|src[9]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[9]<<2&0x7ffffc)|src[10]>>30)>>1 ^ -(int32((src[9]<<2&0x7ffffc)|src[10]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32(src[10]>>7&0x7fffff)>>1 ^ -(int32(src[10]>>7&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[10]<<16&0x7f0000)|src[11]>>16)>>1 ^ -(int32((src[10]<<16&0x7f0000)|src[11]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[11]<<7&0x7fff80)|src[12]>>25)>>1 ^ -(int32((src[11]<<7&0x7fff80)|src[12]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32(src[12]>>2&0x7fffff)>>1 ^ -(int32(src[12]>>2&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[12]<<21&0x600000)|src[13]>>11)>>1 ^ -(int32((src[12]<<21&0x600000)|src[13]>>11) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[13]<<12&0x7ff000)|src[14]>>20)>>1 ^ -(int32((src[13]<<12&0x7ff000)|src[14]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[14]<<3&0x7ffff8)|src[15]>>29)>>1 ^ -(int32((src[14]<<3&0x7ffff8)|src[15]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[15]>>6&0x7fffff)>>1 ^ -(int32(src[15]>>6&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[15]<<17&0x7e0000)|src[16]>>15)>>1 ^ -(int32((src[15]<<17&0x7e0000)|src[16]>>15) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[16]<<8&0x7fff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0x7fff00)|src[17]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[17]>>1&0x7fffff)>>1 ^ -(int32(src[17]>>1&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[17]<<22&0x400000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x400000)|src[18]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[18]<<13&0x7fe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7fe000)|src[19]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[19]<<4&0x7ffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0x7ffff0)|src[20]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[20]>>5&0x7fffff)>>1 ^ -(int32(src[20]>>5&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[20]<<18&0x7c0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x7c0000)|src[21]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[21]<<9&0x7ffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffe00)|src[22]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[22]>>0&0x7fffff)>>1 ^ -(int32(src[22]>>0&0x7fffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range24BitDelta[T Integer](src *[24]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>8&0xffffff)>>1 ^ -(int32(src[0]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<16&0xff0000)|src[1]>>16)>>1 ^ -(int32((src[0]<<16&0xff0000)|src[1]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<8&0xffff00)|src[2]>>24)>>1 ^ -(int32((src[1]<<8&0xffff00)|src[2]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32(src[2]>>0&0xffffff)>>1 ^ -(int32(src[2]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[3]>>8&0xffffff)>>1 ^ -(int32(src[3]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[3]<<16&0xff0000)|src[4]>>16)>>1 ^ -(int32((src[3]<<16&0xff0000)|src[4]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[4]<<8&0xffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xffff00)|src[5]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[5]>>0&0xffffff)>>1 ^ -(int32(src[5]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[6]>>8&0xffffff)>>1 ^ -(int32(src[6]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[6]<<16&0xff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0xff0000)|src[7]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[7]<<8&0xffff00)|src[8]>>24)>>1 ^ -(int32((src[7]<<8&0xffff00)|src[8]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32(src[8]>>0&0xffffff)>>1 ^ -(int32(src[8]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[9]>>8&0xffffff)>>1 ^ -(int32(src[9]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[9]<<16&0xff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xff0000)|src[10]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[10]<<8&0xffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0xffff00)|src[11]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[11]>>0&0xffffff)>>1 ^ -(int32(src[11]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[12]>>8&0xffffff)>>1 ^ -(int32(src[12]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[12]<<16&0xff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0xff0000)|src[13]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[13]<<8&0xffff00)|src[14]>>24)>>1 ^ -(int32((src[13]<<8&0xffff00)|src[14]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[14]>>0&0xffffff)>>1 ^ -(int32(src[14]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32(src[15]>>8&0xffffff)>>1 ^ -(int32(src[15]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[15]<<16&0xff0000)|src[16]>>16)>>1 ^ -(int32((src[15]<<16&0xff0000)|src[16]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[16]<<8&0xffff00)|src[17]>>24)>>1 ^ -(int32((src[16]<<8&0xffff00)|src[17]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[17]>>0&0xffffff)>>1 ^ -(int32(src[17]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[18]>>8&0xffffff)>>1 ^ -(int32(src[18]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[18]<<16&0xff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0xff0000)|src[19]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[19]<<8&0xffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0xffff00)|src[20]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[20]>>0&0xffffff)>>1 ^ -(int32(src[20]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32(src[21]>>8&0xffffff)>>1 ^ -(int32(src[21]>>8&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[21]<<16&0xff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0xff0000)|src[22]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[22]<<8&0xffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0xffff00)|src[23]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[23]>>0&0xffffff)>>1 ^ -(int32(src[23]>>0&0xffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range25BitDelta[T Integer](src *[25]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>7&0x1ffffff)>>1 ^ -(int32(src[0]>>7&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<18&0x1fc0000)|src[1]>>14)>>1 ^ -(int32((src[0]<<18&0x1fc0000)|src[1]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<11&0x1fff800)|src[2]>>21)>>1 ^ -(int32((src[1]<<11&0x1fff800)|src[2]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[2]<<4&0x1fffff0)|src[3]>>28)>>1 ^ -(int32((src[2]<<4&0x1fffff0)|src[3]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32(src[3]>>3&0x1ffffff)>>1 ^ -(int32(src[3]>>3&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[3]<<22&0x1c00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x1c00000)|src[4]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[4]<<15&0x1ff8000)|src[5]>>17)>>1 ^ -(int32((src[4]<<15&0x1ff8000)|src[5]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[5]<<8&0x1ffff00)|src[6]>>24)>>1 ^ -(int32((src[5]<<8&0x1ffff00)|src[6]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[6]<<1&0x1fffffe)|src[7]>>31)>>1 ^ -(int32((src[6]<<1&0x1fffffe)|src[7]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32(src[7]>>6&0x1ffffff)>>1 ^ -(int32(src[7]>>6&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[7]<<19&0x1f80000)|src[8]>>13)>>1 ^ -(int32((src[7]<<19&0x1f80000)|src[8]>>13) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[8]<<12&0x1fff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x1fff000)|src[9]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[9]<<5&0x1ffffe0)|src[10]>>27)>>1 ^ -(int32((src[9]<<5&0x1ffffe0)|src[10]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32(src[10]>>2&0x1ffffff)>>1 ^ -(int32(src[10]>>2&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[10]<<23&0x1800000)|src[11]>>9)>>1 ^ -(int32((src[10]<<23&0x1800000)|src[11]>>9) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[11]<<16&0x1ff0000)|src[12]>>16)>>1 ^ -(int32((src[11]<<16&0x1ff0000)|src[12]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[12]<<9&0x1fffe00)|src[13]>>23)>>1 ^ -(int32((src[12]<<9&0x1fffe00)|src[13]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[13]<<2&0x1fffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x1fffffc)|src[14]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32(src[14]>>5&0x1ffffff)>>1 ^ -(int32(src[14]>>5&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[14]<<20&0x1f00000)|src[15]>>12)>>1 ^ -(int32((src[14]<<20&0x1f00000)|src[15]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[15]<<13&0x1ffe000)|src[16]>>19)>>1 ^ -(int32((src[15]<<13&0x1ffe000)|src[16]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[16]<<6&0x1ffffc0)|src[17]>>26)>>1 ^ -(int32((src[16]<<6&0x1ffffc0)|src[17]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32(src[17]>>1&0x1ffffff)>>1 ^ -(int32(src[17]>>1&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[17]<<24&0x1000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x1000000)|src[18]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[18]<<17&0x1fe0000)|src[19]>>15)>>1 ^ -(int32((src[18]<<17&0x1fe0000)|src[19]>>15) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[19]<<10&0x1fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x1fffc00)|src[20]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[20]<<3&0x1fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x1fffff8)|src[21]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32(src[21]>>4&0x1ffffff)>>1 ^ -(int32(src[21]>>4&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[21]<<21&0x1e00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1e00000)|src[22]>>11) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[22]<<14&0x1ffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x1ffc000)|src[23]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[23]<<7&0x1ffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x1ffff80)|src[24]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[24]>>0&0x1ffffff)>>1 ^ -(int32(src[24]>>0&0x1ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range26BitDelta[T Integer](src *[26]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>6&0x3ffffff)>>1 ^ -(int32(src[0]>>6&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<20&0x3f00000)|src[1]>>12)>>1 ^ -(int32((src[0]<<20&0x3f00000)|src[1]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<14&0x3ffc000)|src[2]>>18)>>1 ^ -(int32((src[1]<<14&0x3ffc000)|src[2]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[2]<<8&0x3ffff00)|src[3]>>24)>>1 ^ -(int32((src[2]<<8&0x3ffff00)|src[3]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[3]<<2&0x3fffffc)|src[4]>>30)>>1 ^ -(int32((src[3]<<2&0x3fffffc)|src[4]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32(src[4]>>4&0x3ffffff)>>1 ^ -(int32(src[4]>>4&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[4]<<22&0x3c00000)|src[5]>>10)>>1 ^ -(int32((src[4]<<22&0x3c00000)|src[5]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[5]<<16&0x3ff0000)|src[6]>>16)>>1 ^ -(int32((src[5]<<16&0x3ff0000)|src[6]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[6]<<10&0x3fffc00)|src[7]>>22)>>1 ^ -(int32((src[6]<<10&0x3fffc00)|src[7]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[7]<<4&0x3fffff0)|src[8]>>28)>>1 ^ -(int32((src[7]<<4&0x3fffff0)|src[8]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[8]>>2&0x3ffffff)>>1 ^ -(int32(src[8]>>2&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[8]<<24&0x3000000)|src[9]>>8)>>1 ^ -(int32((src[8]<<24&0x3000000)|src[9]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[9]<<18&0x3fc0000)|src[10]>>14)>>1 ^ -(int32((src[9]<<18&0x3fc0000)|src[10]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[10]<<12&0x3fff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0x3fff000)|src[11]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[11]<<6&0x3ffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3ffffc0)|src[12]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[12]>>0&0x3ffffff)>>1 ^ -(int32(src[12]>>0&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[13]>>6&0x3ffffff)>>1 ^ -(int32(src[13]>>6&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[13]<<20&0x3f00000)|src[14]>>12)>>1 ^ -(int32((src[13]<<20&0x3f00000)|src[14]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[14]<<14&0x3ffc000)|src[15]>>18)>>1 ^ -(int32((src[14]<<14&0x3ffc000)|src[15]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[15]<<8&0x3ffff00)|src[16]>>24)>>1 ^ -(int32((src[15]<<8&0x3ffff00)|src[16]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[16]<<2&0x3fffffc)|src[17]>>30)>>1 ^ -(int32((src[16]<<2&0x3fffffc)|src[17]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[17]>>4&0x3ffffff)>>1 ^ -(int32(src[17]>>4&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[17]<<22&0x3c00000)|src[18]>>10)>>1 ^ -(int32((src[17]<<22&0x3c00000)|src[18]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[18]<<16&0x3ff0000)|src[19]>>16)>>1 ^ -(int32((src[18]<<16&0x3ff0000)|src[19]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[19]<<10&0x3fffc00)|src[20]>>22)>>1 ^ -(int32((src[19]<<10&0x3fffc00)|src[20]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[20]<<4&0x3fffff0)|src[21]>>28)>>1 ^ -(int32((src[20]<<4&0x3fffff0)|src[21]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32(src[21]>>2&0x3ffffff)>>1 ^ -(int32(src[21]>>2&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[21]<<24&0x3000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0x3000000)|src[22]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[22]<<18&0x3fc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x3fc0000)|src[23]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[23]<<12&0x3fff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3fff000)|src[24]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[24]<<6&0x3ffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x3ffffc0)|src[25]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[25]>>0&0x3ffffff)>>1 ^ -(int32(src[25]>>0&0x3ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range27BitDelta[T Integer](src *[27]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>5&0x7ffffff)>>1 ^ -(int32(src[0]>>5&0x7ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<22&0x7c00000)|src[1]>>10)>>1 ^ -(int32((src[0]<<22&0x7c00000)|src[1]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<17&0x7fe0000)|src[2]>>15)>>1 ^ -(int32((src[1]<<17&0x7fe0000)|src[2]>>15) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[2]<<12&0x7fff000)|src[3]>>20)>>1 ^ -(int32((src[2]<<12&0x7fff000)|src[3]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[3]<<7&0x7ffff80)|src[4]>>25)>>1 ^ -(int32((src[3]<<7&0x7ffff80)|src[4]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[4]<<2&0x7fffffc)|src[5]>>30)>>1 ^ -(int32((src[4]<<2&0x7fffffc)|src[5]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32(src[5]>>3&0x7ffffff)>>1 ^ -(int32(src[5]>>3&0x7ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[5]<<24&0x7000000)|src[6]>>8)>>1 ^ -(int32((src[5]<<24&0x7000000)|src[6]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[6]<<19&0x7f80000)|src[7]>>13)>>1 ^ -(int32((src[6]<<19&0x7f80000)|src[7]>>13) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[7]<<14&0x7ffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x7ffc000)|src[8]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[8]<<9&0x7fffe00)|src[9]>>23)>>1 ^ -(int32((src[8]<<9&0x7fffe00)|src[9]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[9]<<4&0x7fffff0)|src[10]>>28)>>1 ^ -(int32((src[9]<<4&0x7fffff0)|src[10]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32(src[10]>>1&0x7ffffff)>>1 ^ -(int32(src[10]>>1&0x7ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[10]<<26&0x4000000)|src[11]>>6)>>1 ^ -(int32((src[10]<<26&0x4000000)|src[11]>>6) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[11]<<21&0x7e00000)|src[12]>>11)>>1 ^ -(int32((src[11]<<21&0x7e00000)|src[12]>>11) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[12]<<16&0x7ff0000)|src[13]>>16)>>1 ^ -(int32((src[12]<<16&0x7ff0000)|src[13]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[13]<<11&0x7fff800)|src[14]>>21)>>1 ^ -(int32((src[13]<<11&0x7fff800)|src[14]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[14]<<6&0x7ffffc0)|src[15]>>26)>>1 ^ -(int32((src[14]<<6&0x7ffffc0)|src[15]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[15]<<1&0x7fffffe)|src[16]>>31)>>1 ^ -(int32((src[15]<<1&0x7fffffe)|src[16]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32(src[16]>>4&0x7ffffff)>>1 ^ -(int32(src[16]>>4&0x7ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[16]<<23&0x7800000)|src[17]>>9)>>1 ^ -(int32((src[16]<<23&0x7800000)|src[17]>>9) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[17]<<18&0x7fc0000)|src[18]>>14)>>1 ^ -(int32((src[17]<<18&0x7fc0000)|src[18]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[18]<<13&0x7ffe000)|src[19]>>19)>>1 ^ -(int32((src[18]<<13&0x7ffe000)|src[19]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[19]<<8&0x7ffff00)|src[20]>>24)>>1 ^ -(int32((src[19]<<8&0x7ffff00)|src[20]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[20]<<3&0x7fffff8)|src[21]>>29)>>1 ^ -(int32((src[20]<<3&0x7fffff8)|src[21]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32(src[21]>>2&0x7ffffff)>>1 ^ -(int32(src[21]>>2&0x7ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[21]<<25&0x6000000)|src[22]>>7)>>1 ^ -(int32((src[21]<<25&0x6000000)|src[22]>>7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[22]<<20&0x7f00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0x7f00000)|src[23]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[23]<<15&0x7ff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x7ff8000)|src[24]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[24]<<10&0x7fffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x7fffc00)|src[25]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[25]<<5&0x7ffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7ffffe0)|src[26]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[26]>>0&0x7ffffff)>>1 ^ -(int32(src[26]>>0&0x7ffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range28BitDelta[T Integer](src *[28]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>4&0xfffffff)>>1 ^ -(int32(src[0]>>4&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<24&0xf000000)|src[1]>>8)>>1 ^ -(int32((src[0]<<24&0xf000000)|src[1]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<20&0xff00000)|src[2]>>12)>>1 ^ -(int32((src[1]<<20&0xff00000)|src[2]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[2]<<16&0xfff0000)|src[3]>>16)>>1 ^ -(int32((src[2]<<16&0xfff0000)|src[3]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[3]<<12&0xffff000)|src[4]>>20)>>1 ^ -(int32((src[3]<<12&0xffff000)|src[4]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[4]<<8&0xfffff00)|src[5]>>24)>>1 ^ -(int32((src[4]<<8&0xfffff00)|src[5]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[5]<<4&0xffffff0)|src[6]>>28)>>1 ^ -(int32((src[5]<<4&0xffffff0)|src[6]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32(src[6]>>0&0xfffffff)>>1 ^ -(int32(src[6]>>0&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32(src[7]>>4&0xfffffff)>>1 ^ -(int32(src[7]>>4&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[7]<<24&0xf000000)|src[8]>>8)>>1 ^ -(int32((src[7]<<24&0xf000000)|src[8]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[8]<<20&0xff00000)|src[9]>>12)>>1 ^ -(int32((src[8]<<20&0xff00000)|src[9]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[9]<<16&0xfff0000)|src[10]>>16)>>1 ^ -(int32((src[9]<<16&0xfff0000)|src[10]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[10]<<12&0xffff000)|src[11]>>20)>>1 ^ -(int32((src[10]<<12&0xffff000)|src[11]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[11]<<8&0xfffff00)|src[12]>>24)>>1 ^ -(int32((src[11]<<8&0xfffff00)|src[12]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[12]<<4&0xffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0xffffff0)|src[13]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[13]>>0&0xfffffff)>>1 ^ -(int32(src[13]>>0&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[14]>>4&0xfffffff)>>1 ^ -(int32(src[14]>>4&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[14]<<24&0xf000000)|src[15]>>8)>>1 ^ -(int32((src[14]<<24&0xf000000)|src[15]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[15]<<20&0xff00000)|src[16]>>12)>>1 ^ -(int32((src[15]<<20&0xff00000)|src[16]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[16]<<16&0xfff0000)|src[17]>>16)>>1 ^ -(int32((src[16]<<16&0xfff0000)|src[17]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[17]<<12&0xffff000)|src[18]>>20)>>1 ^ -(int32((src[17]<<12&0xffff000)|src[18]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[18]<<8&0xfffff00)|src[19]>>24)>>1 ^ -(int32((src[18]<<8&0xfffff00)|src[19]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[19]<<4&0xffffff0)|src[20]>>28)>>1 ^ -(int32((src[19]<<4&0xffffff0)|src[20]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32(src[20]>>0&0xfffffff)>>1 ^ -(int32(src[20]>>0&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32(src[21]>>4&0xfffffff)>>1 ^ -(int32(src[21]>>4&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[21]<<24&0xf000000)|src[22]>>8)>>1 ^ -(int32((src[21]<<24&0xf000000)|src[22]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[22]<<20&0xff00000)|src[23]>>12)>>1 ^ -(int32((src[22]<<20&0xff00000)|src[23]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[23]<<16&0xfff0000)|src[24]>>16)>>1 ^ -(int32((src[23]<<16&0xfff0000)|src[24]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[24]<<12&0xffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0xffff000)|src[25]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[25]<<8&0xfffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0xfffff00)|src[26]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[26]<<4&0xffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0xffffff0)|src[27]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[27]>>0&0xfffffff)>>1 ^ -(int32(src[27]>>0&0xfffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range29BitDelta[T Integer](src *[29]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>3&0x1fffffff)>>1 ^ -(int32(src[0]>>3&0x1fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<26&0x1c000000)|src[1]>>6)>>1 ^ -(int32((src[0]<<26&0x1c000000)|src[1]>>6) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<23&0x1f800000)|src[2]>>9)>>1 ^ -(int32((src[1]<<23&0x1f800000)|src[2]>>9) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[2]<<20&0x1ff00000)|src[3]>>12)>>1 ^ -(int32((src[2]<<20&0x1ff00000)|src[3]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[3]<<17&0x1ffe0000)|src[4]>>15)>>1 ^ -(int32((src[3]<<17&0x1ffe0000)|src[4]>>15) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[4]<<14&0x1fffc000)|src[5]>>18)>>1 ^ -(int32((src[4]<<14&0x1fffc000)|src[5]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[5]<<11&0x1ffff800)|src[6]>>21)>>1 ^ -(int32((src[5]<<11&0x1ffff800)|src[6]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[6]<<8&0x1fffff00)|src[7]>>24)>>1 ^ -(int32((src[6]<<8&0x1fffff00)|src[7]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[7]<<5&0x1fffffe0)|src[8]>>27)>>1 ^ -(int32((src[7]<<5&0x1fffffe0)|src[8]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[8]<<2&0x1ffffffc)|src[9]>>30)>>1 ^ -(int32((src[8]<<2&0x1ffffffc)|src[9]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32(src[9]>>1&0x1fffffff)>>1 ^ -(int32(src[9]>>1&0x1fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[9]<<28&0x10000000)|src[10]>>4)>>1 ^ -(int32((src[9]<<28&0x10000000)|src[10]>>4) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[10]<<25&0x1e000000)|src[11]>>7)>>1 ^ -(int32((src[10]<<25&0x1e000000)|src[11]>>7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[11]<<22&0x1fc00000)|src[12]>>10)>>1 ^ -(int32((src[11]<<22&0x1fc00000)|src[12]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[12]<<19&0x1ff80000)|src[13]>>13)>>1 ^ -(int32((src[12]<<19&0x1ff80000)|src[13]>>13) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[13]<<16&0x1fff0000)|src[14]>>16)>>1 ^ -(int32((src[13]<<16&0x1fff0000)|src[14]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[14]<<13&0x1fffe000)|src[15]>>19)>>1 ^ -(int32((src[14]<<13&0x1fffe000)|src[15]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[15]<<10&0x1ffffc00)|src[16]>>22)>>1 ^ -(int32((src[15]<<10&0x1ffffc00)|src[16]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[16]<<7&0x1fffff80)|src[17]>>25)>>1 ^ -(int32((src[16]<<7&0x1fffff80)|src[17]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[17]<<4&0x1ffffff0)|src[18]>>28)>>1 ^ -(int32((src[17]<<4&0x1ffffff0)|src[18]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[18]<<1&0x1ffffffe)|src[19]>>31)>>1 ^ -(int32((src[18]<<1&0x1ffffffe)|src[19]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32(src[19]>>2&0x1fffffff)>>1 ^ -(int32(src[19]>>2&0x1fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[19]<<27&0x18000000)|src[20]>>5)>>1 ^ -(int32((src[19]<<27&0x18000000)|src[20]>>5) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[20]<<24&0x1f000000)|src[21]>>8)>>1 ^ -(int32((src[20]<<24&0x1f000000)|src[21]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[21]<<21&0x1fe00000)|src[22]>>11)>>1 ^ -(int32((src[21]<<21&0x1fe00000)|src[22]>>11) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[22]<<18&0x1ffc0000)|src[23]>>14)>>1 ^ -(int32((src[22]<<18&0x1ffc0000)|src[23]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[23]<<15&0x1fff8000)|src[24]>>17)>>1 ^ -(int32((src[23]<<15&0x1fff8000)|src[24]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[24]<<12&0x1ffff000)|src[25]>>20)>>1 ^ -(int32((src[24]<<12&0x1ffff000)|src[25]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[25]<<9&0x1ffffe00)|src[26]>>23)>>1 ^ -(int32((src[25]<<9&0x1ffffe00)|src[26]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[26]<<6&0x1fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x1fffffc0)|src[27]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[27]<<3&0x1ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x1ffffff8)|src[28]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[28]>>0&0x1fffffff)>>1 ^ -(int32(src[28]>>0&0x1fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range30BitDelta[T Integer](src *[30]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>2&0x3fffffff)>>1 ^ -(int32(src[0]>>2&0x3fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<28&0x30000000)|src[1]>>4)>>1 ^ -(int32((src[0]<<28&0x30000000)|src[1]>>4) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<26&0x3c000000)|src[2]>>6)>>1 ^ -(int32((src[1]<<26&0x3c000000)|src[2]>>6) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[2]<<24&0x3f000000)|src[3]>>8)>>1 ^ -(int32((src[2]<<24&0x3f000000)|src[3]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[3]<<22&0x3fc00000)|src[4]>>10)>>1 ^ -(int32((src[3]<<22&0x3fc00000)|src[4]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[4]<<20&0x3ff00000)|src[5]>>12)>>1 ^ -(int32((src[4]<<20&0x3ff00000)|src[5]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[5]<<18&0x3ffc0000)|src[6]>>14)>>1 ^ -(int32((src[5]<<18&0x3ffc0000)|src[6]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[6]<<16&0x3fff0000)|src[7]>>16)>>1 ^ -(int32((src[6]<<16&0x3fff0000)|src[7]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[7]<<14&0x3fffc000)|src[8]>>18)>>1 ^ -(int32((src[7]<<14&0x3fffc000)|src[8]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[8]<<12&0x3ffff000)|src[9]>>20)>>1 ^ -(int32((src[8]<<12&0x3ffff000)|src[9]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[9]<<10&0x3ffffc00)|src[10]>>22)>>1 ^ -(int32((src[9]<<10&0x3ffffc00)|src[10]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[10]<<8&0x3fffff00)|src[11]>>24)>>1 ^ -(int32((src[10]<<8&0x3fffff00)|src[11]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[11]<<6&0x3fffffc0)|src[12]>>26)>>1 ^ -(int32((src[11]<<6&0x3fffffc0)|src[12]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[12]<<4&0x3ffffff0)|src[13]>>28)>>1 ^ -(int32((src[12]<<4&0x3ffffff0)|src[13]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[13]<<2&0x3ffffffc)|src[14]>>30)>>1 ^ -(int32((src[13]<<2&0x3ffffffc)|src[14]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32(src[14]>>0&0x3fffffff)>>1 ^ -(int32(src[14]>>0&0x3fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32(src[15]>>2&0x3fffffff)>>1 ^ -(int32(src[15]>>2&0x3fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[15]<<28&0x30000000)|src[16]>>4)>>1 ^ -(int32((src[15]<<28&0x30000000)|src[16]>>4) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[16]<<26&0x3c000000)|src[17]>>6)>>1 ^ -(int32((src[16]<<26&0x3c000000)|src[17]>>6) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[17]<<24&0x3f000000)|src[18]>>8)>>1 ^ -(int32((src[17]<<24&0x3f000000)|src[18]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[18]<<22&0x3fc00000)|src[19]>>10)>>1 ^ -(int32((src[18]<<22&0x3fc00000)|src[19]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[19]<<20&0x3ff00000)|src[20]>>12)>>1 ^ -(int32((src[19]<<20&0x3ff00000)|src[20]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[20]<<18&0x3ffc0000)|src[21]>>14)>>1 ^ -(int32((src[20]<<18&0x3ffc0000)|src[21]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[21]<<16&0x3fff0000)|src[22]>>16)>>1 ^ -(int32((src[21]<<16&0x3fff0000)|src[22]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[22]<<14&0x3fffc000)|src[23]>>18)>>1 ^ -(int32((src[22]<<14&0x3fffc000)|src[23]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[23]<<12&0x3ffff000)|src[24]>>20)>>1 ^ -(int32((src[23]<<12&0x3ffff000)|src[24]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[24]<<10&0x3ffffc00)|src[25]>>22)>>1 ^ -(int32((src[24]<<10&0x3ffffc00)|src[25]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[25]<<8&0x3fffff00)|src[26]>>24)>>1 ^ -(int32((src[25]<<8&0x3fffff00)|src[26]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[26]<<6&0x3fffffc0)|src[27]>>26)>>1 ^ -(int32((src[26]<<6&0x3fffffc0)|src[27]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[27]<<4&0x3ffffff0)|src[28]>>28)>>1 ^ -(int32((src[27]<<4&0x3ffffff0)|src[28]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[28]<<2&0x3ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x3ffffffc)|src[29]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[29]>>0&0x3fffffff)>>1 ^ -(int32(src[29]>>0&0x3fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+func range31BitDelta[T Integer](src *[31]Word, offset, min, max T) (mask uint32, last T) {
+	offset -= T(int32(src[0]>>1&0x7fffffff)>>1 ^ -(int32(src[0]>>1&0x7fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 0
+	}
+	offset -= T(int32((src[0]<<30&0x40000000)|src[1]>>2)>>1 ^ -(int32((src[0]<<30&0x40000000)|src[1]>>2) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 1
+	}
+	offset -= T(int32((src[1]<<29&0x60000000)|src[2]>>3)>>1 ^ -(int32((src[1]<<29&0x60000000)|src[2]>>3) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 2
+	}
+	offset -= T(int32((src[2]<<28&0x70000000)|src[3]>>4)>>1 ^ -(int32((src[2]<<28&0x70000000)|src[3]>>4) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 3
+	}
+	offset -= T(int32((src[3]<<27&0x78000000)|src[4]>>5)>>1 ^ -(int32((src[3]<<27&0x78000000)|src[4]>>5) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 4
+	}
+	offset -= T(int32((src[4]<<26&0x7c000000)|src[5]>>6)>>1 ^ -(int32((src[4]<<26&0x7c000000)|src[5]>>6) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 5
+	}
+	offset -= T(int32((src[5]<<25&0x7e000000)|src[6]>>7)>>1 ^ -(int32((src[5]<<25&0x7e000000)|src[6]>>7) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 6
+	}
+	offset -= T(int32((src[6]<<24&0x7f000000)|src[7]>>8)>>1 ^ -(int32((src[6]<<24&0x7f000000)|src[7]>>8) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 7
+	}
+	offset -= T(int32((src[7]<<23&0x7f800000)|src[8]>>9)>>1 ^ -(int32((src[7]<<23&0x7f800000)|src[8]>>9) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 8
+	}
+	offset -= T(int32((src[8]<<22&0x7fc00000)|src[9]>>10)>>1 ^ -(int32((src[8]<<22&0x7fc00000)|src[9]>>10) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 9
+	}
+	offset -= T(int32((src[9]<<21&0x7fe00000)|src[10]>>11)>>1 ^ -(int32((src[9]<<21&0x7fe00000)|src[10]>>11) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 10
+	}
+	offset -= T(int32((src[10]<<20&0x7ff00000)|src[11]>>12)>>1 ^ -(int32((src[10]<<20&0x7ff00000)|src[11]>>12) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 11
+	}
+	offset -= T(int32((src[11]<<19&0x7ff80000)|src[12]>>13)>>1 ^ -(int32((src[11]<<19&0x7ff80000)|src[12]>>13) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 12
+	}
+	offset -= T(int32((src[12]<<18&0x7ffc0000)|src[13]>>14)>>1 ^ -(int32((src[12]<<18&0x7ffc0000)|src[13]>>14) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 13
+	}
+	offset -= T(int32((src[13]<<17&0x7ffe0000)|src[14]>>15)>>1 ^ -(int32((src[13]<<17&0x7ffe0000)|src[14]>>15) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 14
+	}
+	offset -= T(int32((src[14]<<16&0x7fff0000)|src[15]>>16)>>1 ^ -(int32((src[14]<<16&0x7fff0000)|src[15]>>16) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 15
+	}
+	offset -= T(int32((src[15]<<15&0x7fff8000)|src[16]>>17)>>1 ^ -(int32((src[15]<<15&0x7fff8000)|src[16]>>17) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 16
+	}
+	offset -= T(int32((src[16]<<14&0x7fffc000)|src[17]>>18)>>1 ^ -(int32((src[16]<<14&0x7fffc000)|src[17]>>18) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 17
+	}
+	offset -= T(int32((src[17]<<13&0x7fffe000)|src[18]>>19)>>1 ^ -(int32((src[17]<<13&0x7fffe000)|src[18]>>19) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 18
+	}
+	offset -= T(int32((src[18]<<12&0x7ffff000)|src[19]>>20)>>1 ^ -(int32((src[18]<<12&0x7ffff000)|src[19]>>20) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 19
+	}
+	offset -= T(int32((src[19]<<11&0x7ffff800)|src[20]>>21)>>1 ^ -(int32((src[19]<<11&0x7ffff800)|src[20]>>21) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 20
+	}
+	offset -= T(int32((src[20]<<10&0x7ffffc00)|src[21]>>22)>>1 ^ -(int32((src[20]<<10&0x7ffffc00)|src[21]>>22) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 21
+	}
+	offset -= T(int32((src[21]<<9&0x7ffffe00)|src[22]>>23)>>1 ^ -(int32((src[21]<<9&0x7ffffe00)|src[22]>>23) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 22
+	}
+	offset -= T(int32((src[22]<<8&0x7fffff00)|src[23]>>24)>>1 ^ -(int32((src[22]<<8&0x7fffff00)|src[23]>>24) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 23
+	}
+	offset -= T(int32((src[23]<<7&0x7fffff80)|src[24]>>25)>>1 ^ -(int32((src[23]<<7&0x7fffff80)|src[24]>>25) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 24
+	}
+	offset -= T(int32((src[24]<<6&0x7fffffc0)|src[25]>>26)>>1 ^ -(int32((src[24]<<6&0x7fffffc0)|src[25]>>26) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 25
+	}
+	offset -= T(int32((src[25]<<5&0x7fffffe0)|src[26]>>27)>>1 ^ -(int32((src[25]<<5&0x7fffffe0)|src[26]>>27) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 26
+	}
+	offset -= T(int32((src[26]<<4&0x7ffffff0)|src[27]>>28)>>1 ^ -(int32((src[26]<<4&0x7ffffff0)|src[27]>>28) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 27
+	}
+	offset -= T(int32((src[27]<<3&0x7ffffff8)|src[28]>>29)>>1 ^ -(int32((src[27]<<3&0x7ffffff8)|src[28]>>29) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 28
+	}
+	offset -= T(int32((src[28]<<2&0x7ffffffc)|src[29]>>30)>>1 ^ -(int32((src[28]<<2&0x7ffffffc)|src[29]>>30) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 29
+	}
+	offset -= T(int32((src[29]<<1&0x7ffffffe)|src[30]>>31)>>1 ^ -(int32((src[29]<<1&0x7ffffffe)|src[30]>>31) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 30
+	}
+	offset -= T(int32(src[30]>>0&0x7fffffff)>>1 ^ -(int32(src[30]>>0&0x7fffffff) & 1))
+	if offset >= min && offset <= max {
+		mask |= 1 << 31
+	}
+	return mask, offset
+}
+
+// PageSize is the frame capacity for streams from Writer.
+const PageSize = 5 * 32
+
+// Write writes each Word marshalled in native endianness.
+// The n return has the amount of bytes written—not words!
+func Write(w io.Writer, words []Word) (n int, err error) {
+	p := (*byte)(unsafe.Pointer(unsafe.SliceData(words)))
+	return w.Write(unsafe.Slice(p, len(words)*4))
+}
+
+// ReadFull reads exactly len(buf) Words from r into buf, unmarshalled in native
+// endianness. The n return has the number of bytes read—not Words! The error is
+// io.EOF only if no bytes were read. If an EOF happens after reading some but
+// not all of the words, then ReadFull returns io.ErrUnexpectedEOF.
+func ReadFull(r io.Reader, buf []Word) (n int, err error) {
+	p := (*byte)(unsafe.Pointer(unsafe.SliceData(buf)))
+	bytes := unsafe.Slice(p, len(buf)*4)
+	n, err = io.ReadFull(r, bytes)
+	return n, err
+}
+
+// ReadAsOf reads into buf since a byte [!] offset, and it returns the number of
+// bytes added. The Words reflect in native endianness.
+func ReadAsOf(r io.Reader, buf []Word, offset int) (n int, err error) {
+	p := (*byte)(unsafe.Pointer(unsafe.SliceData(buf)))
+	bytes := unsafe.Slice(p, len(buf)*4)
+	return r.Read(bytes[offset:])
+}
+
+// Writer encodes 32-bit integers to a stream.
+type Writer[T uint32 | int32] struct {
+	out         io.Writer
+	lastValue   T
+	header      Word
+	headerShift uint
+	// pending write
+	buf []Word
+	// buf space
+	mem [PageSize + 1]Word
+}
+
+// NewWriter begins the stream with a user defined delta offset. Readers of the
+// stream must use the exact same value to decode. Try to get close to the first
+// integer written, or use zero (0) for unknown.
+func NewWriter[T uint32 | int32](out io.Writer, deltaOffset T) *Writer[T] {
+	w := &Writer[T]{
+		out:       out,
+		lastValue: deltaOffset,
+	}
+	w.buf = w.mem[:1]
+	return w
+}
+
+// WritePack adds 32 integers to the stream. Errors only come from the output
+// io.Writer. The Writer is left in an undefined state after error encounters.
+func (w *Writer[T]) WritePack(p *[32]T) (fatal error) {
+	start := len(w.buf)
+	w.buf = AppendDeltaEncode(w.buf, p, w.lastValue)
+	w.lastValue = p[31]
+
+	// add encoding size (range 0..32) to page header
+	w.header |= Word(len(w.buf)-start) << w.headerShift
+	w.headerShift += 6
+
+	if w.headerShift < 30 {
+		return nil // partial page pending
+	}
+	w.header |= 1 << 31 // full-page flag
+
+	// redundant check omits Go panic
+	if len(w.buf) != 0 {
+		// write page with header
+		w.buf[0] = w.header
+		_, fatal = Write(w.out, w.buf)
+
+		// start over
+		w.header, w.headerShift = 0, 0
+		// reserve header location
+		w.buf = w.buf[:1]
+	}
+
+	return
+}
+
+// Flush writes any and all pending data including p [optional] to the stream.
+// Encoding is suboptimal when the total number of integers written (since the
+// stream start or a previous Flush) is not a multiple of PageSize.
+func (w *Writer[T]) Flush(p []T) error {
+	// consume full packs
+	for len(p) > 31 {
+		err := w.WritePack((*[32]T)(p))
+		if err != nil {
+			return err
+		}
+
+		p = p[32:]
+	}
+	if len(p) == 0 && w.headerShift == 0 {
+		// finished on complete page
+		return nil
+	}
+
+	// incomplete pack gets no compression
+	for _, v := range p {
+		w.buf = append(w.buf, Word(v))
+	}
+	// A partial page uses its last pack-size to
+	// count the number of integers that follow.
+	w.header |= Word(len(p)) << 24
+	// mark unused pack-sizes
+	for w.headerShift < 24 {
+		w.header |= 63 << w.headerShift
+		w.headerShift += 6
+	}
+	// install with redundant check to omit Go panic
+	if len(w.buf) != 0 {
+		w.buf[0] = w.header
+	}
+
+	_, err := Write(w.out, w.buf)
+	if err != nil {
+		return err
+	}
+
+	// start over
+	w.header, w.headerShift = 0, 0
+	// redundant check to omit Go panic
+	if len(w.buf) != 0 {
+		// reserve header location
+		w.buf = w.buf[:1]
+	}
+	return nil
+}
+
+// Reader decodes 32-bit integers from a stream.
+type Reader[T uint32 | int32] struct {
+	in        io.Reader // data source
+	lastValue T         // delta offset for next pack
+	// packs 5 size of 6 bits each plus a "full" flag
+	header Word
+	// position of next size in header is multiple of 6
+	headerShift uint
+
+	// read buffer equals .buf[.offset:.byteN/4]
+	buf [PageSize + 1]Word
+	// byte [!] count in buffer
+	byteN int
+	// index of buffer position
+	offset int
+}
+
+// NewReader begins the stream with a user defined delta offset. The value must
+// match the NewWriter used to create this stream.
+func NewReader[T uint32 | int32](in io.Reader, deltaOffset T) *Reader[T] {
+	return &Reader[T]{
+		in:          in,
+		lastValue:   deltaOffset,
+		headerShift: 30, // start exhausted
+	}
+}
+
+func (r *Reader[T]) ensureNWords(min int) error {
+	for r.byteN/4-r.offset < min {
+		// move remainder to buffer start
+		if r.offset != 0 {
+			r.byteN -= r.offset * 4
+			copy(r.buf[:(r.byteN+4-1)/4], r.buf[r.offset:])
+			r.offset = 0
+		}
+
+		n, err := ReadAsOf(r.in, r.buf[:], r.byteN)
+		r.byteN += n
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadAppend appends integers from the stream to dst, and it returns the
+// extended buffer. Errors only come from the input io.Reader. The return
+// equals dst when Read encounters an error. Otherwise, Reads are of at
+// most PageSize integers in size.
+func (r *Reader[T]) ReadAppend(dst []T) ([]T, error) {
+	// need next header?
+	if r.headerShift > 24 {
+		err := r.ensureNWords(1)
+		if err != nil {
+			return dst, err
+		}
+
+		r.header = r.buf[r.offset]
+		r.offset++
+		r.headerShift = 0
+	}
+
+	size := int(r.header>>r.headerShift) & 63
+
+	if r.header&(1<<31) == 0 && (r.headerShift == 24 || size == 63) {
+		// incomplete pack in partial page
+		remain := int(r.header >> 24)
+		err := r.ensureNWords(remain)
+		if err != nil {
+			return dst, err
+		}
+
+		// copy without compression
+		for _, w := range r.buf[r.offset : r.offset+remain] {
+			dst = append(dst, T(w))
+		}
+		r.offset += remain
+		r.headerShift = 30
+		return dst, nil
+	}
+
+	err := r.ensureNWords(size)
+	if err != nil {
+		return dst, err
+	}
+
+	r.headerShift += 6
+	enc := r.buf[r.offset : r.offset+size]
+	r.offset += size
+
+	dst = AppendDeltaDecode(dst, enc, r.lastValue)
+	// redundant check omits Go panic
+	if len(dst) != 0 {
+		r.lastValue = dst[len(dst)-1]
+	}
+	return dst, nil
+}
@@ -0,0 +1,4 @@
+// Package pack32 provides compression for batches of 32 integers.
+package pack32
+
+//go:generate go run ../cmd/packgen -width 32 -limit 31 -stream gen.go
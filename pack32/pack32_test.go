@@ -0,0 +1,90 @@
+package pack32
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestDeltaEncoding(t *testing.T) {
+	for bitN := 0; bitN <= 32; bitN++ {
+		t.Run(fmt.Sprintf("%dBitDelta", bitN), func(t *testing.T) {
+			if bitN <= 16 {
+				t.Run("int16", func(t *testing.T) {
+					testDeltaEncoding[int16](t, bitN)
+				})
+			}
+			t.Run("int32", func(t *testing.T) {
+				testDeltaEncoding[int32](t, bitN)
+			})
+			t.Run("uint32", func(t *testing.T) {
+				testDeltaEncoding[uint32](t, bitN)
+			})
+		})
+	}
+}
+
+func testDeltaEncoding[T Integer](t *testing.T, bitN int) {
+	// random deltas of bitN in size, zig-zag encoded
+	var data [32]T
+	offset := T(rand.Uint32())
+	last := offset
+	for i := range data {
+		zigZag := rand.Uint64() & (1<<bitN - 1)
+		if i == 0 {
+			zigZag |= 1 << bitN >> 1 // max size
+		}
+		last -= T(zigZag>>1) ^ -T(zigZag&1)
+		data[i] = last
+	}
+
+	in := data // copy just in case encode mutates input
+	pack := AppendDeltaEncode(nil, &in, offset)
+	if len(pack) > bitN {
+		t.Errorf("packed %d-bit random data in %d words", bitN, len(pack))
+	}
+
+	got := AppendDeltaDecode(nil, pack, offset)
+	if !reflect.DeepEqual(got, data[:]) {
+		t.Logf("packed as: %#x", pack)
+		t.Errorf("encode + decode changed input\ngot:  %#x\nwant: %#x", got, data)
+	}
+
+	var into [32]T
+	DecodeInto(&into, pack, offset)
+	if into != data {
+		t.Errorf("decode into got %#x, want %#x", into, data)
+	}
+
+	if last := DecodeLast(pack, offset); last != data[31] {
+		t.Errorf("decode last got %#x, want %#x", last, data[31])
+	}
+}
+
+func TestStream(t *testing.T) {
+	data := make([]int32, 2*PageSize+7)
+	for i := range data {
+		data[i] = int32(i*i) - 1000
+	}
+
+	var buf bytes.Buffer
+	err := NewWriter(&buf, int32(0)).Flush(data)
+	if err != nil {
+		t.Fatal("flush error:", err)
+	}
+
+	r := NewReader(&buf, int32(0))
+	var got []int32
+	for err == nil {
+		got, err = r.ReadAppend(got)
+	}
+	if err != io.EOF {
+		t.Fatal("read error:", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("got %d, want %d", got, data)
+	}
+}
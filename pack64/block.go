@@ -0,0 +1,38 @@
+package pack64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Block holds encoded Words, such as the output of AppendDeltaEncodeSlice.
+// The binary form is in little-endian byte order, regardless of the platform.
+type Block []Word
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (b Block) AppendBinary(dst []byte) ([]byte, error) {
+	for _, w := range b {
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(w))
+	}
+	return dst, nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (b Block) MarshalBinary() ([]byte, error) {
+	return b.AppendBinary(make([]byte, 0, len(b)*8))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. The
+// Block gets replaced with the content of data, reusing capacity when present.
+func (b *Block) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return fmt.Errorf("pack64: block of %d bytes not a multiple of the word size", len(data))
+	}
+
+	words := (*b)[:0]
+	for ; len(data) != 0; data = data[8:] {
+		words = append(words, Word(binary.LittleEndian.Uint64(data)))
+	}
+	*b = words
+	return nil
+}
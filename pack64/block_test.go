@@ -0,0 +1,79 @@
+package pack64
+
+import (
+	"bytes"
+	"encoding"
+	"reflect"
+	"testing"
+)
+
+// Interface compliance
+var (
+	_ encoding.BinaryMarshaler   = Block(nil)
+	_ encoding.BinaryUnmarshaler = (*Block)(nil)
+)
+
+func TestBlockBinary(t *testing.T) {
+	b := Block{0x0102_0304_0506_0708, 0, 0xffee_ddcc_bbaa_9988}
+
+	got, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatal("marshal error:", err)
+	}
+	want := []byte{
+		8, 7, 6, 5, 4, 3, 2, 1,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("marshalled as %#x, want %#x", got, want)
+	}
+
+	// append retains prefix
+	got, err = b.AppendBinary([]byte{42})
+	if err != nil {
+		t.Fatal("append error:", err)
+	}
+	if got[0] != 42 || !bytes.Equal(got[1:], want) {
+		t.Errorf("appended to [42] as %#x", got)
+	}
+
+	var back Block
+	err = back.UnmarshalBinary(want)
+	if err != nil {
+		t.Fatal("unmarshal error:", err)
+	}
+	if !reflect.DeepEqual(back, b) {
+		t.Errorf("unmarshalled as %#x, want %#x", back, b)
+	}
+
+	err = back.UnmarshalBinary(want[:len(want)-1])
+	if err == nil {
+		t.Error("unmarshal of incomplete word got no error")
+	}
+}
+
+// TestBlockSlice verifies a full cycle with AppendDeltaEncodeSlice.
+func TestBlockSlice(t *testing.T) {
+	data := []int16{7, 8, 9, -3, 4, 4, 4}
+	for len(data) < 1000 {
+		data = append(data, data[len(data)-1]*3+data[len(data)-7])
+	}
+
+	bytes, err := Block(AppendDeltaEncodeSlice(nil, data, 0)).MarshalBinary()
+	if err != nil {
+		t.Fatal("marshal error:", err)
+	}
+
+	var b Block
+	if err := b.UnmarshalBinary(bytes); err != nil {
+		t.Fatal("unmarshal error:", err)
+	}
+	got, err := AppendDeltaDecodeSlice([]int16(nil), b, 0)
+	if err != nil {
+		t.Fatal("decode error:", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("got %d, want %d", got, data)
+	}
+}
@@ -0,0 +1,17 @@
+package pack64
+
+// AppendDeltaDecode adds 64 Integers to dst and it returns the extended buffer.
+// The appended Integers are equal to an AppendDeltaEncode's input if src equals
+// the appended Words from the encode, and if both offset values are equal too.
+// On amd64, some of the bit sizes decode with AVX2 when available.
+func AppendDeltaDecode[T Integer](dst []T, src []Word, offset T) []T {
+	return appendDeltaDecode(dst, src, offset)
+}
+
+// DecodeInto sets each Integer in dst to the respective AppendDeltaEncode input,
+// given that src equals the appended Words from the encode, and given that both
+// offset values are equal too. DecodeInto does not allocate. On amd64, some of
+// the bit sizes decode with AVX2 when available.
+func DecodeInto[T Integer](dst *[64]T, src []Word, offset T) {
+	decodeInto(dst, src, offset)
+}
@@ -0,0 +1,95 @@
+//go:build !purego
+
+package pack64
+
+import "unsafe"
+
+// HasAVX2 enables the assembly decoders.
+var hasAVX2 = detectAVX2()
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+func detectAVX2() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	const osxsave, avx = 1 << 27, 1 << 28
+	if ecx1&osxsave == 0 || ecx1&avx == 0 {
+		return false
+	}
+	// operating system must preserve YMM registers
+	if xcr0, _ := xgetbv(); xcr0&6 != 6 {
+		return false
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&(1<<5) != 0
+}
+
+// AVX2Params configures decodeAVX2 for a bit size. The assembly depends on the
+// field layout.
+type avx2Params struct {
+	shifts    [4]uint64 // initial right-shift of four values in a word
+	mask      uint64    // value bits
+	shiftStep uint64    // shift decrement per four values
+	groupN    uint64    // number of four-value groups per word, or zero for two words per group
+}
+
+// AVX2ParamsPerBitN has the supported bit sizes only.
+var avx2ParamsPerBitN [33]*avx2Params
+
+func init() {
+	for _, bitN := range []uint64{2, 4, 8, 16} {
+		p := &avx2Params{
+			mask:      1<<bitN - 1,
+			shiftStep: 4 * bitN,
+			groupN:    16 / bitN,
+		}
+		for i := range p.shifts {
+			p.shifts[i] = 64 - bitN*uint64(i+1)
+		}
+		avx2ParamsPerBitN[bitN] = p
+	}
+	// two values per word
+	avx2ParamsPerBitN[32] = &avx2Params{
+		shifts: [4]uint64{32, 0, 32, 0},
+		mask:   1<<32 - 1,
+	}
+}
+
+// DecodeAVX2 is DecodeInto for the bit sizes from avx2ParamsPerBitN.
+//
+//go:noescape
+func decodeAVX2(dst *[64]uint64, src *Word, offset uint64, p *avx2Params)
+
+// AVX2Params returns the decoder configuration, if any.
+func avx2ParamsFor[T Integer](src []Word, offset T) *avx2Params {
+	if !hasAVX2 || unsafe.Sizeof(offset) != 8 || len(src) >= len(avx2ParamsPerBitN) {
+		return nil
+	}
+	return avx2ParamsPerBitN[len(src)]
+}
+
+// DecodeInto is decodeIntoGeneric with hardware acceleration when available.
+func decodeInto[T Integer](dst *[64]T, src []Word, offset T) {
+	if p := avx2ParamsFor(src, offset); p != nil {
+		decodeAVX2((*[64]uint64)(unsafe.Pointer(dst)), &src[0], uint64(offset), p)
+		return
+	}
+	decodeIntoGeneric(dst, src, offset)
+}
+
+// AppendDeltaDecode is appendDeltaDecodeGeneric with hardware acceleration when
+// available.
+func appendDeltaDecode[T Integer](dst []T, src []Word, offset T) []T {
+	if p := avx2ParamsFor(src, offset); p != nil {
+		n := len(dst)
+		dst = append(dst, make([]T, 64)...)
+		decodeAVX2((*[64]uint64)(unsafe.Pointer(&dst[n])), &src[0], uint64(offset), p)
+		return dst
+	}
+	return appendDeltaDecodeGeneric(dst, src, offset)
+}
@@ -0,0 +1,89 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// DECODE4 writes four integers to (DI) from the zig-zag deltas in Y10, which
+// may have bits above the mask in Y1. Y0 has the offset in each lane. Y6 has
+// one in each lane, and Y7 has zero. Y11 gets overwritten.
+#define DECODE4 \
+	VPAND    Y1, Y10, Y10;        \ // apply value mask
+	VPAND    Y6, Y10, Y11;        \ // zig-zag sign
+	VPSUBQ   Y11, Y7, Y11;        \
+	VPSRLQ   $1, Y10, Y10;        \
+	VPXOR    Y11, Y10, Y10;       \ // delta per lane
+	VPERMQ   $0x90, Y10, Y11;     \ // prefix sum over lanes
+	VPBLENDD $0x03, Y7, Y11, Y11; \
+	VPADDQ   Y11, Y10, Y10;       \
+	VPERMQ   $0x40, Y10, Y11;     \
+	VPBLENDD $0x0f, Y7, Y11, Y11; \
+	VPADDQ   Y11, Y10, Y10;       \
+	VPSUBQ   Y10, Y0, Y11;        \ // apply on offset
+	VMOVDQU  Y11, (DI);           \
+	VPERMQ   $0xff, Y10, Y10;     \ // offset for next four
+	VPSUBQ   Y10, Y0, Y0;         \
+	ADDQ     $32, DI
+
+// func decodeAVX2(dst *[64]uint64, src *Word, offset uint64, p *avx2Params)
+TEXT ·decodeAVX2(SB), NOSPLIT, $0-32
+	MOVQ         dst+0(FP), DI
+	MOVQ         src+8(FP), SI
+	MOVQ         p+24(FP), R8
+	VPBROADCASTQ offset+16(FP), Y0
+	VMOVDQU      (R8), Y2          // p.shifts
+	VPBROADCASTQ 32(R8), Y1        // p.mask
+	VPBROADCASTQ 40(R8), Y5        // p.shiftStep
+	MOVQ         48(R8), R9        // p.groupN
+	VPCMPEQQ     Y6, Y6, Y6
+	VPSRLQ       $63, Y6, Y6
+	VPXOR        Y7, Y7, Y7
+	LEAQ         512(DI), R11      // end of dst
+
+	TESTQ R9, R9
+	JZ    pairs
+
+words:
+	VPBROADCASTQ (SI), Y8
+	ADDQ         $8, SI
+	VMOVDQU      Y2, Y9
+	MOVQ         R9, R10
+
+groups:
+	VPSRLVQ Y9, Y8, Y10
+	VPSUBQ  Y5, Y9, Y9
+	DECODE4
+	DECQ    R10
+	JNZ     groups
+	CMPQ    DI, R11
+	JB      words
+	VZEROUPPER
+	RET
+
+pairs:
+	VBROADCASTI128 (SI), Y8 // [w0, w1, w0, w1]
+	ADDQ           $16, SI
+	VPERMQ         $0x50, Y8, Y8 // [w0, w0, w1, w1]
+	VPSRLVQ        Y2, Y8, Y10
+	DECODE4
+	CMPQ           DI, R11
+	JB             pairs
+	VZEROUPPER
+	RET
@@ -0,0 +1,76 @@
+//go:build !purego
+
+package pack64
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// TestDecodeAVX2 verifies the assembly, through the public functions, against
+// the generated code.
+func TestDecodeAVX2(t *testing.T) {
+	if !hasAVX2 {
+		t.Skip("no AVX2 support")
+	}
+	rnd := rand.New(rand.NewSource(42))
+
+	for bitN, p := range avx2ParamsPerBitN {
+		if p == nil {
+			continue
+		}
+		for round := 0; round < 100; round++ {
+			src := make([]Word, bitN)
+			for i := range src {
+				src[i] = Word(rnd.Uint64())
+			}
+			offset := int64(rnd.Uint64())
+
+			var got, want [64]int64
+			DecodeInto(&got, src, offset)
+			decodeIntoGeneric(&want, src, offset)
+			if got != want {
+				t.Fatalf("%d-bit decode of %#x with offset %d got %d, want %d", bitN, src, offset, got, want)
+			}
+
+			appended := AppendDeltaDecode([]uint64{7}, src, uint64(offset))
+			if len(appended) != 65 || appended[0] != 7 {
+				t.Fatalf("%d-bit append got %d values, starting with %d", bitN, len(appended), appended[0])
+			}
+			for i, v := range appended[1:] {
+				if int64(v) != want[i] {
+					t.Fatalf("%d-bit append got %d at index %d, want %d", bitN, v, i, want[i])
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDecodeAVX2(b *testing.B) {
+	if !hasAVX2 {
+		b.Skip("no AVX2 support")
+	}
+	for _, bitN := range []int{2, 4, 8, 16, 32} {
+		src := make([]Word, bitN)
+		for i := range src {
+			src[i] = Word(rand.Uint64())
+		}
+		var dst [64]uint64
+
+		b.Run(fmt.Sprintf("%dBit", bitN), func(b *testing.B) {
+			b.Run("Go", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					decodeIntoGeneric(&dst, src, 0)
+				}
+				b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+			})
+			b.Run("AVX2", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					DecodeInto(&dst, src, 0)
+				}
+				b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+			})
+		})
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !amd64 || purego
+
+package pack64
+
+// DecodeInto is decodeIntoGeneric with hardware acceleration when available.
+func decodeInto[T Integer](dst *[64]T, src []Word, offset T) {
+	decodeIntoGeneric(dst, src, offset)
+}
+
+// AppendDeltaDecode is appendDeltaDecodeGeneric with hardware acceleration when
+// available.
+func appendDeltaDecode[T Integer](dst []T, src []Word, offset T) []T {
+	return appendDeltaDecodeGeneric(dst, src, offset)
+}
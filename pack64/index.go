@@ -0,0 +1,64 @@
+package pack64
+
+import (
+	"io"
+	"sort"
+)
+
+// PageRef locates a page in a stream.
+type PageRef[T uint64 | int64] struct {
+	// Position has the number of integers before the page.
+	Position int64
+	// WordOffset has the number of Words before the page.
+	WordOffset int64
+	// DeltaOffset has the value needed to decode the page.
+	DeltaOffset T
+	// First has the initial integer of the page.
+	First T
+}
+
+// Index has a PageRef for each page in a stream, in order of appearance.
+type Index[T uint64 | int64] []PageRef[T]
+
+// SetIndex makes the Writer append a PageRef to x for each page written from
+// now on. A nil x stops the registration.
+func (w *Writer[T]) SetIndex(x *Index[T]) {
+	w.index = x
+}
+
+// SetIndex provides page locations for the stream. Readers use the Index to
+// jump pages when the input io.Reader implements io.Seeker too. Seek offsets
+// are relative to io.SeekStart, i.e., the stream must start at offset zero.
+func (r *Reader[T]) SetIndex(x Index[T]) {
+	r.index = x
+}
+
+// SeekPage moves to the last page which starts at or before target when that
+// page starts after the current position. The pending state must be empty.
+func (r *Reader[T]) seekPage(target int64) error {
+	seeker, ok := r.in.(io.Seeker)
+	if !ok || len(r.index) == 0 {
+		return nil
+	}
+
+	// index of first page beyond target
+	i := sort.Search(len(r.index), func(i int) bool {
+		return r.index[i].Position > target
+	})
+	if i == 0 || r.index[i-1].Position <= r.position {
+		return nil // no gain
+	}
+	page := r.index[i-1]
+
+	_, err := seeker.Seek(page.WordOffset*8, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	// reset with buffer cleared
+	r.lastValue = page.DeltaOffset
+	r.headerShift = 63
+	r.byteN, r.offset = 0, 0
+	r.position = page.Position
+	return nil
+}
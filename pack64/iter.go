@@ -0,0 +1,57 @@
+//go:build go1.23
+
+package pack64
+
+import (
+	"io"
+	"iter"
+)
+
+// All returns each integer remaining in the stream. Errors from the input
+// io.Reader, other than io.EOF, end the sequence with a zero integer.
+func (r *Reader[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var buf [PageSize]T
+		for {
+			got, err := r.ReadAppend(buf[:0])
+			if err != nil {
+				if err != io.EOF {
+					var zero T
+					yield(zero, err)
+				}
+				return
+			}
+
+			for i, v := range got {
+				if !yield(v, nil) {
+					// retain remainder for next read
+					r.pending = append(r.pendingBuf[:0], got[i+1:]...)
+					r.position -= int64(len(r.pending))
+					return
+				}
+			}
+		}
+	}
+}
+
+// Chunks returns each ReadAppend result remaining in the stream. The slices
+// are only valid until the next iteration, as the buffer is reused. Errors
+// from the input io.Reader, other than io.EOF, end the sequence with nil.
+func (r *Reader[T]) Chunks() iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		var buf [PageSize]T
+		for {
+			got, err := r.ReadAppend(buf[:0])
+			if err != nil {
+				if err != io.EOF {
+					yield(nil, err)
+				}
+				return
+			}
+
+			if !yield(got, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+//go:build go1.23
+
+package pack64
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReaderAll(t *testing.T) {
+	const deltaOffset int64 = -7
+	data := make([]int64, PageSize+99)
+	for i := range data {
+		data[i] = int64(i/3) + deltaOffset
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, deltaOffset).Flush(data); err != nil {
+		t.Fatal("flush error:", err)
+	}
+
+	var got []int64
+	for v, err := range NewReader(bytes.NewReader(buf.Bytes()), deltaOffset).All() {
+		if err != nil {
+			t.Fatal("read error:", err)
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("got %d, want %d", got, data)
+	}
+
+	got = got[:0]
+	for chunk, err := range NewReader(bytes.NewReader(buf.Bytes()), deltaOffset).Chunks() {
+		if err != nil {
+			t.Fatal("read error:", err)
+		}
+		got = append(got, chunk...)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("got %d in chunks, want %d", got, data)
+	}
+}
+
+func TestReaderAllError(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, uint64(0)).Flush(make([]uint64, 100)); err != nil {
+		t.Fatal("flush error:", err)
+	}
+	// fail in tail
+	errCut := errors.New("test cut")
+	in := io.MultiReader(io.LimitReader(&buf, int64(buf.Len()-8)), iotest.ErrReader(errCut))
+
+	var n int
+	var lastErr error
+	for v, err := range NewReader(in, uint64(0)).All() {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if v != 0 {
+			t.Errorf("got %d, want 0", v)
+		}
+		n++
+	}
+	if n != 64 {
+		t.Errorf("got %d integers, want 64", n)
+	}
+	if lastErr != errCut {
+		t.Errorf("got error %v, want %v", lastErr, errCut)
+	}
+}
+
+func TestReaderAllBreak(t *testing.T) {
+	data := make([]uint64, 200)
+	for i := range data {
+		data[i] = uint64(i * 3)
+	}
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, uint64(0)).Flush(data); err != nil {
+		t.Fatal("flush error:", err)
+	}
+
+	r := NewReader(&buf, uint64(0))
+	for v, err := range r.All() {
+		if err != nil {
+			t.Fatal("read error:", err)
+		}
+		if v == data[9] {
+			break
+		}
+	}
+	got, err := r.ReadAll()
+	if err != nil {
+		t.Fatal("read error:", err)
+	}
+	if !reflect.DeepEqual(got, data[10:]) {
+		t.Errorf("got %d after break, want %d", got, data[10:])
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"unsafe"
 )
 
-//go:generate go run ../cmd/packgen -limit 63 gen.go
+//go:generate go run ../cmd/packgen -limit 63 -rename AppendDeltaDecode=appendDeltaDecodeGeneric,DecodeInto=decodeIntoGeneric gen.go
 
 // Write writes each Word marshalled in native endianness.
 // The n return has the amount of bytes written—not words!
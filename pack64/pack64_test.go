@@ -128,6 +128,64 @@ func testDeltaEncoding[T Integer](t *testing.T, bitN int) {
 		t.Logf("packed as: %#x", pack)
 		t.Errorf("encode + decode changed input\ngot:  %#x\nwant: %#x", got, want)
 	}
+
+	if last := DecodeLast(pack, offset); last != data[63] {
+		t.Errorf("decode last got %#x, want %#x", last, data[63])
+	}
+
+	var sum T
+	for _, v := range data {
+		sum += v
+	}
+	if got, last := SumDelta(pack, offset); got != sum || last != data[63] {
+		t.Errorf("sum delta got %#x and last %#x, want %#x and %#x", got, last, sum, data[63])
+	}
+
+	// select about half of the values
+	lo, hi := data[0], data[0]
+	for _, v := range data {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	if gotLo, gotHi := MinMax(pack, offset); gotLo != lo || gotHi != hi {
+		t.Errorf("min max got %#x and %#x, want %#x and %#x", gotLo, gotHi, lo, hi)
+	}
+	from, to := lo/2+hi/4, hi/2+lo/4
+	var wantMask uint64
+	for i, v := range data {
+		if v >= from && v <= to {
+			wantMask |= 1 << i
+		}
+	}
+	if mask, last := RangeMask(pack, offset, from, to); mask != wantMask || last != data[63] {
+		t.Errorf("range mask [%#x, %#x] got %#x and last %#x, want %#x and %#x", from, to, mask, last, wantMask, data[63])
+	}
+	if n, last := CountRange(pack, offset, from, to); n != bits.OnesCount64(wantMask) || last != data[63] {
+		t.Errorf("count range [%#x, %#x] got %d and last %#x, want %d and %#x", from, to, n, last, bits.OnesCount64(wantMask), data[63])
+	}
+
+	for _, mask := range []uint64{0, 1, 1 << 63, 0xf0f0_0000_0000_0f0f, ^uint64(0), wantMask} {
+		want := []T{42}
+		for i, v := range data {
+			if mask&(1<<i) != 0 {
+				want = append(want, v)
+			}
+		}
+		got := AppendDeltaDecodeSelect([]T{42}, pack, offset, mask)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("decode select %#x got %#x, want %#x", mask, got, want)
+		}
+	}
+
+	var into [64]T
+	DecodeInto(&into, pack, offset)
+	if into != data {
+		t.Errorf("encode + decode into changed input\ngot:  %#x\nwant: %#x", into, data)
+	}
 }
 
 func BenchmarkDeltaBitEncoding(b *testing.B) {
@@ -175,6 +233,82 @@ func benchmarkDeltaBitEncoding[T Integer](b *testing.B, bitN int) {
 		}
 		b.ReportMetric(float64(b.N*64/1e9)/b.Elapsed().Seconds(), "Gℕ/s")
 	})
+
+	b.Run("SumDelta", func(b *testing.B) {
+		src := AppendDeltaEncode(nil, &data, offset)
+		b.SetBytes(64 * 8)
+
+		var sum T
+		for i := 0; i < b.N; i++ {
+			s, _ := SumDelta(src, offset)
+			sum ^= s
+		}
+		b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+
+	// kernels compared to a decode plus a loop
+	from, to := MinMax(AppendDeltaEncode(nil, &data, offset), offset)
+	from, to = from/2+to/4, to/2+from/4
+	b.Run("RangeMask", func(b *testing.B) {
+		src := AppendDeltaEncode(nil, &data, offset)
+		b.SetBytes(64 * 8)
+
+		var mask uint64
+		for i := 0; i < b.N; i++ {
+			m, _ := RangeMask(src, offset, from, to)
+			mask ^= m
+		}
+		b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+	b.Run("RangeMaskDecodeInto", func(b *testing.B) {
+		src := AppendDeltaEncode(nil, &data, offset)
+		b.SetBytes(64 * 8)
+
+		var mask uint64
+		var buf [64]T
+		for i := 0; i < b.N; i++ {
+			DecodeInto(&buf, src, offset)
+			for i, v := range buf {
+				if v >= from && v <= to {
+					mask ^= 1 << i
+				}
+			}
+		}
+		b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+	b.Run("MinMax", func(b *testing.B) {
+		src := AppendDeltaEncode(nil, &data, offset)
+		b.SetBytes(64 * 8)
+
+		var sink T
+		for i := 0; i < b.N; i++ {
+			min, max := MinMax(src, offset)
+			sink ^= min ^ max
+		}
+		b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+
+	b.Run("DecodeSelect", func(b *testing.B) {
+		src := AppendDeltaEncode(nil, &data, offset)
+		b.SetBytes(64 * 8)
+
+		var dst []T // buffer reused
+		for i := 0; i < b.N; i++ {
+			dst = AppendDeltaDecodeSelect(dst[:0], src, offset, 0x8000_0100_0010_0001)
+		}
+		b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+
+	b.Run("DecodeInto", func(b *testing.B) {
+		src := AppendDeltaEncode(nil, &data, offset)
+		b.SetBytes(64 * 8)
+
+		var dst [64]T // buffer reused
+		for i := 0; i < b.N; i++ {
+			DecodeInto(&dst, src, offset)
+		}
+		b.ReportMetric(float64(b.N*64)/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
 }
 
 // RandomNBitDelta generates a pseudo random data set with it's deltas zig-zag
@@ -0,0 +1,248 @@
+package pack64
+
+import (
+	"io"
+	"runtime"
+	"sync"
+)
+
+// ParallelPageN is the number of pages per goroutine in a parallel encode.
+const parallelPageN = 64
+
+// FlushParallel has the same effect as Flush, yet it encodes full pages on
+// multiple goroutines. The stream output, including any Index registration,
+// is identical to Flush. A workerN of zero or less defaults to GOMAXPROCS.
+func (w *Writer[T]) FlushParallel(p []T, workerN int) error {
+	if workerN <= 0 {
+		workerN = runtime.GOMAXPROCS(0)
+	}
+
+	// complete any pending page first
+	for w.headerShift != 0 && len(p) > 63 {
+		err := w.WritePack((*[64]T)(p))
+		if err != nil {
+			return err
+		}
+		p = p[64:]
+	}
+
+	if w.headerShift == 0 {
+		// each chunk has up to parallelPageN pages
+		chunks := make([][]Word, workerN)
+		pageSizes := make([][]int, workerN)
+
+		for pageN := len(p) / PageSize; pageN != 0; pageN = len(p) / PageSize {
+			// encode up to workerN chunks concurrently
+			var chunkN int
+			var wg sync.WaitGroup
+			for ; chunkN < workerN && chunkN*parallelPageN < pageN; chunkN++ {
+				first := chunkN * parallelPageN
+				end := first + parallelPageN
+				if end > pageN {
+					end = pageN
+				}
+				offset := w.lastValue
+				if first != 0 {
+					offset = p[first*PageSize-1]
+				}
+
+				wg.Add(1)
+				go func(i int, src []T, offset T) {
+					defer wg.Done()
+					chunks[i], pageSizes[i] = appendPages(chunks[i][:0], pageSizes[i][:0], src, offset)
+				}(chunkN, p[first*PageSize:end*PageSize], offset)
+			}
+			wg.Wait()
+
+			// write in order
+			for i := 0; i < chunkN; i++ {
+				_, err := Write(w.out, chunks[i])
+				if err != nil {
+					return err
+				}
+				for _, wordN := range pageSizes[i] {
+					w.page.First = p[0]
+					w.lastValue = p[PageSize-1]
+					w.pageDone(PageSize, wordN)
+					p = p[PageSize:]
+				}
+			}
+		}
+	}
+
+	return w.Flush(p)
+}
+
+// AppendPages adds the stream encoding of full pages to dst, and it returns the
+// extended buffer. The number of Words per page is appended to sizes. The length
+// of src must be a multiple of PageSize.
+func appendPages[T Integer](dst []Word, sizes []int, src []T, offset T) ([]Word, []int) {
+	for ; len(src) != 0; src = src[PageSize:] {
+		headerIndex := len(dst)
+		dst = append(dst, 0) // reserve
+
+		header := Word(1 << 63) // full-page flag
+		for i, headerShift := 0, uint(0); headerShift < 63; i, headerShift = i+64, headerShift+7 {
+			start := len(dst)
+			dst = AppendDeltaEncode(dst, (*[64]T)(src[i:]), offset)
+			offset = src[i+63]
+
+			// add encoding size (range 0..64) to page header
+			header |= Word(len(dst)-start) << headerShift
+		}
+
+		dst[headerIndex] = header
+		sizes = append(sizes, len(dst)-headerIndex)
+	}
+	return dst, sizes
+}
+
+// ReadParallel decodes each page from x on workerN goroutines, and it passes
+// the result to f in order of appearance. The page slice is valid until f
+// returns only. The position has the number of integers before the page in
+// the stream. Any error from f aborts ReadParallel with the error as a return.
+// No goroutines remain, nor do reads from in, once ReadParallel returns.
+// The stream must start at offset zero of in. Pages not in x are not read.
+// A workerN of zero or less defaults to GOMAXPROCS.
+func ReadParallel[T uint64 | int64](in io.ReaderAt, x Index[T], workerN int, f func(position int64, page []T) error) error {
+	if workerN <= 0 {
+		workerN = runtime.GOMAXPROCS(0)
+	}
+
+	type job struct {
+		pageIndex int
+		words     [PageSize + 1]Word
+		page      []T
+		err       error
+		done      chan struct{}
+	}
+	// recycle jobs, including their buffers
+	free := make(chan *job, workerN+1)
+	for i := 0; i < cap(free); i++ {
+		free <- &job{done: make(chan struct{}, 1)}
+	}
+	// pending jobs in order of appearance
+	order := make(chan *job, cap(free))
+	work := make(chan *job)
+	quit := make(chan struct{})
+	// no more reads from in after return
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer close(quit)
+
+	wg.Add(1 + workerN)
+	go func() {
+		defer wg.Done()
+		defer close(order)
+		defer close(work)
+		for i := range x {
+			var j *job
+			select {
+			case j = <-free:
+				break
+			case <-quit:
+				return
+			}
+			j.pageIndex = i
+			order <- j // never blocks
+			select {
+			case work <- j:
+				break
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < workerN; i++ {
+		go func() {
+			defer wg.Done()
+			for j := range work {
+				j.page, j.err = readPage(in, x, j.pageIndex, j.words[:], j.page[:0])
+				j.done <- struct{}{}
+			}
+		}()
+	}
+
+	for j := range order {
+		<-j.done
+		if j.err != nil {
+			return j.err
+		}
+		err := f(x[j.pageIndex].Position, j.page)
+		if err != nil {
+			return err
+		}
+		free <- j
+	}
+	return nil
+}
+
+// ReadPage decodes page i from x into dst, with buf as read space.
+func readPage[T uint64 | int64](in io.ReaderAt, x Index[T], i int, buf []Word, dst []T) ([]T, error) {
+	ref := x[i]
+	words := buf[:PageSize+1]
+	if i+1 < len(x) {
+		wordN := x[i+1].WordOffset - ref.WordOffset
+		if wordN < 1 || wordN > PageSize+1 {
+			return dst, ErrCorrupt
+		}
+		words = buf[:wordN]
+	}
+
+	n, err := ReadFull(io.NewSectionReader(in, ref.WordOffset*8, int64(len(words))*8), words)
+	switch err {
+	case nil:
+		break
+	case io.EOF, io.ErrUnexpectedEOF:
+		if i+1 < len(x) {
+			return dst, io.ErrUnexpectedEOF
+		}
+		words = words[:n/8] // last page
+	default:
+		return dst, err
+	}
+
+	dst, wordN, err := appendPageDecode(dst, words, ref.DeltaOffset)
+	if err != nil {
+		return dst, err
+	}
+	if i+1 < len(x) && (wordN != len(words) || int64(len(dst)) != x[i+1].Position-ref.Position) {
+		return dst, ErrCorrupt
+	}
+	return dst, nil
+}
+
+// AppendPageDecode adds the integers of the page at the start of src to dst,
+// and it returns the extended buffer plus the number of Words in the page.
+func appendPageDecode[T Integer](dst []T, src []Word, offset T) ([]T, int, error) {
+	if len(src) == 0 {
+		return dst, 0, io.ErrUnexpectedEOF
+	}
+	header := src[0]
+	wordN := 1
+
+	for headerShift := uint(0); headerShift < 63; headerShift += 7 {
+		size := int(header>>headerShift) & 127
+
+		if header&(1<<63) == 0 && (headerShift == 56 || size == 127) {
+			// incomplete pack in partial page
+			n := int(header >> 56)
+			if n > 63 || wordN+n > len(src) {
+				return dst, wordN, ErrCorrupt
+			}
+			for _, w := range src[wordN : wordN+n] {
+				dst = append(dst, T(w))
+			}
+			return dst, wordN + n, nil
+		}
+
+		if size > 64 || wordN+size > len(src) {
+			return dst, wordN, ErrCorrupt
+		}
+		dst = appendDeltaDecode(dst, src[wordN:wordN+size], offset)
+		offset = dst[len(dst)-1]
+		wordN += size
+	}
+	return dst, wordN, nil
+}
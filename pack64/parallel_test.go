@@ -0,0 +1,225 @@
+package pack64
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestFlushParallel verifies identical output with Flush.
+func TestFlushParallel(t *testing.T) {
+	const deltaOffset = -1
+	data := make([]int64, 3*parallelPageN*PageSize+PageSize+100)
+	for i := range data {
+		data[i] = int64(i%13)*int64(i%1000) + deltaOffset
+	}
+
+	// number of integers before flush, and number of packs pending in a page
+	for _, lead := range []int{0, 64, PageSize - 64, PageSize} {
+		for _, packN := range []int{0, 1, 8} {
+			start := lead + packN*64
+			for _, n := range []int{0, 63, PageSize, 2*parallelPageN*PageSize + 64, len(data) - start} {
+				for _, workerN := range []int{1, 2, 5} {
+					var want, got bytes.Buffer
+					var wantIndex, gotIndex Index[int64]
+
+					w := NewWriter(&want, int64(deltaOffset))
+					w.SetIndex(&wantIndex)
+					writeLead(t, w, data, lead, packN)
+					if err := w.Flush(data[start : start+n]); err != nil {
+						t.Fatal("flush error:", err)
+					}
+
+					w = NewWriter(&got, int64(deltaOffset))
+					w.SetIndex(&gotIndex)
+					writeLead(t, w, data, lead, packN)
+					if err := w.FlushParallel(data[start:start+n], workerN); err != nil {
+						t.Fatal("parallel flush error:", err)
+					}
+
+					if !bytes.Equal(got.Bytes(), want.Bytes()) {
+						t.Errorf("%d integers after %d and %d packs on %d workers: stream differs from Flush", n, lead, packN, workerN)
+					}
+					if !reflect.DeepEqual(gotIndex, wantIndex) {
+						t.Errorf("%d integers after %d and %d packs on %d workers: got index %+v, want %+v", n, lead, packN, workerN, gotIndex, wantIndex)
+					}
+				}
+			}
+		}
+	}
+}
+
+// WriteLead flushes the first lead integers of data, and it writes packN packs
+// after, which remain pending in a partial page.
+func writeLead(t *testing.T, w *Writer[int64], data []int64, lead, packN int) {
+	t.Helper()
+	if err := w.Flush(data[:lead]); err != nil {
+		t.Fatal("flush error:", err)
+	}
+	for i := 0; i < packN; i++ {
+		if err := w.WritePack((*[64]int64)(data[lead+i*64:])); err != nil {
+			t.Fatal("write pack error:", err)
+		}
+	}
+}
+
+func BenchmarkFlushParallel(b *testing.B) {
+	data := make([]int64, 1<<20)
+	for i := range data {
+		data[i] = int64(i&3) + int64(i)
+	}
+	b.SetBytes(int64(len(data)) * 8)
+
+	b.Run("Flush", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			err := NewWriter(io.Discard, int64(0)).Flush(data)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(b.N*len(data))/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+	b.Run("FlushParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			err := NewWriter(io.Discard, int64(0)).FlushParallel(data, 0)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(b.N*len(data))/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+}
+
+func TestReadParallel(t *testing.T) {
+	const deltaOffset = 99
+	data := make([]uint64, 20*PageSize+100)
+	for i := range data {
+		data[i] = uint64(i*i%7777) + deltaOffset
+	}
+
+	var buf bytes.Buffer
+	var index Index[uint64]
+	w := NewWriter(&buf, uint64(deltaOffset))
+	w.SetIndex(&index)
+	// odd page sizes
+	for _, n := range []int{PageSize, 64, 3*PageSize + 7, 0, 15 * PageSize} {
+		if err := w.Flush(data[:n]); err != nil {
+			t.Fatal("flush error:", err)
+		}
+	}
+	if err := w.Flush(data); err != nil {
+		t.Fatal("flush error:", err)
+	}
+	want, err := NewReader(bytes.NewReader(buf.Bytes()), uint64(deltaOffset)).ReadAll()
+	if err != nil {
+		t.Fatal("read error:", err)
+	}
+
+	for _, workerN := range []int{1, 3, 0} {
+		var got []uint64
+		err := ReadParallel(bytes.NewReader(buf.Bytes()), index, workerN, func(position int64, page []uint64) error {
+			if position != int64(len(got)) {
+				t.Errorf("got position %d after %d integers", position, len(got))
+			}
+			got = append(got, page...)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%d workers got error: %s", workerN, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%d workers got %d integers, want %d", workerN, len(got), len(want))
+		}
+	}
+
+	// abort on callback error
+	var pageN int
+	in := &closingReaderAt{r: bytes.NewReader(buf.Bytes())}
+	err = ReadParallel(in, index, 4, func(position int64, page []uint64) error {
+		pageN++
+		if pageN == 3 {
+			return io.ErrShortWrite
+		}
+		return nil
+	})
+	in.closed.Store(true)
+	if err != io.ErrShortWrite || pageN != 3 {
+		t.Errorf("got error %v after %d pages, want io.ErrShortWrite after 3", err, pageN)
+	}
+	time.Sleep(20 * time.Millisecond) // pending reads, if any
+	if n := in.lateN.Load(); n != 0 {
+		t.Errorf("got %d reads after return", n)
+	}
+
+	// truncated stream
+	truncated := buf.Bytes()[:buf.Len()/2]
+	err = ReadParallel(bytes.NewReader(truncated), index, 2, func(position int64, page []uint64) error {
+		return nil
+	})
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("truncated stream got error %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+// ClosingReaderAt counts reads after closed is set. Reads are slow, such that
+// they are likely pending on return of ReadParallel.
+type closingReaderAt struct {
+	r      *bytes.Reader
+	closed atomic.Bool
+	lateN  atomic.Int64
+}
+
+func (c *closingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	time.Sleep(time.Millisecond)
+	if c.closed.Load() {
+		c.lateN.Add(1)
+		return 0, io.ErrClosedPipe
+	}
+	return c.r.ReadAt(p, off)
+}
+
+func BenchmarkReadParallel(b *testing.B) {
+	data := make([]int64, 1<<20)
+	for i := range data {
+		data[i] = int64(i&3) + int64(i)
+	}
+	var buf bytes.Buffer
+	var index Index[int64]
+	w := NewWriter(&buf, int64(0))
+	w.SetIndex(&index)
+	if err := w.Flush(data); err != nil {
+		b.Fatal(err)
+	}
+	in := bytes.NewReader(buf.Bytes())
+	b.SetBytes(int64(len(data)) * 8)
+
+	b.Run("ReadAppend", func(b *testing.B) {
+		dst := make([]int64, 0, PageSize)
+		for i := 0; i < b.N; i++ {
+			in.Reset(buf.Bytes())
+			r := NewReader(in, int64(0))
+			var err error
+			for err == nil {
+				dst, err = r.ReadAppend(dst[:0])
+			}
+			if err != io.EOF {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(b.N*len(data))/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+	b.Run("ReadParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			err := ReadParallel(in, index, 0, func(position int64, page []int64) error {
+				return nil
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(b.N*len(data))/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+}
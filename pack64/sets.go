@@ -0,0 +1,149 @@
+package pack64
+
+import "io"
+
+// Intersect writes each integer present in both a and b to w, and it flushes w
+// on completion. Both streams must be in ascending order. Packs in either one
+// of the streams are passed without decoding when they can not match any of
+// the other stream. Duplicates match one-on-one. Encoded slices apply with
+// NewSliceReader.
+func Intersect[T uint64 | int64](w *Writer[T], a, b *Reader[T]) error {
+	var out packBuf[T]
+	for {
+		va, err := a.peek()
+		if err != nil {
+			return out.finish(w, err)
+		}
+		vb, err := b.peek()
+		if err != nil {
+			return out.finish(w, err)
+		}
+
+		switch {
+		case va < vb:
+			_, err = a.Search(vb)
+		case vb < va:
+			_, err = b.Search(va)
+		default:
+			a.pass()
+			b.pass()
+			err = out.add(w, va)
+		}
+		if err != nil {
+			return out.finish(w, err)
+		}
+	}
+}
+
+// Union writes each integer present in a or b to w, and it flushes w on
+// completion. Both streams must be in ascending order. Duplicates match
+// one-on-one, i.e., integers present in both streams are written once.
+func Union[T uint64 | int64](w *Writer[T], a, b *Reader[T]) error {
+	var out packBuf[T]
+	for {
+		va, err := a.peek()
+		if err != nil {
+			if err == io.EOF {
+				err = out.addAll(w, b)
+			}
+			return out.finish(w, err)
+		}
+		vb, err := b.peek()
+		if err != nil {
+			if err == io.EOF {
+				err = out.addAll(w, a)
+			}
+			return out.finish(w, err)
+		}
+
+		switch {
+		case va < vb:
+			a.pass()
+			err = out.add(w, va)
+		case vb < va:
+			b.pass()
+			err = out.add(w, vb)
+		default:
+			a.pass()
+			b.pass()
+			err = out.add(w, va)
+		}
+		if err != nil {
+			return out.finish(w, err)
+		}
+	}
+}
+
+// Difference writes each integer present in a, yet not present in b, to w,
+// and it flushes w on completion. Both streams must be in ascending order.
+// Packs in b are passed without decoding when they can not match any of a.
+// Duplicates match one-on-one.
+func Difference[T uint64 | int64](w *Writer[T], a, b *Reader[T]) error {
+	var out packBuf[T]
+	for {
+		va, err := a.peek()
+		if err != nil {
+			return out.finish(w, err)
+		}
+
+		_, err = b.Search(va)
+		switch {
+		case err == io.EOF:
+			return out.finish(w, out.addAll(w, a))
+		case err != nil:
+			return out.finish(w, err)
+		}
+
+		a.pass()
+		if b.pending[0] == va {
+			b.pass()
+			continue
+		}
+		err = out.add(w, va)
+		if err != nil {
+			return out.finish(w, err)
+		}
+	}
+}
+
+// PackBuf collects integers for a Writer.
+type packBuf[T uint64 | int64] struct {
+	pack [64]T
+	n    int // number of integers in pack
+}
+
+func (buf *packBuf[T]) add(w *Writer[T], v T) error {
+	buf.pack[buf.n] = v
+	buf.n++
+	if buf.n < len(buf.pack) {
+		return nil
+	}
+	buf.n = 0
+	return w.WritePack(&buf.pack)
+}
+
+// AddAll adds all integers remaining in r until io.EOF.
+func (buf *packBuf[T]) addAll(w *Writer[T], r *Reader[T]) error {
+	for {
+		v, err := r.peek()
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return err
+		}
+		r.pass()
+		err = buf.add(w, v)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Finish flushes w when err is nil or io.EOF.
+func (buf *packBuf[T]) finish(w *Writer[T], err error) error {
+	if err != nil && err != io.EOF {
+		return err
+	}
+	return w.Flush(buf.pack[:buf.n])
+}
@@ -0,0 +1,140 @@
+package pack64
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSets(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	// ascending with gaps for pack skipping
+	newList := func(n int, gapN int64) []int64 {
+		l := make([]int64, n)
+		var v int64
+		for i := range l {
+			if random.Intn(100) == 0 {
+				v += gapN
+			}
+			v += random.Int63n(4) // duplicates included
+			l[i] = v
+		}
+		return l
+	}
+
+	lists := [][]int64{
+		nil,
+		{42},
+		newList(63, 10),
+		newList(1000, 10_000),
+		newList(2000, 100),
+		newList(3000, 1000),
+	}
+	for _, a := range lists {
+		for _, b := range lists {
+			testSetOp(t, "intersect", Intersect[int64], intersectSlices(a, b), a, b)
+			testSetOp(t, "union", Union[int64], unionSlices(a, b), a, b)
+			testSetOp(t, "difference", Difference[int64], differenceSlices(a, b), a, b)
+		}
+	}
+}
+
+func testSetOp(t *testing.T, name string, op func(w *Writer[int64], a, b *Reader[int64]) error, want, a, b []int64) {
+	t.Helper()
+
+	var bufA, bufB bytes.Buffer
+	if err := NewWriter(&bufA, int64(0)).Flush(a); err != nil {
+		t.Fatal("flush error:", err)
+	}
+	if err := NewWriter(&bufB, int64(0)).Flush(b); err != nil {
+		t.Fatal("flush error:", err)
+	}
+
+	var out bytes.Buffer
+	err := op(NewWriter(&out, int64(0)), NewReader(&bufA, int64(0)), NewReader(&bufB, int64(0)))
+	if err != nil {
+		t.Fatalf("%s of %d and %d integers got error: %s", name, len(a), len(b), err)
+	}
+
+	got, err := NewReader(&out, int64(0)).ReadAll()
+	if err != nil {
+		t.Fatalf("%s of %d and %d integers got read error: %s", name, len(a), len(b), err)
+	}
+	if len(got)+len(want) != 0 && !reflect.DeepEqual(got, want) {
+		t.Errorf("%s of %d and %d integers got %d, want %d", name, len(a), len(b), got, want)
+	}
+
+	// encoded slices
+	sliceA, err := NewSliceReader(AppendDeltaEncodeSlice(nil, a, 0), int64(0))
+	if err != nil {
+		t.Fatal("slice reader error:", err)
+	}
+	sliceB, err := NewSliceReader(AppendDeltaEncodeSlice(nil, b, 0), int64(0))
+	if err != nil {
+		t.Fatal("slice reader error:", err)
+	}
+	out.Reset()
+	err = op(NewWriter(&out, int64(0)), sliceA, sliceB)
+	if err != nil {
+		t.Fatalf("%s of %d and %d integer slices got error: %s", name, len(a), len(b), err)
+	}
+	got, err = NewReader(&out, int64(0)).ReadAll()
+	if err != nil {
+		t.Fatalf("%s of %d and %d integer slices got read error: %s", name, len(a), len(b), err)
+	}
+	if len(got)+len(want) != 0 && !reflect.DeepEqual(got, want) {
+		t.Errorf("%s of %d and %d integer slices got %d, want %d", name, len(a), len(b), got, want)
+	}
+}
+
+func intersectSlices(a, b []int64) []int64 {
+	var out []int64
+	for len(a) != 0 && len(b) != 0 {
+		switch {
+		case a[0] < b[0]:
+			a = a[1:]
+		case b[0] < a[0]:
+			b = b[1:]
+		default:
+			out = append(out, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+	return out
+}
+
+func unionSlices(a, b []int64) []int64 {
+	var out []int64
+	for len(a) != 0 && len(b) != 0 {
+		switch {
+		case a[0] < b[0]:
+			out = append(out, a[0])
+			a = a[1:]
+		case b[0] < a[0]:
+			out = append(out, b[0])
+			b = b[1:]
+		default:
+			out = append(out, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+	out = append(out, a...)
+	return append(out, b...)
+}
+
+func differenceSlices(a, b []int64) []int64 {
+	var out []int64
+	for len(a) != 0 {
+		switch {
+		case len(b) == 0 || a[0] < b[0]:
+			out = append(out, a[0])
+			a = a[1:]
+		case b[0] < a[0]:
+			b = b[1:]
+		default:
+			a, b = a[1:], b[1:]
+		}
+	}
+	return out
+}
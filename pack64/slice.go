@@ -0,0 +1,131 @@
+package pack64
+
+import (
+	"bytes"
+	"errors"
+	"unsafe"
+)
+
+// ErrCorrupt signals malformed input on decode.
+var ErrCorrupt = errors.New("pack64: corrupt encoding")
+
+// AppendDeltaEncodeSlice adds all integers from src to dst, and it returns the
+// extended buffer. The encoding starts with the number of integers, followed by
+// pages in the format of Writer. Offset has the same role as with
+// AppendDeltaEncode. AppendDeltaDecodeSlice needs the exact same value.
+func AppendDeltaEncodeSlice[T Integer](dst []Word, src []T, offset T) []Word {
+	dst = append(dst, Word(len(src)))
+
+	for len(src) != 0 {
+		// reserve header location
+		headerIndex := len(dst)
+		dst = append(dst, 0)
+
+		var header Word
+		var headerShift uint
+		for ; headerShift < 63 && len(src) > 63; headerShift += 7 {
+			start := len(dst)
+			dst = AppendDeltaEncode(dst, (*[64]T)(src), offset)
+			offset = src[63]
+			src = src[64:]
+
+			// add encoding size (range 0..64) to page header
+			header |= Word(len(dst)-start) << headerShift
+		}
+
+		if headerShift >= 63 {
+			header |= 1 << 63 // full-page flag
+		} else {
+			// incomplete pack gets no compression
+			for _, v := range src {
+				dst = append(dst, Word(v))
+			}
+			// A partial page uses its last pack-size to
+			// count the number of integers that follow.
+			header |= Word(len(src)) << 56
+			// mark unused pack-sizes
+			for ; headerShift < 56; headerShift += 7 {
+				header |= 127 << headerShift
+			}
+			src = src[len(src):]
+		}
+
+		dst[headerIndex] = header
+	}
+
+	return dst
+}
+
+// NewSliceReader returns a Reader of the integers from an
+// AppendDeltaEncodeSlice, such as for Intersect, Union and Difference. Offset
+// must match the encoding. The Reader uses src as is, without any copy.
+func NewSliceReader[T uint64 | int64](src []Word, offset T) (*Reader[T], error) {
+	if len(src) == 0 {
+		return nil, ErrCorrupt
+	}
+	// pages follow the number of integers
+	pages := src[1:]
+	p := (*byte)(unsafe.Pointer(unsafe.SliceData(pages)))
+	return NewReader(bytes.NewReader(unsafe.Slice(p, len(pages)*8)), offset), nil
+}
+
+// AppendDeltaDecodeSlice adds the integers from an AppendDeltaEncodeSlice to
+// dst, and it returns the extended buffer. Offset must match the encoding. The
+// return equals dst when src is not a complete encoding, with ErrCorrupt.
+func AppendDeltaDecodeSlice[T Integer](dst []T, src []Word, offset T) ([]T, error) {
+	if len(src) == 0 {
+		return dst, ErrCorrupt
+	}
+	remain := src[0]
+	src = src[1:]
+	// each page has at least one header
+	if remain > Word(len(src))*PageSize {
+		return dst, ErrCorrupt
+	}
+
+	// allocate once
+	orig := dst
+	if n := int(remain); cap(dst)-len(dst) < n {
+		dst = make([]T, len(dst), len(dst)+n)
+		copy(dst, orig)
+	}
+
+	for remain != 0 {
+		if len(src) == 0 {
+			return orig, ErrCorrupt
+		}
+		header := src[0]
+		src = src[1:]
+
+		for headerShift := uint(0); headerShift < 63; headerShift += 7 {
+			size := int(header>>headerShift) & 127
+
+			if header&(1<<63) == 0 && (headerShift == 56 || size == 127) {
+				// incomplete pack in partial page
+				n := int(header >> 56)
+				if n > len(src) || Word(n) > remain {
+					return orig, ErrCorrupt
+				}
+				for _, w := range src[:n] {
+					dst = append(dst, T(w))
+				}
+				src = src[n:]
+				remain -= Word(n)
+				break
+			}
+
+			if size > 64 || size > len(src) || remain < 64 {
+				return orig, ErrCorrupt
+			}
+			dst = appendDeltaDecode(dst, src[:size], offset)
+			offset = dst[len(dst)-1]
+			src = src[size:]
+			remain -= 64
+		}
+	}
+
+	if len(src) != 0 {
+		return orig, ErrCorrupt
+	}
+	return dst, nil
+}
@@ -0,0 +1,113 @@
+package pack64
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestSlice(t *testing.T) {
+	const offset = -99
+	data := make([]int32, 2*PageSize+100)
+	for i := range data {
+		data[i] = int32(i*i) + offset
+	}
+
+	for n := 0; n <= len(data); n++ {
+		feed := data[:n]
+
+		enc := AppendDeltaEncodeSlice(nil, feed, offset)
+		if enc[0] != Word(n) {
+			t.Errorf("encoding of %d numbers starts with %d", n, enc[0])
+		}
+
+		got, err := AppendDeltaDecodeSlice([]int32{}, enc, offset)
+		if err != nil {
+			t.Fatalf("encoding of %d numbers got decode error: %s", n, err)
+		}
+		if !reflect.DeepEqual(got, feed) {
+			t.Fatalf("encoded %d, decoded %d", feed, got)
+		}
+
+		// remove each word from the end
+		for i := len(enc) - 1; i >= 0; i-- {
+			got, err := AppendDeltaDecodeSlice(nil, enc[:i], offset)
+			if err != ErrCorrupt || got != nil {
+				t.Fatalf("encoding of %d numbers truncated to %d words got %d, %v; want ErrCorrupt", n, i, got, err)
+			}
+		}
+	}
+}
+
+// TestSliceStream verifies compatibility with Reader.
+func TestSliceStream(t *testing.T) {
+	const offset = 1000
+	data := make([]int64, PageSize+PageSize/2)
+	for i := range data {
+		data[i] = int64(i%7)*int64(i%3) + offset
+	}
+
+	var buf bytes.Buffer
+	_, err := Write(&buf, AppendDeltaEncodeSlice(nil, data, offset)[1:])
+	if err != nil {
+		t.Fatal("write error:", err)
+	}
+
+	r := NewReader(&buf, int64(offset))
+	var got []int64
+	for err == nil {
+		got, err = r.ReadAppend(got)
+	}
+	if err != io.EOF {
+		t.Fatal("read error:", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("encoded %d, read %d", data, got)
+	}
+
+	r, err = NewSliceReader(AppendDeltaEncodeSlice(nil, data, offset), int64(offset))
+	if err != nil {
+		t.Fatal("slice reader error:", err)
+	}
+	got, err = r.ReadAll()
+	if err != nil {
+		t.Fatal("slice read error:", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("encoded %d, slice read %d", data, got)
+	}
+	if _, err := NewSliceReader(nil, int64(offset)); err != ErrCorrupt {
+		t.Errorf("slice reader of nil got error %v, want ErrCorrupt", err)
+	}
+}
+
+func BenchmarkSlice(b *testing.B) {
+	data := make([]int64, 1000*64+7)
+	for i := range data {
+		data[i] = int64(i&3) + int64(i)
+	}
+
+	b.Run("Encode", func(b *testing.B) {
+		b.SetBytes(int64(len(data)) * 8)
+		var dst []Word // buffer reused
+		for i := 0; i < b.N; i++ {
+			dst = AppendDeltaEncodeSlice(dst[:0], data, 0)
+		}
+		b.ReportMetric(float64(b.N*len(data))/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+
+	b.Run("Decode", func(b *testing.B) {
+		src := AppendDeltaEncodeSlice(nil, data, 0)
+		b.SetBytes(int64(len(data)) * 8)
+		var dst []int64 // buffer reused
+		for i := 0; i < b.N; i++ {
+			var err error
+			dst, err = AppendDeltaDecodeSlice(dst[:0], src, 0)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(b.N*len(data))/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+	})
+}
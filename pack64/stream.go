@@ -1,7 +1,11 @@
 // Package pack64 provides compression for batches of 64 integers.
 package pack64
 
-import "io"
+import (
+	"fmt"
+	"io"
+	"sort"
+)
 
 // PageSize is the frame capacity for streams from Writer.
 const PageSize = 9 * 64
@@ -14,6 +18,8 @@ type Writer[T uint64 | int64] struct {
 	headerShift uint
 	buf         []Word             // pending write
 	mem         [PageSize + 1]Word // buf space
+	page        PageRef[T]         // location of pending page
+	index       *Index[T]          // optional page registration
 }
 
 // NewWriter begins the stream with a user defined delta offset. Readers of the
@@ -24,6 +30,7 @@ func NewWriter[T uint64 | int64](out io.Writer, deltaOffset T) *Writer[T] {
 		out:       out,
 		lastValue: deltaOffset,
 	}
+	w.page.DeltaOffset = deltaOffset
 	w.buf = w.mem[:1]
 	return w
 }
@@ -31,6 +38,10 @@ func NewWriter[T uint64 | int64](out io.Writer, deltaOffset T) *Writer[T] {
 // WritePack adds 64 integers to the stream. Errors only come from the output
 // io.Writer. The Writer is left in an undefined state after error encounters.
 func (w *Writer[T]) WritePack(p *[64]T) (fatal error) {
+	if w.headerShift == 0 {
+		w.page.First = p[0]
+	}
+
 	start := len(w.buf)
 	w.buf = AppendDeltaEncode(w.buf, p, w.lastValue)
 	w.lastValue = p[63]
@@ -49,6 +60,7 @@ func (w *Writer[T]) WritePack(p *[64]T) (fatal error) {
 		// write page with header
 		w.buf[0] = w.header
 		_, fatal = Write(w.out, w.buf)
+		w.pageDone(PageSize, len(w.buf))
 
 		// start over
 		w.header, w.headerShift = 0, 0
@@ -77,6 +89,11 @@ func (w *Writer[T]) Flush(p []T) error {
 		return nil
 	}
 
+	pageN := int(w.headerShift/7)*64 + len(p)
+	if pageN == len(p) {
+		w.page.First = p[0]
+	}
+
 	// incomplete pack gets no compression
 	for _, v := range p {
 		w.buf = append(w.buf, Word(v))
@@ -98,6 +115,7 @@ func (w *Writer[T]) Flush(p []T) error {
 	if err != nil {
 		return err
 	}
+	w.pageDone(pageN, len(w.buf))
 
 	// start over
 	w.header, w.headerShift = 0, 0
@@ -109,6 +127,16 @@ func (w *Writer[T]) Flush(p []T) error {
 	return nil
 }
 
+// PageDone registers the page written, which holds n integers in wordN Words.
+func (w *Writer[T]) pageDone(n, wordN int) {
+	if w.index != nil {
+		*w.index = append(*w.index, w.page)
+	}
+	w.page.Position += int64(n)
+	w.page.WordOffset += int64(wordN)
+	w.page.DeltaOffset = w.lastValue
+}
+
 // Reader decodes 64-bit integers from a stream.
 type Reader[T uint64 | int64] struct {
 	in        io.Reader // data source
@@ -124,6 +152,16 @@ type Reader[T uint64 | int64] struct {
 	byteN int
 	// index of buffer position
 	offset int
+
+	// number of integers passed
+	position int64
+	// decoded integers not read yet
+	pending []T
+	// pending space
+	pendingBuf [64]T
+
+	// optional page locations
+	index Index[T]
 }
 
 // NewReader begins the stream with a user defined delta offset. The value must
@@ -160,11 +198,45 @@ func (r *Reader[T]) ensureNWords(min int) error {
 // equals dst when Read encounters an error. Otherwise, Reads are of at
 // most PageSize integers in size.
 func (r *Reader[T]) ReadAppend(dst []T) ([]T, error) {
+	if len(r.pending) != 0 {
+		dst = append(dst, r.pending...)
+		r.position += int64(len(r.pending))
+		r.pending = r.pending[:0]
+		return dst, nil
+	}
+
+	words, tail, err := r.readPack()
+	if err != nil {
+		return dst, err
+	}
+
+	if tail {
+		// copy without compression
+		for _, w := range words {
+			dst = append(dst, T(w))
+		}
+		r.position += int64(len(words))
+		return dst, nil
+	}
+
+	dst = appendDeltaDecode(dst, words, r.lastValue)
+	// redundant check omits Go panic
+	if len(dst) != 0 {
+		r.lastValue = dst[len(dst)-1]
+	}
+	r.position += 64
+	return dst, nil
+}
+
+// ReadPack returns the encoding of the next pack. Tail is set for incomplete
+// packs, in which case words has each integer without compression instead.
+// The words are valid until the next read only.
+func (r *Reader[T]) readPack() (words []Word, tail bool, err error) {
 	// need next header?
 	if r.headerShift > 56 {
 		err := r.ensureNWords(1)
 		if err != nil {
-			return dst, err
+			return nil, false, err
 		}
 
 		r.header = r.buf[r.offset]
@@ -179,31 +251,266 @@ func (r *Reader[T]) ReadAppend(dst []T) ([]T, error) {
 		remain := int(r.header >> 56)
 		err := r.ensureNWords(remain)
 		if err != nil {
-			return dst, err
+			return nil, false, err
 		}
 
-		// copy without compression
-		for _, w := range r.buf[r.offset : r.offset+remain] {
-			dst = append(dst, T(w))
-		}
+		words = r.buf[r.offset : r.offset+remain]
 		r.offset += remain
 		r.headerShift = 63
-		return dst, nil
+		return words, true, nil
 	}
 
-	err := r.ensureNWords(size)
+	err = r.ensureNWords(size)
 	if err != nil {
-		return dst, err
+		return nil, false, err
 	}
 
 	r.headerShift += 7
-	enc := r.buf[r.offset : r.offset+size]
+	words = r.buf[r.offset : r.offset+size]
 	r.offset += size
+	return words, false, nil
+}
 
-	dst = AppendDeltaDecode(dst, enc, r.lastValue)
-	// redundant check omits Go panic
-	if len(dst) != 0 {
-		r.lastValue = dst[len(dst)-1]
+// Peek returns the next integer without reading it.
+func (r *Reader[T]) peek() (T, error) {
+	for len(r.pending) == 0 {
+		words, tail, err := r.readPack()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+
+		if tail {
+			r.pending = r.pendingBuf[:0]
+			for _, w := range words {
+				r.pending = append(r.pending, T(w))
+			}
+		} else {
+			decodeInto(&r.pendingBuf, words, r.lastValue)
+			r.lastValue = r.pendingBuf[63]
+			r.pending = r.pendingBuf[:]
+		}
+	}
+	return r.pending[0], nil
+}
+
+// Pass reads the integer from a peek.
+func (r *Reader[T]) pass() {
+	r.pending = r.pending[1:]
+	r.position++
+}
+
+// Skip discards the next n integers from the stream. A negative n gets an error
+// without any effect. Other errors only come from the input io.Reader. Whole
+// packs are passed without decoding. Whole pages are passed without reading
+// when the Reader has an Index (with SetIndex).
+func (r *Reader[T]) Skip(n int64) error {
+	if n < 0 {
+		return fmt.Errorf("pack64: skip of %d integers is negative", n)
+	}
+	if n <= int64(len(r.pending)) {
+		r.pending = r.pending[n:]
+		r.position += n
+		return nil
+	}
+	target := r.position + n
+	r.position += int64(len(r.pending))
+	r.pending = nil
+
+	err := r.seekPage(target)
+	if err != nil {
+		return err
+	}
+
+	for n := target - r.position; n != 0; n = target - r.position {
+		words, tail, err := r.readPack()
+		if err != nil {
+			return err
+		}
+
+		if tail {
+			if n < int64(len(words)) {
+				r.pending = r.pendingBuf[:0]
+				for _, w := range words[n:] {
+					r.pending = append(r.pending, T(w))
+				}
+				r.position += n
+				return nil
+			}
+			r.position += int64(len(words))
+			continue
+		}
+
+		if n < 64 {
+			decodeInto(&r.pendingBuf, words, r.lastValue)
+			r.lastValue = r.pendingBuf[63]
+			r.pending = r.pendingBuf[n:]
+			r.position += n
+			return nil
+		}
+		r.lastValue = DecodeLast(words, r.lastValue)
+		r.position += 64
+	}
+	return nil
+}
+
+// Search moves to the first integer which is greater than or equal to x, and it
+// returns its position in the stream, counting from zero. The stream must be in
+// ascending order. Packs before the integer found are passed without decoding.
+// Pages before the integer found are passed without reading when the Reader has
+// an Index (with SetIndex). Search returns io.EOF when the stream has no such
+// integer, with the number of integers in the stream for the position. Other
+// errors only come from the input io.Reader.
+func (r *Reader[T]) Search(x T) (position int64, err error) {
+	for i, v := range r.pending {
+		if v >= x {
+			r.pending = r.pending[i:]
+			r.position += int64(i)
+			return r.position, nil
+		}
+	}
+	r.position += int64(len(r.pending))
+	r.pending = nil
+
+	// page index of the first page starting at x or more
+	i := sort.Search(len(r.index), func(i int) bool {
+		return r.index[i].First >= x
+	})
+	// integer could be in the page before
+	if i != 0 {
+		err := r.seekPage(r.index[i-1].Position)
+		if err != nil {
+			return r.position, err
+		}
+	}
+
+	for {
+		words, tail, err := r.readPack()
+		if err != nil {
+			return r.position, err
+		}
+
+		if tail {
+			for i, w := range words {
+				if T(w) >= x {
+					r.pending = r.pendingBuf[:0]
+					for _, w := range words[i:] {
+						r.pending = append(r.pending, T(w))
+					}
+					r.position += int64(i)
+					return r.position, nil
+				}
+			}
+			r.position += int64(len(words))
+			continue
+		}
+
+		last := DecodeLast(words, r.lastValue)
+		if last < x {
+			r.lastValue = last
+			r.position += 64
+			continue
+		}
+
+		decodeInto(&r.pendingBuf, words, r.lastValue)
+		r.lastValue = last
+		for i, v := range r.pendingBuf {
+			if v >= x {
+				r.pending = r.pendingBuf[i:]
+				r.position += int64(i)
+				break
+			}
+		}
+		return r.position, nil
+	}
+}
+
+// ReadAll reads integers from the stream until io.EOF. A successful call
+// returns err == nil, not err == io.EOF.
+func (r *Reader[T]) ReadAll() ([]T, error) {
+	var all []T
+	for {
+		var err error
+		all, err = r.ReadAppend(all)
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return all, err
+		}
+	}
+}
+
+// Sum returns the total of all integers remaining in the stream, without the
+// need to decode them. The sum overflows the same as regular addition on T does.
+// A successful call returns err == nil, not err == io.EOF.
+func (r *Reader[T]) Sum() (T, error) {
+	var sum T
+	for _, v := range r.pending {
+		sum += v
+	}
+	r.position += int64(len(r.pending))
+	r.pending = r.pending[:0]
+
+	for {
+		words, tail, err := r.readPack()
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return sum, err
+		}
+
+		if tail {
+			for _, w := range words {
+				sum += T(w)
+			}
+			r.position += int64(len(words))
+			continue
+		}
+
+		packSum, last := SumDelta(words, r.lastValue)
+		sum += packSum
+		r.lastValue = last
+		r.position += 64
+	}
+}
+
+// CountRange returns the number of integers remaining in the stream which are
+// greater than or equal to min, and which are less than or equal to max. A
+// successful call returns err == nil, not err == io.EOF.
+func (r *Reader[T]) CountRange(min, max T) (int64, error) {
+	var n int64
+	for _, v := range r.pending {
+		if v >= min && v <= max {
+			n++
+		}
+	}
+	r.position += int64(len(r.pending))
+	r.pending = r.pending[:0]
+
+	for {
+		words, tail, err := r.readPack()
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return n, err
+		}
+
+		if tail {
+			for _, w := range words {
+				if T(w) >= min && T(w) <= max {
+					n++
+				}
+			}
+			r.position += int64(len(words))
+			continue
+		}
+
+		packN, last := CountRange(words, r.lastValue, min, max)
+		n += int64(packN)
+		r.lastValue = last
+		r.position += 64
 	}
-	return dst, nil
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -126,3 +127,309 @@ func BenchmarkReadAppend(b *testing.B) {
 	}
 	b.ReportMetric(float64(b.N*64/1e9)/b.Elapsed().Seconds(), "Gℕ/s")
 }
+
+func TestReadAll(t *testing.T) {
+	const deltaOffset int64 = 99
+	data := make([]int64, 2*PageSize+1)
+	for i := range data {
+		data[i] = deltaOffset - int64(i*i)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, deltaOffset).Flush(data); err != nil {
+		t.Fatal("flush error:", err)
+	}
+
+	got, err := NewReader(&buf, deltaOffset).ReadAll()
+	if err != nil {
+		t.Fatal("read error:", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("got %d, want %d", got, data)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	const deltaOffset int64 = 5
+	data := make([]int64, 3000)
+	for i := range data {
+		data[i] = int64(i*i%1001) - deltaOffset
+	}
+
+	// flush in odd sizes for partial pages
+	var index Index[int64]
+	var buf bytes.Buffer
+	w := NewWriter(&buf, deltaOffset)
+	w.SetIndex(&index)
+	var flushN int
+	for _, n := range []int{100, 700, 63, 1000, 1, 1136} {
+		err := w.Flush(data[flushN : flushN+n])
+		if err != nil {
+			t.Fatal("flush error:", err)
+		}
+		flushN += n
+	}
+
+	if len(index) != 9 {
+		t.Errorf("got %d index entries, want 9", len(index))
+	}
+
+	for _, skips := range [][]int64{
+		{0}, {1}, {63}, {64}, {65}, {100}, {PageSize}, {PageSize + 1},
+		{1000}, {2999}, {3000},
+		{1, 1}, {10, 64, 64, 1}, {99, 1, 600, 200}, {800, 63, 1, 999},
+	} {
+		for _, useIndex := range []bool{false, true} {
+			r := NewReader(bytes.NewReader(buf.Bytes()), deltaOffset)
+			if useIndex {
+				r.SetIndex(index)
+			}
+
+			var offset int64
+			for _, n := range skips {
+				if err := r.Skip(n); err != nil {
+					t.Fatalf("skip %d with index %t got error: %s", skips, useIndex, err)
+				}
+				offset += n
+
+				got, err := r.ReadAppend(nil)
+				if err == io.EOF && offset == int64(len(data)) {
+					break
+				}
+				if err != nil {
+					t.Fatalf("read after skip %d with index %t got error: %s", skips, useIndex, err)
+				}
+				if !reflect.DeepEqual(got, data[offset:offset+int64(len(got))]) {
+					t.Fatalf("read after skip %d with index %t got %d, want %d", skips, useIndex, got, data[offset:offset+int64(len(got))])
+				}
+				offset += int64(len(got))
+			}
+
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("read after skip %d with index %t got error: %s", skips, useIndex, err)
+			}
+			if want := data[offset:]; len(want) != 0 && !reflect.DeepEqual(got, want) || len(got) != len(want) {
+				t.Errorf("read after skip %d with index %t got %d, want %d", skips, useIndex, got, data[offset:])
+			}
+		}
+	}
+
+	// index must prevent reads
+	in := &countingReader{r: bytes.NewReader(buf.Bytes())}
+	r := NewReader(in, deltaOffset)
+	r.SetIndex(index)
+	if err := r.Skip(2999); err != nil {
+		t.Fatal("skip error:", err)
+	}
+	if in.n >= int64(buf.Len())/2 {
+		t.Errorf("skip with index read %d bytes from stream of %d bytes", in.n, buf.Len())
+	}
+
+	err := NewReader(bytes.NewReader(buf.Bytes()), deltaOffset).Skip(3001)
+	if err != io.EOF {
+		t.Errorf("skip beyond end got error %v, want io.EOF", err)
+	}
+
+	r = NewReader(bytes.NewReader(buf.Bytes()), deltaOffset)
+	if err := r.Skip(10); err != nil {
+		t.Fatal("skip error:", err)
+	}
+	if err := r.Skip(-1); err == nil {
+		t.Error("negative skip got no error")
+	}
+	got, err := r.ReadAppend(nil)
+	if err != nil {
+		t.Fatal("read after negative skip got error:", err)
+	}
+	if len(got) == 0 || got[0] != data[10] {
+		t.Errorf("read after negative skip got %d, want %d first", got, data[10])
+	}
+}
+
+// CountingReader tracks the number of bytes read.
+type countingReader struct {
+	r *bytes.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (n int, err error) {
+	n, err = c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) Seek(offset int64, whence int) (int64, error) {
+	return c.r.Seek(offset, whence)
+}
+
+func TestSum(t *testing.T) {
+	const deltaOffset uint64 = 1 << 40
+	data := make([]uint64, 2*PageSize+77)
+	for i := range data {
+		data[i] = deltaOffset + uint64(i*i) ^ uint64(i)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, deltaOffset).Flush(data); err != nil {
+		t.Fatal("flush error:", err)
+	}
+
+	for _, skip := range []int64{0, 1, 64, 100, PageSize, int64(len(data))} {
+		var want uint64
+		for _, v := range data[skip:] {
+			want += v
+		}
+
+		r := NewReader(bytes.NewReader(buf.Bytes()), deltaOffset)
+		if err := r.Skip(skip); err != nil {
+			t.Fatal("skip error:", err)
+		}
+		got, err := r.Sum()
+		if err != nil {
+			t.Fatal("sum error:", err)
+		}
+		if got != want {
+			t.Errorf("sum after skip %d got %d, want %d", skip, got, want)
+		}
+	}
+}
+
+func BenchmarkSum(b *testing.B) {
+	deltaOffset := int64(b.N)
+
+	// test data
+	var p [PageSize]int64
+	for i := range p {
+		p[i] = int64(i&3) + deltaOffset
+	}
+
+	var buf bytes.Buffer
+	err := NewWriter(&buf, deltaOffset).Flush(p[:])
+	if err != nil {
+		b.Fatal(err)
+	}
+	stream := bytes.Repeat(buf.Bytes(), 1024)
+
+	b.SetBytes(1024 * PageSize * 8)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := NewReader(bytes.NewReader(stream), deltaOffset).Sum()
+		if err != nil {
+			b.Fatal("sum error:", err)
+		}
+	}
+	b.ReportMetric(float64(b.N)*1024*PageSize/1e9/b.Elapsed().Seconds(), "Gℕ/s")
+}
+
+func TestCountRange(t *testing.T) {
+	const deltaOffset int64 = 0
+	data := make([]int64, 3*PageSize+9)
+	for i := range data {
+		data[i] = int64(i*7919%1000) - 500
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, deltaOffset).Flush(data); err != nil {
+		t.Fatal("flush error:", err)
+	}
+
+	for _, r := range [][2]int64{{-500, 499}, {0, 0}, {-100, 100}, {499, -500}, {1000, 2000}} {
+		var want int64
+		for _, v := range data {
+			if v >= r[0] && v <= r[1] {
+				want++
+			}
+		}
+
+		got, err := NewReader(bytes.NewReader(buf.Bytes()), deltaOffset).CountRange(r[0], r[1])
+		if err != nil {
+			t.Fatal("count error:", err)
+		}
+		if got != want {
+			t.Errorf("count range [%d, %d] got %d, want %d", r[0], r[1], got, want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	const deltaOffset uint64 = 0
+	// ascending with duplicates
+	data := make([]uint64, 4000)
+	for i := range data {
+		data[i] = uint64(i/3*5) + 100
+	}
+
+	// flush in odd sizes for partial pages
+	var index Index[uint64]
+	var buf bytes.Buffer
+	w := NewWriter(&buf, deltaOffset)
+	w.SetIndex(&index)
+	var flushN int
+	for _, n := range []int{1000, 30, 1, 600, 2369} {
+		err := w.Flush(data[flushN : flushN+n])
+		if err != nil {
+			t.Fatal("flush error:", err)
+		}
+		flushN += n
+	}
+	for i, page := range index {
+		if page.First != data[page.Position] {
+			t.Errorf("page %d first is %d, want %d", i, page.First, data[page.Position])
+		}
+	}
+
+	for _, x := range []uint64{0, 100, 101, 105, 1000, 1001, 1665, 1670, 5000, 6760, 6761, 9999} {
+		want := int64(sort.Search(len(data), func(i int) bool { return data[i] >= x }))
+
+		for _, useIndex := range []bool{false, true} {
+			r := NewReader(bytes.NewReader(buf.Bytes()), deltaOffset)
+			if useIndex {
+				r.SetIndex(index)
+			}
+
+			got, err := r.Search(x)
+			if want == int64(len(data)) {
+				if err != io.EOF || got != want {
+					t.Errorf("search %d with index %t got position %d, error %v; want %d, io.EOF", x, useIndex, got, err, want)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("search %d with index %t got error: %s", x, useIndex, err)
+			}
+			if got != want {
+				t.Errorf("search %d with index %t got position %d, want %d", x, useIndex, got, want)
+			}
+
+			rest, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("read after search %d with index %t got error: %s", x, useIndex, err)
+			}
+			if !reflect.DeepEqual(rest, data[want:]) {
+				t.Errorf("read after search %d with index %t got %d, want %d", x, useIndex, rest, data[want:])
+			}
+		}
+	}
+
+	// index must prevent reads
+	in := &countingReader{r: bytes.NewReader(buf.Bytes())}
+	r := NewReader(in, deltaOffset)
+	r.SetIndex(index)
+	if _, err := r.Search(data[3900]); err != nil {
+		t.Fatal("search error:", err)
+	}
+	if in.n >= int64(buf.Len())/2 {
+		t.Errorf("search with index read %d bytes from stream of %d bytes", in.n, buf.Len())
+	}
+
+	// continue from position
+	r = NewReader(bytes.NewReader(buf.Bytes()), deltaOffset)
+	if err := r.Skip(2000); err != nil {
+		t.Fatal("skip error:", err)
+	}
+	got, err := r.Search(0)
+	if got != 2000 || err != nil {
+		t.Errorf("search after skip got position %d, error %v; want 2000", got, err)
+	}
+}